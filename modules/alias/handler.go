@@ -85,7 +85,7 @@ func handleAliasAdd(ctx sdk.Context, k Keeper, msg types.MsgAliasUpdate) sdk.Err
 	}
 
 	if addNewAlias {
-		fee := params.GetFeeForAlias(msg.Alias)
+		fee := k.GetFeeForAlias(ctx, msg.Alias)
 		if err := k.DeductInt64CetFee(ctx, msg.Owner, fee); err != nil {
 			return err
 		}
@@ -53,6 +53,13 @@ func (k *mocAssetKeeper) IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.A
 	return ok && bytes.Equal(addr, a)
 }
 
+type mocCetPriceKeeper map[string]sdk.Dec
+
+func (k mocCetPriceKeeper) GetCetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	price, ok := k[denom]
+	return price, ok
+}
+
 func newContextAndKeeper(chainid string) (sdk.Context, *Keeper) {
 	db := dbm.NewMemDB()
 	ms := sdkstore.NewCommitMultiStore(db)
@@ -251,3 +258,22 @@ func TestReservedAliases(t *testing.T) {
 		require.Equal(t, types.ErrCanOnlyBeUsedByCetOwner(alias).Result(), res)
 	}
 }
+
+func TestAliasFeePricedInToken(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("test-1")
+	tom := simpleAddr("00001")
+
+	// the fee set at 0.02 usdt per CET is doubled when CET is at 0.01 usdt
+	params := keeper.GetParams(ctx)
+	params.FeePricing = dex.NewFeePricing("usdt", sdk.NewDecWithPrec(2, 2))
+	keeper.SetParams(ctx, params)
+	require.Equal(t, params.FeeForAliasLength5, keeper.GetFeeForAlias(ctx, "tommy"))
+	keeper.SetCetPriceKeeper(mocCetPriceKeeper{"usdt": sdk.NewDecWithPrec(1, 2)})
+	fee := keeper.GetFeeForAlias(ctx, "tommy")
+	require.Equal(t, 2*params.FeeForAliasLength5, fee)
+
+	handlerFunc := NewHandler(*keeper)
+	res := handlerFunc(ctx, types.MsgAliasUpdate{Owner: tom, Alias: "tommy", IsAdd: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, fmt.Sprintf("Deduct %dcet from %s", fee, tom), logStr)
+}
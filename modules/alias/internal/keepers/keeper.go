@@ -130,6 +130,7 @@ type Keeper struct {
 	aliasKeeper   *AliasKeeper
 	bankKeeper    types.ExpectedBankxKeeper
 	assetKeeper   types.ExpectedAssetStatusKeeper
+	priceKeeper   dex.CetPriceKeeper
 }
 
 func NewKeeper(key sdk.StoreKey,
@@ -145,6 +146,12 @@ func NewKeeper(key sdk.StoreKey,
 	}
 }
 
+// SetCetPriceKeeper enables pricing the alias fees in a reference token, with the price of CET it gives.
+// The fees are fixed in CET if it is not set.
+func (k *Keeper) SetCetPriceKeeper(pk dex.CetPriceKeeper) {
+	k.priceKeeper = pk
+}
+
 func (k *Keeper) DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error {
 	return k.bankKeeper.DeductInt64CetFee(ctx, addr, amt)
 }
@@ -186,3 +193,9 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetFeeForAlias returns the fee of adding the alias, which may be priced in a reference token
+func (k Keeper) GetFeeForAlias(ctx sdk.Context, alias string) int64 {
+	params := k.GetParams(ctx)
+	return params.FeePricing.GetFee(ctx, k.priceKeeper, params.GetFeeForAlias(alias))
+}
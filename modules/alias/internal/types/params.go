@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 const (
@@ -25,6 +27,7 @@ var (
 	KeyFeeForAliasLength6         = []byte("FeeForAliasLength6")
 	KeyFeeForAliasLength7OrHigher = []byte("FeeForAliasLength7OrHigher")
 	KeyMaxAliasCount              = []byte("MaxAliasCount")
+	KeyFeePricing                 = []byte("FeePricing")
 )
 
 type Params struct {
@@ -35,6 +38,8 @@ type Params struct {
 	FeeForAliasLength6         int64 `json:"fee_for_alias_length_6"`
 	FeeForAliasLength7OrHigher int64 `json:"fee_for_alias_length_7_or_higher"`
 	MaxAliasCount              int   `json:"max_alias_count"`
+	// the optional mode in which the alias fees are priced in a reference token
+	FeePricing dex.FeePricing `json:"fee_pricing"`
 }
 
 // ParamKeyTable for alias module
@@ -52,6 +57,7 @@ func DefaultParams() Params {
 		DefaultFeeForAliasLength6,
 		DefaultFeeForAliasLength7OrHigher,
 		DefaultMaxAliasCount,
+		dex.DefaultFeePricing(),
 	}
 }
 
@@ -67,6 +73,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyFeeForAliasLength6, Value: &p.FeeForAliasLength6},
 		{Key: KeyFeeForAliasLength7OrHigher, Value: &p.FeeForAliasLength7OrHigher},
 		{Key: KeyMaxAliasCount, Value: &p.MaxAliasCount},
+		{Key: KeyFeePricing, Value: &p.FeePricing},
 	}
 }
 
@@ -95,6 +102,9 @@ func (p *Params) ValidateGenesis() error {
 	if p.MaxAliasCount <= 0 {
 		return fmt.Errorf("%s must be a positive number, is %d", KeyMaxAliasCount, p.MaxAliasCount)
 	}
+	if err := p.FeePricing.Validate(); err != nil {
+		return fmt.Errorf("%s is invalid: %s", KeyFeePricing, err)
+	}
 	return nil
 }
 
@@ -129,12 +139,14 @@ func (p Params) String() string {
   FeeForAliasLength5:         %d
   FeeForAliasLength6:         %d
   FeeForAliasLength7OrHigher: %d
-  MaxAliasCount:              %d`,
+  MaxAliasCount:              %d
+  FeePricing:                 %s`,
 		p.FeeForAliasLength2,
 		p.FeeForAliasLength3,
 		p.FeeForAliasLength4,
 		p.FeeForAliasLength5,
 		p.FeeForAliasLength6,
 		p.FeeForAliasLength7OrHigher,
-		p.MaxAliasCount)
+		p.MaxAliasCount,
+		p.FeePricing)
 }
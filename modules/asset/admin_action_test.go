@@ -0,0 +1,52 @@
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+)
+
+func Test_AdminActions(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrListNoOwner()[:2]
+
+	res := h(input.ctx, asset.NewMsgForbidToken("abc", testAddr).WithReason("incident 1"))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgForbidAddr("abc", testAddr, addrs).WithReason("sanctioned"))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgUnForbidAddr("abc", testAddr, addrs[:1]))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgUnForbidToken("abc", testAddr).WithReason("resolved"))
+	require.True(t, res.IsOK(), res.Log)
+
+	// a failed action is not logged
+	res = h(input.ctx, asset.NewMsgUnForbidToken("abc", testAddr).WithReason("again"))
+	require.False(t, res.IsOK())
+
+	// the latest first
+	actions := input.tk.GetAdminActions(input.ctx, "abc", 1, 3)
+	require.Equal(t, 3, len(actions))
+	require.Equal(t, asset.AdminAction{ID: 4, Symbol: "abc", Action: "unforbid_token", Operator: testAddr,
+		Reason: "resolved", Height: input.ctx.BlockHeight(), Time: input.ctx.BlockHeader().Time.Unix()}, actions[0])
+	require.Equal(t, "unforbid_addr", actions[1].Action)
+	require.Equal(t, addrs[:1], actions[1].Addresses)
+	require.Equal(t, "", actions[1].Reason)
+	require.Equal(t, "forbid_addr", actions[2].Action)
+	require.Equal(t, "sanctioned", actions[2].Reason)
+
+	actions = input.tk.GetAdminActions(input.ctx, "abc", 2, 3)
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, "forbid_token", actions[0].Action)
+	require.Equal(t, "incident 1", actions[0].Reason)
+	require.Empty(t, input.tk.GetAdminActions(input.ctx, "abc", 3, 3))
+	require.Empty(t, input.tk.GetAdminActions(input.ctx, "xyz", 1, 3))
+
+	// the log is kept through genesis
+	genesis := asset.ExportGenesis(input.ctx, input.tk)
+	require.Equal(t, 4, len(genesis.AdminActions))
+	require.NoError(t, asset.ValidateGenesis(genesis))
+}
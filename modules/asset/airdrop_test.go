@@ -0,0 +1,106 @@
+package asset_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func Test_HolderSnapshotAndAirdrop(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	ctx := input.ctx.WithBlockHeight(10)
+	require.NoError(t, input.tk.AddToken(ctx, addrs[0], types.NewTokenCoins("abc", sdk.NewInt(300))))
+	require.NoError(t, input.tk.AddToken(ctx, addrs[1], types.NewTokenCoins("abc", sdk.NewInt(600))))
+	res := h(ctx, asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(10000), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+	params := input.tk.GetParams(ctx)
+	params.AirdropBatchSize = 2
+	input.tk.SetParams(ctx, params)
+
+	// the height must not have passed
+	res = h(ctx, asset.NewMsgCreateHolderSnapshot(testAddr, "abc", 9))
+	require.False(t, res.IsOK())
+	cetBefore := input.tk.GetAccTotalToken(ctx, testAddr).AmountOf(dex.CET)
+	res = h(ctx, asset.NewMsgCreateHolderSnapshot(testAddr, "abc", 10))
+	require.True(t, res.IsOK(), res.Log)
+	cetAfter := input.tk.GetAccTotalToken(ctx, testAddr).AmountOf(dex.CET)
+	require.Equal(t, sdk.NewInt(params.HolderSnapshotFee), cetBefore.Sub(cetAfter))
+
+	// no airdrop before the snapshot is taken
+	amount := sdk.NewCoin("xyz", sdk.NewInt(1001))
+	res = h(ctx, asset.NewMsgCreateAirdrop(testAddr, 1, amount))
+	require.False(t, res.IsOK())
+
+	asset.EndBlocker(ctx, input.tk)
+	snapshot, ok := input.tk.GetHolderSnapshot(ctx, 1)
+	require.True(t, ok)
+	require.True(t, snapshot.Taken)
+	require.Equal(t, int64(3), snapshot.HolderCount)
+	require.Equal(t, sdk.NewInt(3000), snapshot.TotalAmount)
+
+	res = h(ctx, asset.NewMsgCreateAirdrop(testAddr, 2, amount))
+	require.False(t, res.IsOK())
+	res = h(ctx, asset.NewMsgCreateAirdrop(testAddr, 1, amount))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(8999), input.tk.GetAccTotalToken(ctx, testAddr).AmountOf("xyz"))
+
+	// two holders are paid in a block
+	ctx = ctx.WithBlockHeight(11)
+	asset.EndBlocker(ctx, input.tk)
+	airdrop, ok := input.tk.GetAirdrop(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, int64(2), airdrop.Paid)
+	require.False(t, airdrop.Finished)
+
+	// the remainder of rounding is returned to the sender at last
+	ctx = ctx.WithBlockHeight(12)
+	asset.EndBlocker(ctx, input.tk)
+	airdrop, _ = input.tk.GetAirdrop(ctx, 1)
+	require.Equal(t, int64(3), airdrop.Paid)
+	require.True(t, airdrop.Finished)
+	require.Equal(t, sdk.NewInt(1000), airdrop.Distributed)
+	require.Equal(t, sdk.NewInt(100), input.tk.GetAccTotalToken(ctx, addrs[0]).AmountOf("xyz"))
+	require.Equal(t, sdk.NewInt(200), input.tk.GetAccTotalToken(ctx, addrs[1]).AmountOf("xyz"))
+	require.Equal(t, sdk.NewInt(9700), input.tk.GetAccTotalToken(ctx, testAddr).AmountOf("xyz"))
+
+	ctx = ctx.WithBlockHeight(13)
+	asset.EndBlocker(ctx, input.tk)
+	airdrop, _ = input.tk.GetAirdrop(ctx, 1)
+	require.Equal(t, int64(3), airdrop.Paid)
+}
+
+func Test_HolderSnapshotsPerBlock(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	ctx := input.ctx.WithBlockHeight(10)
+
+	for i := 0; i < types.MaxHolderSnapshotsPerBlock+1; i++ {
+		res := h(ctx, asset.NewMsgCreateHolderSnapshot(testAddr, "abc", 10))
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// the snapshot beyond the limit is taken in the next block
+	asset.EndBlocker(ctx, input.tk)
+	last := uint64(types.MaxHolderSnapshotsPerBlock + 1)
+	snapshot, _ := input.tk.GetHolderSnapshot(ctx, last-1)
+	require.True(t, snapshot.Taken)
+	snapshot, _ = input.tk.GetHolderSnapshot(ctx, last)
+	require.False(t, snapshot.Taken)
+
+	ctx = ctx.WithBlockHeight(11)
+	asset.EndBlocker(ctx, input.tk)
+	snapshot, _ = input.tk.GetHolderSnapshot(ctx, last)
+	require.True(t, snapshot.Taken)
+	require.Equal(t, int64(11), snapshot.Height)
+	require.Equal(t, int64(1), snapshot.HolderCount)
+}
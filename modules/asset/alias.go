@@ -17,6 +17,18 @@ const (
 	QueryForbiddenAddr        = types.QueryForbiddenAddr
 	QueryParameters           = types.QueryParameters
 	QueryReservedSymbols      = types.QueryReservedSymbols
+	QueryVesting              = types.QueryVesting
+	QueryVestings             = types.QueryVestings
+	QueryTokenRoles           = types.QueryTokenRoles
+	QueryApprovalPolicy       = types.QueryApprovalPolicy
+	QueryOperations           = types.QueryOperations
+	QueryPendingOwner         = types.QueryPendingOwner
+	QueryAdminActions         = types.QueryAdminActions
+	QueryTokenSearch          = types.QueryTokenSearch
+	RoleMinter                = types.RoleMinter
+	RoleBurner                = types.RoleBurner
+	RoleFreezer               = types.RoleFreezer
+	RoleMetadataAdmin         = types.RoleMetadataAdmin
 	MaxTokenAmount            = types.MaxTokenAmount
 	DefaultIssueTokenFee      = types.DefaultIssueLongTokenFee
 	DefaultIssue2CharTokenFee = types.DefaultIssue2CharTokenFee
@@ -29,52 +41,111 @@ const (
 var (
 	// functions aliases
 
-	NewQuerier                 = keepers.NewQuerier
-	NewBaseKeeper              = keepers.NewBaseKeeper
-	NewBaseTokenKeeper         = keepers.NewBaseTokenKeeper
-	RegisterCodec              = types.RegisterCodec
-	DefaultGenesisState        = types.DefaultGenesisState
-	NewGenesisState            = types.NewGenesisState
-	NewQueryAssetParams        = types.NewQueryAssetParams
-	NewToken                   = types.NewToken
-	NewMsgIssueToken           = types.NewMsgIssueToken
-	NewMsgTransferOwnership    = types.NewMsgTransferOwnership
-	NewMsgMintToken            = types.NewMsgMintToken
-	NewMsgBurnToken            = types.NewMsgBurnToken
-	NewMsgForbidToken          = types.NewMsgForbidToken
-	NewMsgUnForbidToken        = types.NewMsgUnForbidToken
-	NewMsgAddTokenWhitelist    = types.NewMsgAddTokenWhitelist
-	NewMsgRemoveTokenWhitelist = types.NewMsgRemoveTokenWhitelist
-	NewMsgForbidAddr           = types.NewMsgForbidAddr
-	NewMsgUnForbidAddr         = types.NewMsgUnForbidAddr
-	NewMsgModifyTokenInfo      = types.NewMsgModifyTokenInfo
-	TestIdentityString         = types.TestIdentityString
-	ValidateTokenSymbol        = types.ValidateTokenSymbol
+	NewQuerier                    = keepers.NewQuerier
+	NewBaseKeeper                 = keepers.NewBaseKeeper
+	NewBaseTokenKeeper            = keepers.NewBaseTokenKeeper
+	RegisterCodec                 = types.RegisterCodec
+	DefaultGenesisState           = types.DefaultGenesisState
+	NewGenesisState               = types.NewGenesisState
+	NewQueryAssetParams           = types.NewQueryAssetParams
+	NewToken                      = types.NewToken
+	NewMsgIssueToken              = types.NewMsgIssueToken
+	NewMsgTransferOwnership       = types.NewMsgTransferOwnership
+	NewMsgMintToken               = types.NewMsgMintToken
+	NewMsgBurnToken               = types.NewMsgBurnToken
+	NewMsgForbidToken             = types.NewMsgForbidToken
+	NewMsgUnForbidToken           = types.NewMsgUnForbidToken
+	NewMsgAddTokenWhitelist       = types.NewMsgAddTokenWhitelist
+	NewMsgRemoveTokenWhitelist    = types.NewMsgRemoveTokenWhitelist
+	NewMsgForbidAddr              = types.NewMsgForbidAddr
+	NewMsgUnForbidAddr            = types.NewMsgUnForbidAddr
+	NewMsgModifyTokenInfo         = types.NewMsgModifyTokenInfo
+	NewMsgCreateVestingSchedule   = types.NewMsgCreateVestingSchedule
+	NewMsgRevokeVestingSchedule   = types.NewMsgRevokeVestingSchedule
+	NewQueryVestingParams         = types.NewQueryVestingParams
+	NewQueryVestingsParams        = types.NewQueryVestingsParams
+	NewMsgGrantTokenRole          = types.NewMsgGrantTokenRole
+	NewMsgRevokeTokenRole         = types.NewMsgRevokeTokenRole
+	NewMsgSetApprovalPolicy       = types.NewMsgSetApprovalPolicy
+	NewMsgApproveTokenOperation   = types.NewMsgApproveTokenOperation
+	NewMsgCancelTokenOperation    = types.NewMsgCancelTokenOperation
+	NewMsgAcceptOwnership         = types.NewMsgAcceptOwnership
+	NewMsgCancelOwnershipTransfer = types.NewMsgCancelOwnershipTransfer
+	NewMsgSetMintPolicy           = types.NewMsgSetMintPolicy
+	NewMsgCreateHolderSnapshot    = types.NewMsgCreateHolderSnapshot
+	NewMsgCreateAirdrop           = types.NewMsgCreateAirdrop
+	NewMsgBidSymbol               = types.NewMsgBidSymbol
+	NewMsgDistributeDividend      = types.NewMsgDistributeDividend
+	NewMsgClaimDividends          = types.NewMsgClaimDividends
+	NewMsgSetTransferFee          = types.NewMsgSetTransferFee
+	NewMsgIssueTokens             = types.NewMsgIssueTokens
+	NewMsgClawback                = types.NewMsgClawback
+	NewTransferFeePolicy          = types.NewTransferFeePolicy
+	NewMintPolicy                 = types.NewMintPolicy
+	TestIdentityString            = types.TestIdentityString
+	ValidateTokenSymbol           = types.ValidateTokenSymbol
 
 	DefaultParams = types.DefaultParams
 
 	// variable aliases
 
-	ModuleCdc = types.ModuleCdc
+	ModuleCdc     = types.ModuleCdc
+	MaxMintAmount = types.MaxMintAmount
 )
 
 type (
-	Keeper                  = keepers.BaseKeeper
-	BaseTokenKeeper         = keepers.BaseTokenKeeper
-	TokenKeeper             = keepers.TokenKeeper
-	Params                  = types.Params
-	GenesisState            = types.GenesisState
-	Token                   = types.Token
-	BaseToken               = types.BaseToken
-	MsgForbidToken          = types.MsgForbidToken
-	MsgForbidAddr           = types.MsgForbidAddr
-	MsgIssueToken           = types.MsgIssueToken
-	MsgTransferOwnership    = types.MsgTransferOwnership
-	MsgMintToken            = types.MsgMintToken
-	MsgBurnToken            = types.MsgBurnToken
-	MsgUnForbidToken        = types.MsgUnForbidToken
-	MsgAddTokenWhitelist    = types.MsgAddTokenWhitelist
-	MsgRemoveTokenWhitelist = types.MsgRemoveTokenWhitelist
-	MsgUnForbidAddr         = types.MsgUnForbidAddr
-	MsgModifyTokenInfo      = types.MsgModifyTokenInfo
+	Keeper                     = keepers.BaseKeeper
+	BaseTokenKeeper            = keepers.BaseTokenKeeper
+	TokenKeeper                = keepers.TokenKeeper
+	Params                     = types.Params
+	GenesisState               = types.GenesisState
+	Token                      = types.Token
+	BaseToken                  = types.BaseToken
+	MsgForbidToken             = types.MsgForbidToken
+	MsgForbidAddr              = types.MsgForbidAddr
+	MsgIssueToken              = types.MsgIssueToken
+	MsgTransferOwnership       = types.MsgTransferOwnership
+	MsgMintToken               = types.MsgMintToken
+	MsgBurnToken               = types.MsgBurnToken
+	MsgUnForbidToken           = types.MsgUnForbidToken
+	MsgAddTokenWhitelist       = types.MsgAddTokenWhitelist
+	MsgRemoveTokenWhitelist    = types.MsgRemoveTokenWhitelist
+	MsgUnForbidAddr            = types.MsgUnForbidAddr
+	MsgModifyTokenInfo         = types.MsgModifyTokenInfo
+	MsgCreateVestingSchedule   = types.MsgCreateVestingSchedule
+	MsgRevokeVestingSchedule   = types.MsgRevokeVestingSchedule
+	VestingSchedule            = types.VestingSchedule
+	TokenMetadata              = types.TokenMetadata
+	MetadataEntry              = types.MetadataEntry
+	MsgGrantTokenRole          = types.MsgGrantTokenRole
+	MsgRevokeTokenRole         = types.MsgRevokeTokenRole
+	MsgSetApprovalPolicy       = types.MsgSetApprovalPolicy
+	MsgApproveTokenOperation   = types.MsgApproveTokenOperation
+	MsgCancelTokenOperation    = types.MsgCancelTokenOperation
+	TokenRole                  = types.TokenRole
+	ApprovalPolicy             = types.ApprovalPolicy
+	TokenOperation             = types.TokenOperation
+	MsgAcceptOwnership         = types.MsgAcceptOwnership
+	MsgCancelOwnershipTransfer = types.MsgCancelOwnershipTransfer
+	PendingOwnership           = types.PendingOwnership
+	MsgSetMintPolicy           = types.MsgSetMintPolicy
+	MsgCreateHolderSnapshot    = types.MsgCreateHolderSnapshot
+	MsgCreateAirdrop           = types.MsgCreateAirdrop
+	HolderSnapshot             = types.HolderSnapshot
+	SnapshotHolder             = types.SnapshotHolder
+	Airdrop                    = types.Airdrop
+	MsgBidSymbol               = types.MsgBidSymbol
+	MsgDistributeDividend      = types.MsgDistributeDividend
+	MsgClaimDividends          = types.MsgClaimDividends
+	DividendPool               = types.DividendPool
+	DividendAccount            = types.DividendAccount
+	MsgSetTransferFee          = types.MsgSetTransferFee
+	MsgIssueTokens             = types.MsgIssueTokens
+	MsgClawback                = types.MsgClawback
+	TransferFeePolicy          = types.TransferFeePolicy
+	AdminAction                = types.AdminAction
+	SymbolAuction              = types.SymbolAuction
+	MintPolicy                 = types.MintPolicy
+	MintState                  = types.MintState
+	TokenFilter                = types.TokenFilter
 )
@@ -0,0 +1,159 @@
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func Test_Clawback(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	addrs := mockAddrListNoOwner()
+	holder, recipient := addrs[0], addrs[1]
+
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18)))
+	msg := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, true, true, true, "", "", types.TestIdentityString).WithClawbackable()
+	res := h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.tk.GetToken(input.ctx, "abc").GetClawbackable())
+	coins := types.NewTokenCoins("abc", sdk.NewInt(1000))
+	require.NoError(t, input.tk.SendCoinsFromAccountToAssetModule(input.ctx, testAddr, coins))
+	require.NoError(t, input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, holder, coins))
+
+	// the holder could be clawed back even if it is forbidden
+	res = h(input.ctx, asset.NewMsgForbidAddr("abc", testAddr, []sdk.AccAddress{holder}))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(300), recipient).
+		WithReason("court order 1"))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(700), input.tk.GetAccTotalToken(input.ctx, holder).AmountOf("abc").Int64())
+	require.Equal(t, int64(300), input.tk.GetAccTotalToken(input.ctx, recipient).AmountOf("abc").Int64())
+
+	// burnt without a recipient
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(200), nil))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(500), input.tk.GetAccTotalToken(input.ctx, holder).AmountOf("abc").Int64())
+	token := input.tk.GetToken(input.ctx, "abc")
+	require.Equal(t, int64(1900), token.GetTotalSupply().Int64())
+	require.Equal(t, int64(200), token.GetTotalBurn().Int64())
+
+	// recorded in the admin action log
+	actions := input.tk.GetAdminActions(input.ctx, "abc", 1, 2)
+	require.Len(t, actions, 2)
+	require.Equal(t, types.AdminActionClawback, actions[0].Action)
+	require.Equal(t, []sdk.AccAddress{holder}, actions[0].Addresses)
+	require.Equal(t, types.NewTokenCoins("abc", sdk.NewInt(200)), actions[0].Coins)
+	require.Equal(t, []sdk.AccAddress{holder, recipient}, actions[1].Addresses)
+	require.Equal(t, "court order 1", actions[1].Reason)
+	require.NoError(t, actions[1].Validate())
+
+	// only the owner could claw back, and no more than the holder has
+	res = h(input.ctx, asset.NewMsgClawback("abc", recipient, holder, sdk.NewInt(100), nil))
+	require.Equal(t, types.CodeNeedTokenOwner, res.Code)
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(501), nil))
+	require.False(t, res.IsOK())
+
+	// the token must be clawbackable
+	res = h(input.ctx, asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(2100), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgClawback("xyz", testAddr, holder, sdk.NewInt(1), nil))
+	require.Equal(t, types.CodeClawbackNotSupported, res.Code)
+}
+
+func Test_Clawback_LockedAndFrozen(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	holder, recipient := mockAddrListNoOwner()[0], mockAddrListNoOwner()[1]
+
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18)))
+	res := h(input.ctx, asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, true, true, true, "", "", types.TestIdentityString).WithClawbackable())
+	require.True(t, res.IsOK(), res.Log)
+	coins := types.NewTokenCoins("abc", sdk.NewInt(100))
+	require.NoError(t, input.tk.SendCoinsFromAccountToAssetModule(input.ctx, testAddr, coins))
+	require.NoError(t, input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, holder, coins))
+	input.bxk.SetParams(input.ctx, bankx.DefaultParams())
+	unlockTime := input.ctx.BlockHeader().Time.Unix() + 3600
+	require.NoError(t, input.bxk.SendLockedCoins(input.ctx, testAddr, holder, nil,
+		types.NewTokenCoins("abc", sdk.NewInt(300)), unlockTime, 0, false))
+	require.NoError(t, input.bxk.FreezeCoins(input.ctx, holder, types.NewTokenCoins("abc", sdk.NewInt(50))))
+
+	// the coins frozen out of market orders could not be recovered, which is reported
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(500), recipient))
+	require.Equal(t, types.CodeInvalidClawback, res.Code)
+	require.Contains(t, res.Log, "100 could not be recovered")
+	cachedCtx, _ := input.ctx.CacheContext()
+	res = h(cachedCtx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(400), recipient))
+	require.Equal(t, types.CodeInvalidClawback, res.Code)
+	require.Contains(t, res.Log, "50 could not be recovered, which is frozen out of market orders")
+
+	// the locked coins are unlocked after the spendable coins
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(250), recipient))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(0), input.bxk.GetCoins(input.ctx, holder).AmountOf("abc").Int64())
+	lockedCoins := input.bxk.GetLockedCoins(input.ctx, holder)
+	require.Len(t, lockedCoins, 1)
+	require.Equal(t, int64(100), lockedCoins[0].Coin.Amount.Int64())
+	require.Equal(t, int64(100), input.tk.GetToken(input.ctx, "abc").GetSendLock().Int64())
+	require.Equal(t, int64(250), input.tk.GetAccTotalToken(input.ctx, recipient).AmountOf("abc").Int64())
+
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), nil))
+	require.True(t, res.IsOK(), res.Log)
+	require.Empty(t, input.bxk.GetLockedCoins(input.ctx, holder))
+	require.True(t, input.tk.GetToken(input.ctx, "abc").GetSendLock().IsZero())
+	require.Equal(t, int64(50), input.bxk.GetFrozenCoins(input.ctx, holder).AmountOf("abc").Int64())
+}
+
+// mockOrderCanceller unfreezes the coins of an order of the holder when it is cancelled
+type mockOrderCanceller struct {
+	bxk       bankx.Keeper
+	frozen    sdk.Coins
+	cancelled int
+}
+
+func (oc *mockOrderCanceller) CancelOrdersOfHolder(ctx sdk.Context, holder sdk.AccAddress, denom string) []string {
+	oc.cancelled++
+	if err := oc.bxk.UnFreezeCoins(ctx, holder, oc.frozen); err != nil {
+		panic(err)
+	}
+	return []string{"order"}
+}
+
+func Test_Clawback_CancelOrdersOnShortage(t *testing.T) {
+	input := createTestInput()
+	holder := mockAddrListNoOwner()[0]
+	oc := &mockOrderCanceller{bxk: input.bxk, frozen: types.NewTokenCoins("abc", sdk.NewInt(60))}
+	input.tk.SetOrderCanceller(oc)
+	h := asset.NewHandler(input.tk)
+
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18)))
+	res := h(input.ctx, asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, true, true, true, "", "", types.TestIdentityString).WithClawbackable())
+	require.True(t, res.IsOK(), res.Log)
+	coins := types.NewTokenCoins("abc", sdk.NewInt(100))
+	require.NoError(t, input.tk.SendCoinsFromAccountToAssetModule(input.ctx, testAddr, coins))
+	require.NoError(t, input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, holder, coins))
+	require.NoError(t, input.bxk.FreezeCoins(input.ctx, holder, oc.frozen))
+
+	// the spendable coins are enough, so the orders are kept
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(40), nil))
+	require.True(t, res.IsOK(), res.Log)
+	require.Zero(t, oc.cancelled)
+
+	// the orders are cancelled for the shortage
+	res = h(input.ctx, asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(50), nil))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 1, oc.cancelled)
+	require.Equal(t, int64(10), input.bxk.GetCoins(input.ctx, holder).AmountOf("abc").Int64())
+	require.True(t, input.bxk.GetFrozenCoins(input.ctx, holder).AmountOf("abc").IsZero())
+}
@@ -11,6 +11,8 @@ const (
 	flagTokenURL         = "url"
 	flagTokenDescription = "description"
 	flagTokenIdentity    = "identity"
+	flagPermissioned     = "permissioned"
+	flagClawbackable     = "clawbackable"
 
 	flagClientHome  = "home-client"
 	flagOwner       = "owner"
@@ -22,4 +24,44 @@ const (
 	flagAmount    = "amount"
 	flagWhitelist = "whitelist"
 	flagAddresses = "addresses"
+
+	flagBeneficiary = "beneficiary"
+	flagStartTime   = "start-time"
+	flagCliffTime   = "cliff-time"
+	flagEndTime     = "end-time"
+	flagPeriod      = "period"
+	flagRevocable   = "revocable"
+
+	flagDecimals    = "decimals"
+	flagLogoURI     = "logo-uri"
+	flagLogoHash    = "logo-hash"
+	flagSocialLinks = "social-links"
+	flagAttributes  = "attributes"
+
+	flagRole      = "role"
+	flagAddress   = "address"
+	flagApprovers = "approvers"
+	flagThreshold = "threshold"
+
+	flagHardCap            = "hard-cap"
+	flagMintPeriod         = "mint-period"
+	flagMaxMintPerPeriod   = "max-mint-per-period"
+	flagInflationAmount    = "inflation-amount"
+	flagInflationPeriod    = "inflation-period"
+	flagInflationRecipient = "inflation-recipient"
+
+	flagDenom      = "denom"
+	flagHeight     = "height"
+	flagSnapshotID = "snapshot-id"
+
+	flagFeeRate     = "fee-rate"
+	flagFeeCap      = "fee-cap"
+	flagFeeReceiver = "fee-receiver"
+
+	flagReason    = "reason"
+	flagHolder    = "holder"
+	flagRecipient = "recipient"
+	flagForbidden = "forbidden"
+	flagPage      = "page"
+	flagLimit     = "limit"
 )
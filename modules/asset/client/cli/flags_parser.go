@@ -30,6 +30,34 @@ func parseIssueFlags(owner sdk.AccAddress) (*types.MsgIssueToken, error) {
 		return nil, types.ErrInvalidTokenSupply(flagTotalSupply)
 	}
 	msg := newMsgIssueToken(amt, owner)
+	if viper.GetBool(flagPermissioned) {
+		msg = msg.WithPermissioned()
+	}
+	if viper.GetBool(flagClawbackable) {
+		msg = msg.WithClawbackable()
+	}
+	return &msg, nil
+}
+
+func parseIssueTokensFile(path string, owner sdk.AccAddress) (*types.MsgIssueTokens, error) {
+	records, err := readTokenRecords(path)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]types.MsgIssueToken, 0, len(records))
+	var errs rowErrors
+	for i, record := range records {
+		token, err := record.toMsg(owner)
+		if err != nil {
+			errs.add(i+1, record.Symbol, err)
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgIssueTokens(owner, tokens)
 	return &msg, nil
 }
 
@@ -64,6 +92,24 @@ func parseTransferOwnershipFlags(orginalOwner sdk.AccAddress) (*types.MsgTransfe
 	return &msg, nil
 }
 
+func parseAcceptOwnershipFlags(newOwner sdk.AccAddress) (*types.MsgAcceptOwnership, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset accept-ownership -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAcceptOwnership(viper.GetString(flagSymbol), newOwner)
+	return &msg, nil
+}
+
+func parseCancelOwnershipTransferFlags(owner sdk.AccAddress) (*types.MsgCancelOwnershipTransfer, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset cancel-ownership-transfer -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgCancelOwnershipTransfer(viper.GetString(flagSymbol), owner)
+	return &msg, nil
+}
+
 func parseMintTokenFlags(owner sdk.AccAddress) (*types.MsgMintToken, error) {
 	if err := checkFlags(mintTokenFlags, "$ cetcli tx asset mint-token -h"); err != nil {
 		return nil, err
@@ -106,7 +152,7 @@ func parseForbidTokenFlags(owner sdk.AccAddress) (*types.MsgForbidToken, error)
 	msg := types.NewMsgForbidToken(
 		viper.GetString(flagSymbol),
 		owner,
-	)
+	).WithReason(viper.GetString(flagReason))
 
 	return &msg, nil
 }
@@ -119,7 +165,7 @@ func parseUnForbidTokenFlags(owner sdk.AccAddress) (*types.MsgUnForbidToken, err
 	msg := types.NewMsgUnForbidToken(
 		viper.GetString(flagSymbol),
 		owner,
-	)
+	).WithReason(viper.GetString(flagReason))
 
 	return &msg, nil
 }
@@ -197,7 +243,7 @@ func parseForbidAddrFlags(owner sdk.AccAddress) (*types.MsgForbidAddr, error) {
 		viper.GetString(flagSymbol),
 		owner,
 		addresses,
-	)
+	).WithReason(viper.GetString(flagReason))
 
 	return &msg, nil
 }
@@ -223,7 +269,7 @@ func parseUnForbidAddrFlags(owner sdk.AccAddress) (*types.MsgUnForbidAddr, error
 		viper.GetString(flagSymbol),
 		owner,
 		addresses,
-	)
+	).WithReason(viper.GetString(flagReason))
 
 	return &msg, nil
 }
@@ -243,5 +289,283 @@ func parseModifyTokenInfoFlags(owner sdk.AccAddress) (*types.MsgModifyTokenInfo,
 		viper.GetString(flagTokenForbiddable),
 	)
 
+	socialLinks, err := parseMetadataEntries(viper.GetString(flagSocialLinks))
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := parseMetadataEntries(viper.GetString(flagAttributes))
+	if err != nil {
+		return nil, err
+	}
+	msg = msg.WithMetadata(
+		viper.GetString(flagDecimals),
+		viper.GetString(flagLogoURI),
+		viper.GetString(flagLogoHash),
+		socialLinks,
+		attributes,
+	)
+
+	return &msg, nil
+}
+
+// parseMetadataEntries parses "k1=v1,k2=v2", in which "k=" removes the key
+func parseMetadataEntries(str string) ([]types.MetadataEntry, error) {
+	if str == "" {
+		return nil, nil
+	}
+	var entries []types.MetadataEntry
+	for _, kv := range strings.Split(str, ",") {
+		pair := strings.SplitN(kv, "=", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid key/value pair: %s", kv)
+		}
+		entries = append(entries, types.MetadataEntry{Key: pair[0], Value: pair[1]})
+	}
+	return entries, nil
+}
+
+func parseCreateVestingFlags(creator sdk.AccAddress) (*types.MsgCreateVestingSchedule, error) {
+	if err := checkFlags(createVestingFlags, "$ cetcli tx asset create-vesting -h"); err != nil {
+		return nil, err
+	}
+	amt, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidVestingSchedule("invalid amount")
+	}
+	beneficiary, err := sdk.AccAddressFromBech32(viper.GetString(flagBeneficiary))
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgCreateVestingSchedule(
+		creator,
+		beneficiary,
+		viper.GetString(flagSymbol),
+		amt,
+		viper.GetInt64(flagStartTime),
+		viper.GetInt64(flagCliffTime),
+		viper.GetInt64(flagEndTime),
+		viper.GetInt64(flagPeriod),
+		viper.GetBool(flagRevocable),
+	)
+
+	return &msg, nil
+}
+
+func parseTokenRoleFlags(help string) (symbol, role string, addr sdk.AccAddress, err error) {
+	if err = checkFlags(tokenRoleFlags, help); err != nil {
+		return
+	}
+	if addr, err = sdk.AccAddressFromBech32(viper.GetString(flagAddress)); err != nil {
+		return
+	}
+	return viper.GetString(flagSymbol), viper.GetString(flagRole), addr, nil
+}
+
+func parseGrantRoleFlags(owner sdk.AccAddress) (*types.MsgGrantTokenRole, error) {
+	symbol, role, addr, err := parseTokenRoleFlags("$ cetcli tx asset grant-role -h")
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgGrantTokenRole(symbol, owner, role, addr)
+	return &msg, nil
+}
+
+func parseRevokeRoleFlags(owner sdk.AccAddress) (*types.MsgRevokeTokenRole, error) {
+	symbol, role, addr, err := parseTokenRoleFlags("$ cetcli tx asset revoke-role -h")
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgRevokeTokenRole(symbol, owner, role, addr)
+	return &msg, nil
+}
+
+func parseSetApprovalPolicyFlags(owner sdk.AccAddress) (*types.MsgSetApprovalPolicy, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-approval-policy -h"); err != nil {
+		return nil, err
+	}
+
+	approvers := make([]sdk.AccAddress, 0)
+	if str := viper.GetString(flagApprovers); str != "" {
+		for _, s := range strings.Split(str, ",") {
+			addr, err := sdk.AccAddressFromBech32(s)
+			if err != nil {
+				return nil, err
+			}
+			approvers = append(approvers, addr)
+		}
+	}
+
+	msg := types.NewMsgSetApprovalPolicy(
+		viper.GetString(flagSymbol),
+		owner,
+		approvers,
+		viper.GetInt64(flagThreshold),
+	)
+
+	return &msg, nil
+}
+
+// parseOptionalInt parses an optional integer flag, in which the empty string means zero
+func parseOptionalInt(flag string) (sdk.Int, error) {
+	str := viper.GetString(flag)
+	if str == "" {
+		return sdk.ZeroInt(), nil
+	}
+	amt, ok := sdk.NewIntFromString(str)
+	if !ok {
+		return sdk.Int{}, types.ErrInvalidMintPolicy("invalid " + flag)
+	}
+	return amt, nil
+}
+
+func parseSetMintPolicyFlags(owner sdk.AccAddress) (*types.MsgSetMintPolicy, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-mint-policy -h"); err != nil {
+		return nil, err
+	}
+	hardCap, err := parseOptionalInt(flagHardCap)
+	if err != nil {
+		return nil, err
+	}
+	maxMint, err := parseOptionalInt(flagMaxMintPerPeriod)
+	if err != nil {
+		return nil, err
+	}
+	inflation, err := parseOptionalInt(flagInflationAmount)
+	if err != nil {
+		return nil, err
+	}
+	var recipient sdk.AccAddress
+	if str := viper.GetString(flagInflationRecipient); str != "" {
+		if recipient, err = sdk.AccAddressFromBech32(str); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := types.NewMsgSetMintPolicy(
+		viper.GetString(flagSymbol),
+		owner,
+		types.NewMintPolicy(
+			hardCap,
+			viper.GetInt64(flagMintPeriod),
+			maxMint,
+			inflation,
+			viper.GetInt64(flagInflationPeriod),
+			recipient,
+		),
+	)
+
+	return &msg, nil
+}
+
+func parseCreateSnapshotFlags(creator sdk.AccAddress) (*types.MsgCreateHolderSnapshot, error) {
+	if err := checkFlags(createSnapshotFlags, "$ cetcli tx asset create-snapshot -h"); err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgCreateHolderSnapshot(creator, viper.GetString(flagDenom), viper.GetInt64(flagHeight))
+	return &msg, nil
+}
+
+func parseCreateAirdropFlags(sender sdk.AccAddress) (*types.MsgCreateAirdrop, error) {
+	if err := checkFlags(createAirdropFlags, "$ cetcli tx asset airdrop -h"); err != nil {
+		return nil, err
+	}
+	amount, err := sdk.ParseCoin(viper.GetString(flagAmount))
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgCreateAirdrop(sender, viper.GetUint64(flagSnapshotID), amount)
+	return &msg, nil
+}
+
+func parseBidSymbolFlags(bidder sdk.AccAddress) (*types.MsgBidSymbol, error) {
+	if err := checkFlags(bidSymbolFlags, "$ cetcli tx asset bid-symbol -h"); err != nil {
+		return nil, err
+	}
+	amt, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid amount")
+	}
+	msg := types.NewMsgBidSymbol(bidder, viper.GetString(flagSymbol), amt)
+	return &msg, nil
+}
+
+func parseDistributeDividendFlags(sender sdk.AccAddress) (*types.MsgDistributeDividend, error) {
+	if err := checkFlags(distributeDividendFlags, "$ cetcli tx asset distribute-dividend -h"); err != nil {
+		return nil, err
+	}
+	amount, err := sdk.ParseCoin(viper.GetString(flagAmount))
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgDistributeDividend(sender, viper.GetString(flagSymbol), amount)
+	return &msg, nil
+}
+
+func parseClaimDividendsFlags(holder sdk.AccAddress) (*types.MsgClaimDividends, error) {
+	if err := checkFlags(claimDividendsFlags, "$ cetcli tx asset claim-dividends -h"); err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgClaimDividends(holder, viper.GetString(flagSymbol))
+	return &msg, nil
+}
+
+func parseSetTransferFeeFlags(owner sdk.AccAddress) (*types.MsgSetTransferFee, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-transfer-fee -h"); err != nil {
+		return nil, err
+	}
+	symbol := viper.GetString(flagSymbol)
+	rate := viper.GetInt64(flagFeeRate)
+	if rate == 0 {
+		msg := types.NewMsgSetTransferFee(symbol, owner, nil)
+		return &msg, nil
+	}
+
+	feeCap := sdk.ZeroInt()
+	if str := viper.GetString(flagFeeCap); str != "" {
+		var ok bool
+		if feeCap, ok = sdk.NewIntFromString(str); !ok {
+			return nil, types.ErrInvalidTransferFee("invalid " + flagFeeCap)
+		}
+	}
+	var receiver sdk.AccAddress
+	if str := viper.GetString(flagFeeReceiver); str != "" {
+		var err error
+		if receiver, err = sdk.AccAddressFromBech32(str); err != nil {
+			return nil, err
+		}
+	}
+
+	policy := types.NewTransferFeePolicy(rate, feeCap, receiver)
+	msg := types.NewMsgSetTransferFee(symbol, owner, &policy)
+	return &msg, nil
+}
+
+var clawbackFlags = []string{
+	flagSymbol,
+	flagHolder,
+	flagAmount,
+}
+
+func parseClawbackFlags(owner sdk.AccAddress) (*types.MsgClawback, error) {
+	if err := checkFlags(clawbackFlags, "$ cetcli tx asset clawback -h"); err != nil {
+		return nil, err
+	}
+	holder, err := sdk.AccAddressFromBech32(viper.GetString(flagHolder))
+	if err != nil {
+		return nil, err
+	}
+	amt, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidClawback("invalid " + flagAmount)
+	}
+	var recipient sdk.AccAddress
+	if str := viper.GetString(flagRecipient); str != "" {
+		if recipient, err = sdk.AccAddressFromBech32(str); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := types.NewMsgClawback(viper.GetString(flagSymbol), owner, holder, amt, recipient).
+		WithReason(viper.GetString(flagReason))
 	return &msg, nil
 }
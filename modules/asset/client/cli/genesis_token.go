@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
@@ -116,6 +117,8 @@ $ cetd add-genesis-token --name="CoinEx Chain Native Token" \
 	cmd.Flags().String(flagTokenURL, "", "url of token website")
 	cmd.Flags().String(flagTokenDescription, "", "description of token info")
 	cmd.Flags().String(flagTokenIdentity, "", "identity of token")
+	cmd.Flags().Bool(flagPermissioned, false, "whether only the owner and the whitelisted addresses could receive the token")
+	cmd.Flags().Bool(flagClawbackable, false, "whether the owner could claw back the token from its holders")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range tokenFlags {
@@ -125,6 +128,133 @@ $ cetd add-genesis-token --name="CoinEx Chain Native Token" \
 	return cmd
 }
 
+// ImportGenesisTokensCmd returns import-genesis-tokens cobra Command.
+func ImportGenesisTokensCmd(ctx *server.Context, cdc *codec.Codec,
+	defaultNodeHome, defaultClientHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-genesis-tokens [file]",
+		Short: "Import genesis tokens and their initial balances from a csv or json file to genesis.json",
+		Long: strings.TrimSpace(
+			`Import genesis tokens and their initial balances from a csv or json file to genesis.json.
+Every token is validated as add-genesis-token does, and nothing is imported if any row is invalid.
+The columns of a csv file are the fields of a token in a json file, and the empty bool or amount
+columns default to false or 0. The initial balances are added to the genesis accounts, and they are
+written as "address:amount" separated by ";" in a csv file, whose sum must not exceed the total supply.
+
+Example:
+$ cat tokens.csv
+name,symbol,owner,total_supply,mintable,burnable,balances
+ABC Token,abc,ownerkey,2100000000000000,false,true,coinex1...:100000000;coinex1...:200000000
+$ cetd import-genesis-tokens tokens.csv
+
+$ cat tokens.json
+[{"name": "ABC Token", "symbol": "abc", "owner": "ownerkey", "total_supply": "2100000000000000",
+  "burnable": true, "balances": [{"address": "coinex1...", "amount": "100000000"}]}]
+$ cetd import-genesis-tokens tokens.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			records, err := readTokenRecords(args[0])
+			if err != nil {
+				return err
+			}
+
+			// retrieve the app state
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			var genesisState types.GenesisState
+			cdc.MustUnmarshalJSON(appState[types.ModuleName], &genesisState)
+			var genesisAccounts genaccounts.GenesisAccounts
+			cdc.MustUnmarshalJSON(appState[genaccounts.ModuleName], &genesisAccounts)
+
+			if err = importGenesisTokens(&genesisState, &genesisAccounts, records); err != nil {
+				return err
+			}
+
+			appState[types.ModuleName] = cdc.MustMarshalJSON(genesisState)
+			appState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genesisAccounts)
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			// export app state
+			genDoc.AppState = appStateJSON
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+
+	return cmd
+}
+
+// importGenesisTokens adds all the tokens and their balances to the genesis state,
+// or nothing with the errors of all the invalid rows
+func importGenesisTokens(genesisState *types.GenesisState, genesisAccounts *genaccounts.GenesisAccounts,
+	records []tokenRecord) error {
+
+	tokens := make([]types.Token, 0, len(records))
+	balances := make([][]tokenBalance, 0, len(records))
+	symbols := make(map[string]bool, len(genesisState.Tokens)+len(records))
+	for _, t := range genesisState.Tokens {
+		symbols[t.GetSymbol()] = true
+	}
+
+	var errs rowErrors
+	for i, record := range records {
+		token, err := record.toToken()
+		if err != nil {
+			errs.add(i+1, record.Symbol, err)
+			continue
+		}
+		if symbols[token.GetSymbol()] {
+			errs.add(i+1, record.Symbol, fmt.Errorf("the application state already contains token %s", token.GetSymbol()))
+			continue
+		}
+		symbols[token.GetSymbol()] = true
+		tokenBalances, err := record.toBalances(token.GetTotalSupply())
+		if err != nil {
+			errs.add(i+1, record.Symbol, err)
+			continue
+		}
+		tokens = append(tokens, token)
+		balances = append(balances, tokenBalances)
+	}
+	if err := errs.err(); err != nil {
+		return err
+	}
+
+	for i, token := range tokens {
+		for _, b := range balances[i] {
+			addGenesisBalance(genesisAccounts, b.Address, sdk.NewCoin(token.GetSymbol(), b.Amount))
+		}
+	}
+	genesisState.Tokens = append(genesisState.Tokens, tokens...)
+	return nil
+}
+
+func addGenesisBalance(genesisAccounts *genaccounts.GenesisAccounts, addr sdk.AccAddress, coin sdk.Coin) {
+	for i, acc := range *genesisAccounts {
+		if acc.Address.Equals(addr) {
+			(*genesisAccounts)[i].Coins = acc.Coins.Add(sdk.NewCoins(coin))
+			return
+		}
+	}
+	acc := genaccounts.NewGenesisAccountRaw(addr, sdk.NewCoins(coin), sdk.NewCoins(), 0, 0, "", "")
+	*genesisAccounts = append(*genesisAccounts, acc)
+}
+
 func parseTokenInfo() (types.Token, error) {
 	token := &types.BaseToken{}
 	var err error
@@ -183,6 +313,8 @@ func parseTokenInfo() (types.Token, error) {
 	token.SetAddrForbiddable(viper.GetBool(flagAddrForbiddable))
 	token.SetTokenForbiddable(viper.GetBool(flagTokenForbiddable))
 	token.SetIsForbidden(viper.GetBool(flagTokenForbiddable))
+	token.SetPermissioned(viper.GetBool(flagPermissioned))
+	token.SetClawbackable(viper.GetBool(flagClawbackable))
 
 	return token, nil
 }
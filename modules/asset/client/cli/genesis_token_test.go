@@ -1,13 +1,17 @@
 package cli
 
 import (
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -61,3 +65,51 @@ func TestParseTokenInfo(t *testing.T) {
 	token, _ := parseTokenInfo()
 	require.Equal(t, "aaa", token.GetSymbol())
 }
+
+func TestImportGenesisTokens(t *testing.T) {
+	owner := "coinex1paehyhx9sxdfwc3rjf85vwn6kjnmzjemtedpnl"
+	holder := "coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5"
+	csvFile := `name,symbol,owner,total_supply,mintable,burnable,identity,balances
+ABC Token,abc,` + owner + `,2100,false,true,552A83BA62F9B1F8,` + owner + `:100;` + holder + `:200
+XYZ Token,xyz,` + owner + `,1000,true,,552A83BA62F9B1F8,
+`
+	records, err := parseCSVTokenRecords(strings.NewReader(csvFile))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Len(t, records[0].Balances, 2)
+
+	jsonRecords := []tokenRecord{}
+	err = json.Unmarshal([]byte(`[{"name": "DEF Token", "symbol": "def", "owner": "`+owner+`",
+		"total_supply": 500, "mintable": true, "identity": "552A83BA62F9B1F8"}]`), &jsonRecords)
+	require.NoError(t, err)
+	require.Equal(t, recordField("true"), jsonRecords[0].Mintable)
+	records = append(records, jsonRecords...)
+
+	genesis := types.GenesisState{}
+	accounts := genaccounts.GenesisAccounts{}
+	require.NoError(t, importGenesisTokens(&genesis, &accounts, records))
+	require.Len(t, genesis.Tokens, 3)
+	require.True(t, genesis.Tokens[2].GetMintable())
+	require.Len(t, accounts, 2)
+	require.Equal(t, int64(100), accounts[0].Coins.AmountOf("abc").Int64())
+	require.Equal(t, int64(200), accounts[1].Coins.AmountOf("abc").Int64())
+
+	// nothing is imported with the errors of all the invalid rows
+	csvFile = `name,symbol,owner,total_supply,total_mint,identity,balances
+ABC Token,abc,` + owner + `,2100,,552A83BA62F9B1F8,
+GHI Token,ghi,` + owner + `,2100,100,552A83BA62F9B1F8,
+JKL Token,jkl,` + owner + `,100,,552A83BA62F9B1F8,` + holder + `:200
+`
+	records, err = parseCSVTokenRecords(strings.NewReader(csvFile))
+	require.NoError(t, err)
+	err = importGenesisTokens(&genesis, &accounts, records)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "3 invalid rows")
+	require.Contains(t, err.Error(), "row 1 (abc): the application state already contains token abc")
+	require.Contains(t, err.Error(), "row 2 (ghi): token ghi do not support mint")
+	require.Contains(t, err.Error(), "row 3 (jkl): the balances 200 exceed the total supply 100")
+	require.Len(t, genesis.Tokens, 3)
+
+	_, err = parseCSVTokenRecords(strings.NewReader("name,symbol,decimals\n"))
+	require.Error(t, err)
+}
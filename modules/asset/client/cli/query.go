@@ -2,11 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
@@ -27,6 +30,20 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryTokenWhitelist(types.QuerierRoute, cdc),
 		GetCmdQueryTokenForbiddenAddr(types.QuerierRoute, cdc),
 		GetCmdQueryTokenReservedSymbols(types.QuerierRoute, cdc),
+		GetCmdQueryVesting(types.QuerierRoute, cdc),
+		GetCmdQueryVestings(types.QuerierRoute, cdc),
+		GetCmdQueryTokenRoles(types.QuerierRoute, cdc),
+		GetCmdQueryApprovalPolicy(types.QuerierRoute, cdc),
+		GetCmdQueryTokenOperations(types.QuerierRoute, cdc),
+		GetCmdQueryPendingOwnership(types.QuerierRoute, cdc),
+		GetCmdQueryHolderSnapshot(types.QuerierRoute, cdc),
+		GetCmdQueryAirdrop(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolAuction(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolAuctions(types.QuerierRoute, cdc),
+		GetCmdQueryDividendPools(types.QuerierRoute, cdc),
+		GetCmdQueryClaimableDividends(types.QuerierRoute, cdc),
+		GetCmdQueryAdminActions(types.QuerierRoute, cdc),
+		GetCmdQuerySearchTokens(types.QuerierRoute, cdc),
 	)...)
 
 	return assQueryCmd
@@ -167,3 +184,423 @@ $ cetcli query asset reserved-symbols
 	}
 	return cmd
 }
+
+// GetCmdQueryVesting returns a vesting schedule by its id
+func GetCmdQueryVesting(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedule [id]",
+		Short: "Query a vesting schedule",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a vesting schedule by its id".
+
+Example:
+$ cetcli query asset vesting-schedule 1
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVesting)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryVestingParams(id)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryVestings returns the vesting schedules of a beneficiary
+func GetCmdQueryVestings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedules [beneficiary]",
+		Short: "Query the vesting schedules of a beneficiary",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vesting schedules of a beneficiary".
+
+Example:
+$ cetcli query asset vesting-schedules coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVestings)
+			beneficiary, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryVestingsParams(beneficiary)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenRoles returns the roles granted on a token
+func GetCmdQueryTokenRoles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-roles [symbol]",
+		Short: "Query the roles granted on a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the roles granted on a token, the token owner has all the roles".
+
+Example:
+$ cetcli query asset token-roles abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenRoles)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryApprovalPolicy returns the approval policy of a token
+func GetCmdQueryApprovalPolicy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approval-policy [symbol]",
+		Short: "Query the approval policy of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the approvers and threshold for minting and ownership transfer of a token".
+
+Example:
+$ cetcli query asset approval-policy abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryApprovalPolicy)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenOperations returns the operations waiting for approvals
+func GetCmdQueryTokenOperations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-operations [symbol]",
+		Short: "Query the operations waiting for approvals",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mint and ownership transfer operations of a token waiting for approvals".
+
+Example:
+$ cetcli query asset token-operations abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOperations)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryPendingOwnership returns the pending ownership transfer of a token
+func GetCmdQueryPendingOwnership(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-ownership [symbol]",
+		Short: "Query the pending ownership transfer of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the new owner and the deadline of the ownership transfer waiting for acceptance".
+
+Example:
+$ cetcli query asset pending-ownership abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingOwner)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryHolderSnapshot returns a holder snapshot by its id
+func GetCmdQueryHolderSnapshot(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder-snapshot [id]",
+		Short: "Query a holder snapshot",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a holder snapshot by its id".
+
+Example:
+$ cetcli query asset holder-snapshot 1
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHolderSnapshot)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryHolderSnapshotParams(id)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryAirdrop returns an airdrop and its progress by its id
+func GetCmdQueryAirdrop(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop [id]",
+		Short: "Query an airdrop and its progress",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an airdrop and its progress by its id".
+
+Example:
+$ cetcli query asset airdrop 1
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAirdrop)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryAirdropParams(id)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySymbolAuction returns the auction of a symbol
+func GetCmdQuerySymbolAuction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-auction [symbol]",
+		Short: "Query the auction of a symbol",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the highest bid and the end time of the auction of a symbol".
+
+Example:
+$ cetcli query asset symbol-auction btc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySymbolAuction)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySymbolAuctions returns all the symbol auctions
+func GetCmdQuerySymbolAuctions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-auctions",
+		Short: "Query all the symbol auctions",
+		Args:  cobra.ExactArgs(0),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the symbol auctions in progress and the won symbols not issued yet".
+
+Example:
+$ cetcli query asset symbol-auctions
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySymbolAuctions)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryDividendPools returns the dividend pools of a token
+func GetCmdQueryDividendPools(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dividend-pools [symbol]",
+		Short: "Query the dividends paid to the holders of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dividends paid to the holders of a token, one pool for each denom".
+
+Example:
+$ cetcli query asset dividend-pools abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDividendPools)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryClaimableDividends returns the dividends a holder can claim
+func GetCmdQueryClaimableDividends(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-dividends [symbol] [address]",
+		Short: "Query the dividends a holder of a token can claim",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dividends a holder of a token can claim now".
+
+Example:
+$ cetcli query asset claimable-dividends abc coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryClaimable)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			holder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryClaimableParams(symbol, holder)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryAdminActions returns a page of the admin action log of a token
+func GetCmdQueryAdminActions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-actions [symbol]",
+		Short: "Query the forbid and unforbid actions taken on a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query who forbade or unforbade a token or its holders, when and why, the latest first".
+
+Example:
+$ cetcli query asset admin-actions abc --page=1 --limit=30
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAdminActions)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAdminActionsParams(symbol, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	cmd.Flags().Int(flagPage, 1, "the page number, starting from 1")
+	cmd.Flags().Int(flagLimit, types.DefaultAdminActionsLimit, "the number of actions in a page")
+	return cmd
+}
+
+// GetCmdQuerySearchTokens returns a page of the tokens found by a query and filters
+func GetCmdQuerySearchTokens(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-tokens [query]",
+		Short: "Search tokens by symbol prefix, name or description",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Search the tokens whose name or description has words starting with each word of the query,
+ignoring case. The tokens could also be filtered by symbol prefix, owner, mintable and forbidden, and must
+match all the filters given. They are ordered by symbol.
+
+Example:
+$ cetcli query asset search-tokens dollar --symbol=ab --mintable=true --forbidden=false --page=1 --limit=30
+$ cetcli query asset search-tokens --owner=coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenSearch)
+			filter, err := parseTokenFilterFlags(args)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryTokenSearchParams(filter, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	cmd.Flags().String(flagSymbol, "", "only the tokens whose symbol starts with it")
+	cmd.Flags().String(flagOwner, "", "only the tokens of the owner")
+	cmd.Flags().String(flagMintable, "", "only the mintable (true) or unmintable (false) tokens")
+	cmd.Flags().String(flagForbidden, "", "only the forbidden (true) or unforbidden (false) tokens")
+	cmd.Flags().Int(flagPage, 1, "the page number, starting from 1")
+	cmd.Flags().Int(flagLimit, types.DefaultTokenSearchLimit, "the number of tokens in a page")
+	return cmd
+}
+
+func parseTokenFilterFlags(args []string) (types.TokenFilter, error) {
+	filter := types.TokenFilter{Symbol: viper.GetString(flagSymbol)}
+	if len(args) > 0 {
+		filter.Query = args[0]
+	}
+	if owner := viper.GetString(flagOwner); owner != "" {
+		addr, err := sdk.AccAddressFromBech32(owner)
+		if err != nil {
+			return filter, err
+		}
+		filter.Owner = addr
+	}
+	for _, f := range []struct {
+		flag  string
+		value **bool
+	}{
+		{flagMintable, &filter.Mintable},
+		{flagForbidden, &filter.Forbidden},
+	} {
+		s := viper.GetString(f.flag)
+		if s == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s %s", f.flag, s)
+		}
+		*f.value = &b
+	}
+	return filter, filter.Validate()
+}
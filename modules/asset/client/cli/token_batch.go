@@ -0,0 +1,364 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// tokenRecord is a token read from a batch file, in which the bool and amount fields
+// are kept as strings so that the CSV and JSON files share the same parsing.
+// The owner could be an address or a key name, and is ignored by the issue-tokens tx.
+type tokenRecord struct {
+	Name             string          `json:"name"`
+	Symbol           string          `json:"symbol"`
+	Owner            string          `json:"owner"`
+	TotalSupply      recordField     `json:"total_supply"`
+	Mintable         recordField     `json:"mintable"`
+	Burnable         recordField     `json:"burnable"`
+	AddrForbiddable  recordField     `json:"addr_forbiddable"`
+	TokenForbiddable recordField     `json:"token_forbiddable"`
+	TotalBurn        recordField     `json:"total_burn"`
+	TotalMint        recordField     `json:"total_mint"`
+	IsForbidden      recordField     `json:"is_forbidden"`
+	URL              string          `json:"url"`
+	Description      string          `json:"description"`
+	Identity         string          `json:"identity"`
+	Permissioned     recordField     `json:"permissioned"`
+	Clawbackable     recordField     `json:"clawbackable"`
+	Balances         []recordBalance `json:"balances"`
+}
+
+// recordField is a bool or amount field of a token record,
+// which could be written as a JSON string, bool or number
+type recordField string
+
+func (f *recordField) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err == nil {
+		*f = recordField(s)
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return err
+	}
+	switch v.(type) {
+	case nil:
+		*f = ""
+	case bool, float64:
+		*f = recordField(bz)
+	default:
+		return fmt.Errorf("invalid field %s", bz)
+	}
+	return nil
+}
+
+// recordBalance is an initial balance of a genesis token, which is written as
+// "address:amount" and separated by ";" in the balances column of a CSV file.
+type recordBalance struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// the columns of a CSV file, and its header must have the name and symbol columns at least
+var tokenRecordColumns = []string{
+	"name",
+	"symbol",
+	"owner",
+	"total_supply",
+	"mintable",
+	"burnable",
+	"addr_forbiddable",
+	"token_forbiddable",
+	"total_burn",
+	"total_mint",
+	"is_forbidden",
+	"url",
+	"description",
+	"identity",
+	"permissioned",
+	"clawbackable",
+	"balances",
+}
+
+// rowErrors collects the errors of a batch file, and the rows are numbered from 1,
+// which is the first token after the header of a CSV file
+type rowErrors []string
+
+func (errs *rowErrors) add(row int, symbol string, err error) {
+	*errs = append(*errs, fmt.Sprintf("row %d (%s): %s", row, symbol, errorReason(err)))
+}
+
+func (errs rowErrors) err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d invalid rows:\n%s", len(errs), strings.Join(errs, "\n"))
+}
+
+// errorReason strips the codespace and code of sdk errors, which are noisy in a row error
+func errorReason(err error) string {
+	if sdkErr, ok := err.(sdk.Error); ok {
+		if data, ok := sdkErr.Data().(error); ok {
+			return data.Error()
+		}
+	}
+	return err.Error()
+}
+
+// readTokenRecords reads the tokens from a .csv file or a .json file holding an array of tokens
+func readTokenRecords(path string) ([]tokenRecord, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return parseCSVTokenRecords(file)
+	case ".json":
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var records []tokenRecord
+		if err := json.Unmarshal(bz, &records); err != nil {
+			return nil, err
+		}
+		return records, nil
+	default:
+		return nil, fmt.Errorf("unsupported file %s, which must be a .csv or .json file", path)
+	}
+}
+
+func parseCSVTokenRecords(r io.Reader) ([]tokenRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the csv header: %s", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !isTokenRecordColumn(column) {
+			return nil, fmt.Errorf("unknown csv column %s", column)
+		}
+		columns[column] = i
+	}
+	for _, column := range []string{"name", "symbol"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing csv column %s", column)
+		}
+	}
+
+	var records []tokenRecord
+	var errs rowErrors
+	for row := 1; ; row++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		get := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(fields[i])
+			}
+			return ""
+		}
+		record := tokenRecord{
+			Name:             get("name"),
+			Symbol:           get("symbol"),
+			Owner:            get("owner"),
+			TotalSupply:      recordField(get("total_supply")),
+			Mintable:         recordField(get("mintable")),
+			Burnable:         recordField(get("burnable")),
+			AddrForbiddable:  recordField(get("addr_forbiddable")),
+			TokenForbiddable: recordField(get("token_forbiddable")),
+			TotalBurn:        recordField(get("total_burn")),
+			TotalMint:        recordField(get("total_mint")),
+			IsForbidden:      recordField(get("is_forbidden")),
+			URL:              get("url"),
+			Description:      get("description"),
+			Identity:         get("identity"),
+			Permissioned:     recordField(get("permissioned")),
+			Clawbackable:     recordField(get("clawbackable")),
+		}
+		if record.Balances, err = parseRecordBalances(get("balances")); err != nil {
+			errs.add(row, record.Symbol, err)
+		}
+		records = append(records, record)
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func isTokenRecordColumn(column string) bool {
+	for _, c := range tokenRecordColumns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRecordBalances(s string) ([]recordBalance, error) {
+	var balances []recordBalance
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid balance %s, which must be address:amount", item)
+		}
+		balances = append(balances, recordBalance{
+			Address: strings.TrimSpace(parts[0]),
+			Amount:  strings.TrimSpace(parts[1]),
+		})
+	}
+	return balances, nil
+}
+
+func parseRecordBool(field string, s recordField) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(string(s))
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %s", field, s)
+	}
+	return b, nil
+}
+
+func parseRecordAmount(field string, s recordField) (sdk.Int, error) {
+	if s == "" {
+		return sdk.ZeroInt(), nil
+	}
+	amt, ok := sdk.NewIntFromString(string(s))
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid %s %s", field, s)
+	}
+	return amt, nil
+}
+
+// toMsg returns the MsgIssueToken of the record, which is validated by the tx
+func (r tokenRecord) toMsg(owner sdk.AccAddress) (types.MsgIssueToken, error) {
+	var flags [6]bool
+	for i, field := range []struct {
+		name  string
+		value recordField
+	}{
+		{"mintable", r.Mintable},
+		{"burnable", r.Burnable},
+		{"addr_forbiddable", r.AddrForbiddable},
+		{"token_forbiddable", r.TokenForbiddable},
+		{"permissioned", r.Permissioned},
+		{"clawbackable", r.Clawbackable},
+	} {
+		b, err := parseRecordBool(field.name, field.value)
+		if err != nil {
+			return types.MsgIssueToken{}, err
+		}
+		flags[i] = b
+	}
+	if r.TotalSupply == "" {
+		return types.MsgIssueToken{}, errors.New("total_supply is required")
+	}
+	amt, err := parseRecordAmount("total_supply", r.TotalSupply)
+	if err != nil {
+		return types.MsgIssueToken{}, err
+	}
+
+	msg := types.NewMsgIssueToken(r.Name, r.Symbol, amt, owner,
+		flags[0], flags[1], flags[2], flags[3], r.URL, r.Description, r.Identity)
+	if flags[4] {
+		msg = msg.WithPermissioned()
+	}
+	if flags[5] {
+		msg = msg.WithClawbackable()
+	}
+	return msg, nil
+}
+
+// toToken returns the genesis token of the record, which is validated as BaseToken.Validate does
+func (r tokenRecord) toToken() (types.Token, error) {
+	if r.Owner == "" {
+		return nil, errors.New("owner is required")
+	}
+	owner, err := getAddress(r.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner %s: %s", r.Owner, err)
+	}
+	msg, err := r.toMsg(owner)
+	if err != nil {
+		return nil, err
+	}
+	token, sdkErr := types.NewToken(msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
+		msg.Mintable, msg.Burnable, msg.AddrForbiddable, msg.TokenForbiddable, msg.URL, msg.Description, msg.Identity)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	if token.TotalBurn, err = parseRecordAmount("total_burn", r.TotalBurn); err != nil {
+		return nil, err
+	}
+	if token.TotalMint, err = parseRecordAmount("total_mint", r.TotalMint); err != nil {
+		return nil, err
+	}
+	if token.IsForbidden, err = parseRecordBool("is_forbidden", r.IsForbidden); err != nil {
+		return nil, err
+	}
+	token.SetPermissioned(msg.Permissioned)
+	token.SetClawbackable(msg.Clawbackable)
+
+	if sdkErr := token.Validate(); sdkErr != nil {
+		return nil, sdkErr
+	}
+	return token, nil
+}
+
+// tokenBalance is a parsed initial balance of a genesis token
+type tokenBalance struct {
+	Address sdk.AccAddress
+	Amount  sdk.Int
+}
+
+// toBalances returns the initial balances of the record, whose sum must not exceed the total supply
+func (r tokenRecord) toBalances(totalSupply sdk.Int) ([]tokenBalance, error) {
+	balances := make([]tokenBalance, 0, len(r.Balances))
+	sum := sdk.ZeroInt()
+	for _, b := range r.Balances {
+		addr, err := getAddress(b.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance address %s: %s", b.Address, err)
+		}
+		amt, ok := sdk.NewIntFromString(b.Amount)
+		if !ok || !amt.IsPositive() {
+			return nil, fmt.Errorf("invalid balance amount %s", b.Amount)
+		}
+		balances = append(balances, tokenBalance{Address: addr, Amount: amt})
+		sum = sum.Add(amt)
+	}
+	if sum.GT(totalSupply) {
+		return nil, fmt.Errorf("the balances %s exceed the total supply %s", sum, totalSupply)
+	}
+	return balances, nil
+}
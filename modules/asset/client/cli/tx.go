@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -34,7 +35,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	assTxCmd.AddCommand(client.PostCommands(
 		GetCmdIssueToken(types.QuerierRoute, cdc),
+		GetCmdIssueTokens(cdc),
 		GetCmdTransferOwnership(cdc),
+		GetCmdAcceptOwnership(cdc),
+		GetCmdCancelOwnershipTransfer(cdc),
 		GetCmdMintToken(cdc),
 		GetCmdBurnToken(cdc),
 		GetCmdForbidToken(cdc),
@@ -44,6 +48,21 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdForbidAddr(cdc),
 		GetCmdUnForbidAddr(cdc),
 		GetCmdModifyTokenInfo(cdc),
+		GetCmdCreateVesting(cdc),
+		GetCmdRevokeVesting(cdc),
+		GetCmdGrantRole(cdc),
+		GetCmdRevokeRole(cdc),
+		GetCmdSetApprovalPolicy(cdc),
+		GetCmdApproveOperation(cdc),
+		GetCmdCancelOperation(cdc),
+		GetCmdSetMintPolicy(cdc),
+		GetCmdCreateSnapshot(cdc),
+		GetCmdAirdrop(cdc),
+		GetCmdBidSymbol(cdc),
+		GetCmdDistributeDividend(cdc),
+		GetCmdClaimDividends(cdc),
+		GetCmdSetTransferFee(cdc),
+		GetCmdClawback(cdc),
 	)...)
 
 	return assTxCmd
@@ -89,6 +108,8 @@ $ cetcli tx asset issue-token --name="ABC Token" \
 	cmd.Flags().String(flagTokenURL, "", "url of token website")
 	cmd.Flags().String(flagTokenDescription, "", "description of token info")
 	cmd.Flags().String(flagTokenIdentity, "", "identity of token")
+	cmd.Flags().Bool(flagPermissioned, false, "whether only the owner and the whitelisted addresses could receive the token")
+	cmd.Flags().Bool(flagClawbackable, false, "whether the owner could claw back the token from its holders")
 
 	for _, flag := range issueTokenFlags {
 		_ = cmd.MarkFlagRequired(flag)
@@ -97,6 +118,39 @@ $ cetcli tx asset issue-token --name="ABC Token" \
 	return cmd
 }
 
+// GetCmdIssueTokens will create a issue-tokens tx and sign.
+func GetCmdIssueTokens(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-tokens [file]",
+		Short: "Create and sign a issue-tokens tx",
+		Long: strings.TrimSpace(
+			`Create and sign a issue-tokens tx, broadcast to nodes.
+The tokens are read from a csv or json file as import-genesis-tokens does, and all of them are owned
+by the sender, so the owner, total_burn, total_mint, is_forbidden and balances fields are ignored.
+The issue fee is paid for every token, and no token is issued if any of them fails.
+
+Example:
+$ cat tokens.csv
+name,symbol,total_supply,mintable,burnable,url,description,identity
+ABC Token,abc,2100000000000000,false,true,www.abc.org,token abc is a example token,552A83BA62F9B1F8
+XYZ Token,xyz,1000000000000000,true,true,www.xyz.org,token xyz is a example token,552A83BA62F9B1F8
+$ cetcli tx asset issue-tokens tokens.csv --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseIssueTokensFile(args[0], nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
 var transferOwnershipFlags = []string{
 	flagSymbol,
 	flagNewOwner,
@@ -109,6 +163,7 @@ func GetCmdTransferOwnership(cdc *codec.Codec) *cobra.Command {
 		Short: "Create and sign a transfer-ownership tx",
 		Long: strings.TrimSpace(
 			`Create and sign a transfer-ownership tx, broadcast to nodes.
+The new owner gets the ownership only after accepting it with accept-ownership before the deadline.
 
 Example:
 $ cetcli tx asset transfer-ownership --symbol="abc" \
@@ -225,6 +280,7 @@ func GetCmdForbidToken(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ cetcli tx asset forbid-token --symbol="abc" \
+	--reason="court order 2019-123" \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -237,6 +293,7 @@ $ cetcli tx asset forbid-token --symbol="abc" \
 	}
 
 	cmd.Flags().String(flagSymbol, "", "which token will be forbidden")
+	cmd.Flags().String(flagReason, "", "the optional reason recorded in the admin action log")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range symbolFlags {
@@ -256,6 +313,7 @@ func GetCmdUnForbidToken(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ cetcli tx asset unforbid-token --symbol="abc" \
+	--reason="court order 2019-123" \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -268,6 +326,7 @@ $ cetcli tx asset unforbid-token --symbol="abc" \
 	}
 
 	cmd.Flags().String(flagSymbol, "", "which token will be un forbidden")
+	cmd.Flags().String(flagReason, "", "the optional reason recorded in the admin action log")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range symbolFlags {
@@ -367,6 +426,7 @@ func GetCmdForbidAddr(cdc *codec.Codec) *cobra.Command {
 Example:
 $ cetcli tx asset forbid-addr --symbol="abc" \
 	--addresses=key,key,key \
+	--reason="court order 2019-123" \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -380,6 +440,7 @@ $ cetcli tx asset forbid-addr --symbol="abc" \
 
 	cmd.Flags().String(flagSymbol, "", "which token address be forbidden")
 	cmd.Flags().String(flagAddresses, "", "forbid addresses")
+	cmd.Flags().String(flagReason, "", "the optional reason recorded in the admin action log")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range addressesFlags {
@@ -401,6 +462,7 @@ func GetCmdUnForbidAddr(cdc *codec.Codec) *cobra.Command {
 Example:
 $ cetcli tx asset unforbid-addr --symbol="abc" \
 	--addresses=key,key,key \
+	--reason="court order 2019-123" \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -414,6 +476,7 @@ $ cetcli tx asset unforbid-addr --symbol="abc" \
 
 	cmd.Flags().String(flagSymbol, "", "which token address be un-forbidden")
 	cmd.Flags().String(flagAddresses, "", "unforbid addresses")
+	cmd.Flags().String(flagReason, "", "the optional reason recorded in the admin action log")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range addressesFlags {
@@ -449,6 +512,9 @@ $ cetcli tx asset modify-token-info --symbol="abc" \
 	--url="www.abc.com" \
 	--description="abc example description" \
 	--identity="552A83BA62F9B1F8" \
+	--decimals=8 \
+	--logo-uri="https://www.abc.com/logo.png" \
+	--social-links="twitter=https://twitter.com/abc,telegram=" \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -470,8 +536,647 @@ $ cetcli tx asset modify-token-info --symbol="abc" \
 	cmd.Flags().String(flagBurnable, types.DoNotModifyTokenInfo, "whether the token could be burned")
 	cmd.Flags().String(flagAddrForbiddable, types.DoNotModifyTokenInfo, "whether the token holder address can be forbidden by token owner")
 	cmd.Flags().String(flagTokenForbiddable, types.DoNotModifyTokenInfo, "whether the token can be forbidden")
+	cmd.Flags().String(flagDecimals, "", "new display decimals of token")
+	cmd.Flags().String(flagLogoURI, "", "new logo uri of token")
+	cmd.Flags().String(flagLogoHash, "", "hex encoded sha256 of the new logo")
+	cmd.Flags().String(flagSocialLinks, "", "social links to set, like 'twitter=url1,telegram=url2', an empty url removes the link")
+	cmd.Flags().String(flagAttributes, "", "attributes to set, like 'k1=v1,k2=v2', an empty value removes the attribute")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+var createVestingFlags = []string{
+	flagSymbol,
+	flagAmount,
+	flagBeneficiary,
+	flagStartTime,
+	flagEndTime,
+	flagPeriod,
+}
+
+// GetCmdCreateVesting will create a create-vesting tx and sign.
+func GetCmdCreateVesting(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting",
+		Short: "Create and sign a create-vesting tx",
+		Long: strings.TrimSpace(
+			`Create and sign a create-vesting tx, broadcast to nodes.
+The coins are released to the beneficiary every period seconds, linearly from the start time
+to the end time, and nothing is released before the cliff time (which defaults to the start time).
+
+Example:
+$ cetcli tx asset create-vesting --symbol="abc" \
+	--amount=10000000000000000 \
+	--beneficiary=coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5 \
+	--start-time=1577836800 \
+	--cliff-time=1609459200 \
+	--end-time=1672531200 \
+	--period=86400 \
+	--revocable=true \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseCreateVestingFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token will be vested")
+	cmd.Flags().String(flagAmount, "0", "the amount of vesting")
+	cmd.Flags().String(flagBeneficiary, "", "who will receive the vested coins")
+	cmd.Flags().Int64(flagStartTime, 0, "the unix time when the vesting starts")
+	cmd.Flags().Int64(flagCliffTime, 0, "the unix time before which nothing is released, zero for the start time")
+	cmd.Flags().Int64(flagEndTime, 0, "the unix time when all the coins are vested")
+	cmd.Flags().Int64(flagPeriod, 0, "the seconds between two releases")
+	cmd.Flags().Bool(flagRevocable, false, "whether the unvested coins can be taken back by the creator")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range createVestingFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdRevokeVesting will create a revoke-vesting tx and sign.
+func GetCmdRevokeVesting(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-vesting [id]",
+		Short: "Create and sign a revoke-vesting tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Create and sign a revoke-vesting tx, broadcast to nodes.
+The vested coins are released to the beneficiary and the others are returned to the creator.
+
+Example:
+$ cetcli tx asset revoke-vesting 1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeVestingSchedule(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	return cmd
+}
+
+var tokenRoleFlags = []string{
+	flagSymbol,
+	flagRole,
+	flagAddress,
+}
+
+// GetCmdGrantRole will create a grant-role tx and sign.
+func GetCmdGrantRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role",
+		Short: "Create and sign a grant-role tx",
+		Long: strings.TrimSpace(
+			`Create and sign a grant-role tx, broadcast to nodes.
+The role can be minter, burner, freezer or metadata_admin, and the token owner has all of them.
+
+Example:
+$ cetcli tx asset grant-role --symbol="abc" \
+	--role=minter \
+	--address=coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseGrantRoleFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the role is granted on")
+	cmd.Flags().String(flagRole, "", "minter, burner, freezer or metadata_admin")
+	cmd.Flags().String(flagAddress, "", "who will get the role")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range tokenRoleFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdRevokeRole will create a revoke-role tx and sign.
+func GetCmdRevokeRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role",
+		Short: "Create and sign a revoke-role tx",
+		Long: strings.TrimSpace(
+			`Create and sign a revoke-role tx, broadcast to nodes.
+
+Example:
+$ cetcli tx asset revoke-role --symbol="abc" \
+	--role=minter \
+	--address=coinex1e9kx6klg6z9p9ea4ehqmypl6dvjrp96vfxecd5 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseRevokeRoleFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the role is revoked on")
+	cmd.Flags().String(flagRole, "", "minter, burner, freezer or metadata_admin")
+	cmd.Flags().String(flagAddress, "", "who will lose the role")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range tokenRoleFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdSetApprovalPolicy will create a set-approval-policy tx and sign.
+func GetCmdSetApprovalPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-policy",
+		Short: "Create and sign a set-approval-policy tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set-approval-policy tx, broadcast to nodes.
+Once set, minting, ownership transfer and changing the policy itself need the approvals of threshold approvers.
+Multiple approvers separated by commas, and a zero threshold without approvers removes the policy.
+
+Example:
+$ cetcli tx asset set-approval-policy --symbol="abc" \
+	--approvers=key,key,key \
+	--threshold=2 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetApprovalPolicyFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the policy is set on")
+	cmd.Flags().String(flagApprovers, "", "the approver addresses")
+	cmd.Flags().Int64(flagThreshold, 0, "how many approvals are needed")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdApproveOperation will create a approve-operation tx and sign.
+func GetCmdApproveOperation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-operation [id]",
+		Short: "Create and sign a approve-operation tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Create and sign a approve-operation tx, broadcast to nodes.
+The operation is executed once it gets enough approvals.
+
+Example:
+$ cetcli tx asset approve-operation 1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgApproveTokenOperation(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	return cmd
+}
+
+// GetCmdCancelOperation will create a cancel-operation tx and sign.
+func GetCmdCancelOperation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-operation [id]",
+		Short: "Create and sign a cancel-operation tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Create and sign a cancel-operation tx, broadcast to nodes.
+Only the proposer or the token owner can cancel the operation.
+
+Example:
+$ cetcli tx asset cancel-operation 1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelTokenOperation(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	return cmd
+}
+
+// GetCmdAcceptOwnership will create a accept-ownership tx and sign.
+func GetCmdAcceptOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-ownership",
+		Short: "Create and sign a accept-ownership tx",
+		Long: strings.TrimSpace(
+			`Create and sign a accept-ownership tx, broadcast to nodes.
+The proposed new owner accepts the ownership transfer before the deadline.
+
+Example:
+$ cetcli tx asset accept-ownership --symbol="abc" --from newkey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseAcceptOwnershipFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token`s ownership be accepted")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdCancelOwnershipTransfer will create a cancel-ownership-transfer tx and sign.
+func GetCmdCancelOwnershipTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-ownership-transfer",
+		Short: "Create and sign a cancel-ownership-transfer tx",
+		Long: strings.TrimSpace(
+			`Create and sign a cancel-ownership-transfer tx, broadcast to nodes.
+The token owner cancels the ownership transfer which is not accepted yet.
+
+Example:
+$ cetcli tx asset cancel-ownership-transfer --symbol="abc" --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseCancelOwnershipTransferFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token`s ownership transfer be canceled")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdSetMintPolicy will create a set-mint-policy tx and sign.
+func GetCmdSetMintPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-policy",
+		Short: "Create and sign a set-mint-policy tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set-mint-policy tx, broadcast to nodes.
+The mint policy of a mintable token can only be set once and can not be loosened later.
+A zero hard cap means no cap, and the periods are in seconds.
+The inflation is minted to the recipient automatically at the beginning of blocks.
+
+Example:
+$ cetcli tx asset set-mint-policy --symbol="abc" \
+	--hard-cap=10000000000000000 \
+	--mint-period=86400 \
+	--max-mint-per-period=100000000000 \
+	--inflation-amount=10000000000 \
+	--inflation-period=2592000 \
+	--inflation-recipient=coinex1... \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetMintPolicyFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the policy is set on")
+	cmd.Flags().String(flagHardCap, "", "the max total supply of the token")
+	cmd.Flags().Int64(flagMintPeriod, 0, "the length of the mint period")
+	cmd.Flags().String(flagMaxMintPerPeriod, "", "the max amount minted in one mint period")
+	cmd.Flags().String(flagInflationAmount, "", "the amount minted automatically in one inflation period")
+	cmd.Flags().Int64(flagInflationPeriod, 0, "the length of the inflation period")
+	cmd.Flags().String(flagInflationRecipient, "", "who receives the inflation")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+var createSnapshotFlags = []string{
+	flagDenom,
+	flagHeight,
+}
+
+// GetCmdCreateSnapshot will create a create-snapshot tx and sign.
+func GetCmdCreateSnapshot(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-snapshot",
+		Short: "Create and sign a create-snapshot tx",
+		Long: strings.TrimSpace(
+			`Create and sign a create-snapshot tx, broadcast to nodes.
+The balances of the denom held by all the accounts, including the frozen and locked coins,
+are recorded at the end of the block at the height, which must not have passed.
+The holder snapshot fee is charged.
+
+Example:
+$ cetcli tx asset create-snapshot --denom="abc" \
+	--height=100000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseCreateSnapshotFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "which denom the holders hold")
+	cmd.Flags().Int64(flagHeight, 0, "the height at which the snapshot is taken")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range createSnapshotFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var createAirdropFlags = []string{
+	flagSnapshotID,
+	flagAmount,
+}
+
+// GetCmdAirdrop will create a airdrop tx and sign.
+func GetCmdAirdrop(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop",
+		Short: "Create and sign a airdrop tx",
+		Long: strings.TrimSpace(
+			`Create and sign a airdrop tx, broadcast to nodes.
+The amount is distributed to the holders of a taken snapshot pro-rata to their balances,
+in batches at the end of the following blocks, and the remainder of rounding is returned.
+
+Example:
+$ cetcli tx asset airdrop --snapshot-id=1 \
+	--amount=1000000000xyz \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseCreateAirdropFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSnapshotID, 0, "the id of the holder snapshot")
+	cmd.Flags().String(flagAmount, "", "the coins to distribute")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range createAirdropFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var bidSymbolFlags = []string{
+	flagSymbol,
+	flagAmount,
+}
+
+// GetCmdBidSymbol will create a bid-symbol tx and sign.
+func GetCmdBidSymbol(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-symbol",
+		Short: "Create and sign a bid-symbol tx",
+		Long: strings.TrimSpace(
+			`Create and sign a bid-symbol tx, broadcast to nodes.
+Short and reserved symbols are auctioned in CET, and the first bid starts the auction,
+which must not be lower than the issue fee of the symbol. A later bid must outbid the highest one
+by the min bid increase rate. The highest bid is frozen until it is outbid or the auction ends,
+when it is donated to the community pool and only the winner can issue the symbol without the issue fee.
+
+Example:
+$ cetcli tx asset bid-symbol --symbol="btc" \
+	--amount=1000000000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseBidSymbolFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which symbol to bid on")
+	cmd.Flags().String(flagAmount, "", "the bid in sato.CET")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range bidSymbolFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var distributeDividendFlags = []string{
+	flagSymbol,
+	flagAmount,
+}
+
+// GetCmdDistributeDividend will create a distribute-dividend tx and sign.
+func GetCmdDistributeDividend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute-dividend",
+		Short: "Create and sign a distribute-dividend tx",
+		Long: strings.TrimSpace(
+			`Create and sign a distribute-dividend tx, broadcast to nodes.
+Only the token owner can pay dividends to the holders of the token, in CET or any other token.
+The amount is escrowed in the asset module, and every holder can claim its share pro-rata
+to its balance at the time of the distribution with the claim-dividends tx.
+
+Example:
+$ cetcli tx asset distribute-dividend --symbol="abc" \
+	--amount=1000000000cet \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseDistributeDividendFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "whose holders are paid the dividend")
+	cmd.Flags().String(flagAmount, "", "the coins to distribute")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range distributeDividendFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var claimDividendsFlags = []string{
+	flagSymbol,
+}
+
+// GetCmdClaimDividends will create a claim-dividends tx and sign.
+func GetCmdClaimDividends(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-dividends",
+		Short: "Create and sign a claim-dividends tx",
+		Long: strings.TrimSpace(
+			`Create and sign a claim-dividends tx, broadcast to nodes.
+All the dividends accrued to the holder of the token are paid.
+
+Example:
+$ cetcli tx asset claim-dividends --symbol="abc" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseClaimDividendsFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "whose dividends to claim")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range claimDividendsFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdSetTransferFee will create a set-transfer-fee tx and sign.
+func GetCmdSetTransferFee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-fee",
+		Short: "Create and sign a set-transfer-fee tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set-transfer-fee tx, broadcast to nodes.
+The fee rate is in basis points of every transfer of the token, at most 1000 (10%),
+and is deducted from the amount received. A zero fee cap means no cap.
+The fees are sent to the fee receiver, or burnt if no receiver is given.
+Transfers to module accounts and the settlement of market orders are exempt.
+A zero fee rate removes the transfer fee.
+
+Example:
+$ cetcli tx asset set-transfer-fee --symbol="abc" \
+	--fee-rate=50 \
+	--fee-cap=100000000000 \
+	--fee-receiver=coinex1... \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetTransferFeeFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the transfer fee is set on")
+	cmd.Flags().Int64(flagFeeRate, 0, "the fee rate in basis points")
+	cmd.Flags().String(flagFeeCap, "", "the max fee of a transfer")
+	cmd.Flags().String(flagFeeReceiver, "", "who receives the fees, empty for burning")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdClawback will create a clawback tx and sign.
+func GetCmdClawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback",
+		Short: "Create and sign a clawback tx",
+		Long: strings.TrimSpace(
+			`Create and sign a clawback tx, broadcast to nodes.
+Only the owner of a token issued with --clawbackable could claw it back from a holder.
+The market orders of the holder trading the token are cancelled first, so the coins frozen in them
+could be taken too. The coins are moved to the recipient, or burnt if no recipient is given,
+and the clawback is recorded in the admin action log with the reason.
+
+Example:
+$ cetcli tx asset clawback --symbol="abc" \
+	--holder=coinex1... \
+	--amount=100000000 \
+	--recipient=coinex1... \
+	--reason="court order 2026-123" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseClawbackFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token is clawed back")
+	cmd.Flags().String(flagHolder, "", "the holder whose token is clawed back")
+	cmd.Flags().String(flagAmount, "", "the amount of token clawed back")
+	cmd.Flags().String(flagRecipient, "", "who receives the token, empty for burning")
+	cmd.Flags().String(flagReason, "", "the optional reason recorded in the admin action log")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range clawbackFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
 
 	return cmd
 }
@@ -49,6 +49,14 @@ func TestTxCmds(t *testing.T) {
 		" --name=NewName --total-supply=123 --mintable=true --burnable=true --addr-forbiddable=true --token-forbiddable=true",
 		types.NewMsgModifyTokenInfo("abc", "coinex.org", "cool", "CET", nil,
 			"NewName", "123", "true", "true", "true", "true"))
+
+	testTxCmd(t, "modify-token-info --symbol=abc --url=coinex.org --description=cool --identity=CET"+
+		" --name=NewName --total-supply=123 --mintable=true --burnable=true --addr-forbiddable=true --token-forbiddable=true"+
+		" --decimals=8 --logo-uri=abc.org/logo.png --social-links=twitter=t,github= --attributes=k=v",
+		types.NewMsgModifyTokenInfo("abc", "coinex.org", "cool", "CET", nil,
+			"NewName", "123", "true", "true", "true", "true").WithMetadata("8", "abc.org/logo.png", "",
+			[]types.MetadataEntry{{Key: "twitter", Value: "t"}, {Key: "github", Value: ""}},
+			[]types.MetadataEntry{{Key: "k", Value: "v"}}))
 }
 
 func testTxCmd(t *testing.T, args string, expectedMsg interface{}) {
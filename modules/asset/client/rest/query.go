@@ -3,9 +3,11 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
@@ -20,11 +22,24 @@ var (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, storeName string) {
 	r.HandleFunc("/asset/tokens/{symbol}", QueryTokenRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
-	r.HandleFunc("/asset/tokens", QueryTokensRequestHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens", QueryTokensRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/whitelist", QueryWhitelistRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/addresses", QueryForbiddenAddrRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/reserved/symbols", QueryReservedSymbolsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/parameters", QueryParamsHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/vestings/{id}", QueryVestingRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/accounts/{address}/vestings", QueryVestingsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/roles", QueryTokenRolesRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/approval-policy", QueryApprovalPolicyRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/operations", QueryTokenOperationsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/pending-ownership", QueryPendingOwnershipRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/snapshots/{id}", QueryHolderSnapshotRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/airdrops/{id}", QueryAirdropRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/symbols/{symbol}/auction", QuerySymbolAuctionRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/symbol-auctions", QuerySymbolAuctionsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/dividends", QueryDividendPoolsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/dividends/{address}", QueryClaimableDividendsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/admin-actions", QueryAdminActionsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 }
 
 // QueryTokenRequestHandlerFn - query assetREST Handler
@@ -43,14 +58,64 @@ func QueryTokenRequestHandlerFn(
 	}
 }
 
-// QueryTokensRequestHandlerFn - query assetREST Handler
+// QueryTokensRequestHandlerFn - query assetREST Handler, which returns all the tokens if no search parameters
+// are given, and otherwise a page of the tokens found with the "query", "symbol", "owner", "mintable" and
+// "forbidden" query parameters, paginated with the "page" and "limit" query parameters
 func QueryTokensRequestHandlerFn(
-	storeName string, cliCtx context.CLIContext,
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenList)
-		restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+		if len(r.URL.Query()) == 0 {
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenList)
+			restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenSearch)
+		filter, err := parseTokenFilter(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultTokenSearchLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryTokenSearchParams(filter, page, limit)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+func parseTokenFilter(r *http.Request) (types.TokenFilter, error) {
+	values := r.URL.Query()
+	filter := types.TokenFilter{Symbol: values.Get("symbol"), Query: values.Get("query")}
+	if owner := values.Get("owner"); owner != "" {
+		addr, err := sdk.AccAddressFromBech32(owner)
+		if err != nil {
+			return filter, err
+		}
+		filter.Owner = addr
+	}
+	var err error
+	if filter.Mintable, err = parseOptionalBool(values.Get("mintable")); err != nil {
+		return filter, fmt.Errorf("invalid mintable: %s", err)
+	}
+	if filter.Forbidden, err = parseOptionalBool(values.Get("forbidden")); err != nil {
+		return filter, fmt.Errorf("invalid forbidden: %s", err)
+	}
+	return filter, filter.Validate()
+}
+
+func parseOptionalBool(s string) (*bool, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return nil, err
 	}
+	return &b, nil
 }
 
 // QueryWhitelistRequestHandlerFn - query assetREST Handler
@@ -102,3 +167,216 @@ func QueryParamsHandlerFn(storeName string, cliCtx context.CLIContext) http.Hand
 		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
 	}
 }
+
+// QueryVestingRequestHandlerFn - query assetREST Handler
+func QueryVestingRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryVesting)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryVestingParams(id)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryVestingsRequestHandlerFn - query assetREST Handler
+func QueryVestingsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryVestings)
+		beneficiary, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryVestingsParams(beneficiary)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryTokenRolesRequestHandlerFn - query assetREST Handler
+func QueryTokenRolesRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenRoles)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryApprovalPolicyRequestHandlerFn - query assetREST Handler
+func QueryApprovalPolicyRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryApprovalPolicy)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryTokenOperationsRequestHandlerFn - query assetREST Handler
+func QueryTokenOperationsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryOperations)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryPendingOwnershipRequestHandlerFn - query assetREST Handler
+func QueryPendingOwnershipRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryPendingOwner)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryHolderSnapshotRequestHandlerFn - query assetREST Handler
+func QueryHolderSnapshotRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryHolderSnapshot)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryHolderSnapshotParams(id)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryAirdropRequestHandlerFn - query assetREST Handler
+func QueryAirdropRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryAirdrop)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAirdropParams(id)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QuerySymbolAuctionRequestHandlerFn - query assetREST Handler
+func QuerySymbolAuctionRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySymbolAuction)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QuerySymbolAuctionsRequestHandlerFn - query assetREST Handler
+func QuerySymbolAuctionsRequestHandlerFn(
+	storeName string, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySymbolAuctions)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+	}
+}
+
+// QueryDividendPoolsRequestHandlerFn - query assetREST Handler
+func QueryDividendPoolsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryDividendPools)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryClaimableDividendsRequestHandlerFn - query assetREST Handler
+func QueryClaimableDividendsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryClaimable)
+		vars := mux.Vars(r)
+		symbol := vars["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		holder, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryClaimableParams(symbol, holder)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryAdminActionsRequestHandlerFn - query assetREST Handler, paginated with the "page" and "limit" query parameters
+func QueryAdminActionsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryAdminActions)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultAdminActionsLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAdminActionsParams(symbol, page, limit)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
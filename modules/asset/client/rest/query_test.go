@@ -24,6 +24,11 @@ func TestQuery(t *testing.T) {
 	testQuery(t, "/asset/tokens/abc/forbidden/addresses", "custom/asset/addr-forbidden", types.NewQueryForbiddenAddrParams(testSymbol))
 	testQuery(t, "/asset/tokens/reserved/symbols", "custom/asset/reserved-symbols", nil)
 	testQuery(t, "/asset/parameters", "custom/asset/parameters", nil)
+
+	mintable := true
+	filter := types.TokenFilter{Symbol: "ab", Query: "token", Mintable: &mintable}
+	testQuery(t, "/asset/tokens?symbol=ab&query=token&mintable=true", "custom/asset/token-search",
+		types.NewQueryTokenSearchParams(filter, 1, types.DefaultTokenSearchLimit))
 }
 
 func testQuery(t *testing.T, restPath string,
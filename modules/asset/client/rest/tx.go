@@ -21,7 +21,10 @@ const (
 // registerTXRoutes -
 func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/asset/tokens", issueRequestHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/batches", issueTokensRequestHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/ownerships", transferOwnerRequestHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/ownerships/accepts", acceptOwnershipHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/ownerships/cancels", cancelOwnershipTransferHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/mints", mintTokenHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/burns", burnTokenHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/forbids", forbidTokenHandlerFn(cdc, cliCtx)).Methods("POST")
@@ -31,6 +34,21 @@ func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/addresses", forbidAddrHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/unforbidden/addresses", unForbidAddrHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/infos", modifyTokenInfoHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/vestings", createVestingHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/vestings/{id}/revokes", revokeVestingHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/roles", grantRoleHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/roles/revokes", revokeRoleHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/approval-policy", setApprovalPolicyHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/mint-policy", setMintPolicyHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/transfer-fee", setTransferFeeHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/clawbacks", clawbackHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/snapshots", createSnapshotHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/snapshots/{id}/airdrops", createAirdropHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/symbols/{symbol}/bids", bidSymbolHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/dividends", distributeDividendHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/dividends/claims", claimDividendsHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/operations/{id}/approvals", approveOperationHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/operations/{id}/cancels", cancelOperationHandlerFn(cdc, cliCtx)).Methods("POST")
 }
 
 // issueRequestHandlerFn - http request handler to issue new token.
@@ -50,6 +68,11 @@ func issueRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(issueReq)).Build(checker)
 }
 
+// issueTokensRequestHandlerFn - http request handler to issue a batch of new tokens.
+func issueTokensRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(issueTokensReq))
+}
+
 // transferOwnershipRequestHandlerFn - http request handler to transfer token owner ship.
 func transferOwnerRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(transferOwnerReq))
@@ -99,3 +122,88 @@ func unForbidAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 func modifyTokenInfoHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(modifyTokenInfoReq))
 }
+
+// createVestingHandlerFn - http request handler to create a vesting schedule.
+func createVestingHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(createVestingReq))
+}
+
+// revokeVestingHandlerFn - http request handler to revoke a vesting schedule.
+func revokeVestingHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(revokeVestingReq))
+}
+
+// grantRoleHandlerFn - http request handler to grant a token role.
+func grantRoleHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(grantRoleReq))
+}
+
+// revokeRoleHandlerFn - http request handler to revoke a token role.
+func revokeRoleHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(revokeRoleReq))
+}
+
+// setApprovalPolicyHandlerFn - http request handler to set the approval policy of a token.
+func setApprovalPolicyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setApprovalPolicyReq))
+}
+
+// setMintPolicyHandlerFn - http request handler to set the mint policy of a token.
+func setMintPolicyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setMintPolicyReq))
+}
+
+// createSnapshotHandlerFn - http request handler to create a holder snapshot.
+func createSnapshotHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(createSnapshotReq))
+}
+
+// createAirdropHandlerFn - http request handler to create an airdrop over a holder snapshot.
+func createAirdropHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(createAirdropReq))
+}
+
+// bidSymbolHandlerFn - http request handler to bid on a symbol.
+func bidSymbolHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(bidSymbolReq))
+}
+
+// distributeDividendHandlerFn - http request handler to pay dividends to the holders of a token.
+func distributeDividendHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(distributeDividendReq))
+}
+
+// claimDividendsHandlerFn - http request handler to claim the dividends of a token.
+func claimDividendsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(claimDividendsReq))
+}
+
+// setTransferFeeHandlerFn - http request handler to set the transfer fee of a token.
+func setTransferFeeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setTransferFeeReq))
+}
+
+// clawbackHandlerFn - http request handler to claw back a token from a holder.
+func clawbackHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(clawbackReq))
+}
+
+// approveOperationHandlerFn - http request handler to approve a token operation.
+func approveOperationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(approveOperationReq))
+}
+
+// cancelOperationHandlerFn - http request handler to cancel a token operation.
+func cancelOperationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelOperationReq))
+}
+
+// acceptOwnershipHandlerFn - http request handler to accept a token ownership transfer.
+func acceptOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(acceptOwnershipReq))
+}
+
+// cancelOwnershipTransferHandlerFn - http request handler to cancel a token ownership transfer.
+func cancelOwnershipTransferHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelOwnershipTransferReq))
+}
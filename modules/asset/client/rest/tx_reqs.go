@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -26,6 +27,10 @@ type (
 		URL              string       `json:"url" yaml:"url"`
 		Description      string       `json:"description" yaml:"description"`
 		Identity         string       `json:"identity" yaml:"identity"`
+
+		MintPolicy   *types.MintPolicy `json:"mint_policy,omitempty" yaml:"mint_policy"`
+		Permissioned bool              `json:"permissioned,omitempty" yaml:"permissioned"`
+		Clawbackable bool              `json:"clawbackable,omitempty" yaml:"clawbackable"`
 	}
 
 	// transferOwnerReq defines the properties of a transfer ownership request's body.
@@ -49,10 +54,12 @@ type (
 	// forbidTokenReq defines the properties of a forbid token request's body.
 	forbidTokenReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Reason  string       `json:"reason,omitempty" yaml:"reason"`
 	}
 	// unforbidTokenReq defines the properties of a unforbid token request's body.
 	unForbidTokenReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Reason  string       `json:"reason,omitempty" yaml:"reason"`
 	}
 	// the flowing 4 reqs defines the properties of a whitelist or forbidden addr request's body.
 	addWhiteListReq struct {
@@ -66,10 +73,12 @@ type (
 	forbidAddrReq struct {
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
 		Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+		Reason    string           `json:"reason,omitempty" yaml:"reason"`
 	}
 	unforbidAddrReq struct {
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
 		Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+		Reason    string           `json:"reason,omitempty" yaml:"reason"`
 	}
 	// modifyTokenInfoReq defines the properties of a modify token info request's body.
 	modifyTokenInfoReq struct {
@@ -83,6 +92,109 @@ type (
 		Burnable         *string      `json:"burnable" yaml:"burnable"`
 		AddrForbiddable  *string      `json:"addr_forbiddable" yaml:"addr_forbiddable"`
 		TokenForbiddable *string      `json:"token_forbiddable" yaml:"token_forbiddable"`
+
+		Decimals    string                `json:"decimals,omitempty" yaml:"decimals,omitempty"`
+		LogoURI     string                `json:"logo_uri,omitempty" yaml:"logo_uri,omitempty"`
+		LogoHash    string                `json:"logo_hash,omitempty" yaml:"logo_hash,omitempty"`
+		SocialLinks []types.MetadataEntry `json:"social_links,omitempty" yaml:"social_links,omitempty"`
+		Attributes  []types.MetadataEntry `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	}
+	// createVestingReq defines the properties of a create vesting schedule request's body.
+	createVestingReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Beneficiary sdk.AccAddress `json:"beneficiary" yaml:"beneficiary"`
+		Amount      string         `json:"amount" yaml:"amount"`
+		StartTime   int64          `json:"start_time" yaml:"start_time"`
+		CliffTime   int64          `json:"cliff_time" yaml:"cliff_time"`
+		EndTime     int64          `json:"end_time" yaml:"end_time"`
+		Period      int64          `json:"period" yaml:"period"`
+		Revocable   bool           `json:"revocable" yaml:"revocable"`
+	}
+	// revokeVestingReq defines the properties of a revoke vesting schedule request's body.
+	revokeVestingReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// grantRoleReq defines the properties of a grant token role request's body.
+	grantRoleReq struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Role    string         `json:"role" yaml:"role"`
+		Address sdk.AccAddress `json:"address" yaml:"address"`
+	}
+	// revokeRoleReq defines the properties of a revoke token role request's body.
+	revokeRoleReq struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Role    string         `json:"role" yaml:"role"`
+		Address sdk.AccAddress `json:"address" yaml:"address"`
+	}
+	// setApprovalPolicyReq defines the properties of a set approval policy request's body.
+	setApprovalPolicyReq struct {
+		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Approvers []sdk.AccAddress `json:"approvers" yaml:"approvers"`
+		Threshold int64            `json:"threshold" yaml:"threshold"`
+	}
+	// setMintPolicyReq defines the properties of a set mint policy request's body.
+	setMintPolicyReq struct {
+		BaseReq rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Policy  types.MintPolicy `json:"policy" yaml:"policy"`
+	}
+	// createSnapshotReq defines the properties of a create holder snapshot request's body.
+	createSnapshotReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Denom   string       `json:"denom" yaml:"denom"`
+		Height  int64        `json:"height" yaml:"height"`
+	}
+	// createAirdropReq defines the properties of a create airdrop request's body.
+	createAirdropReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Coin     `json:"amount" yaml:"amount"`
+	}
+	// bidSymbolReq defines the properties of a bid symbol request's body.
+	bidSymbolReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  string       `json:"amount" yaml:"amount"`
+	}
+	// distributeDividendReq defines the properties of a distribute dividend request's body.
+	distributeDividendReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Coin     `json:"amount" yaml:"amount"`
+	}
+	// claimDividendsReq defines the properties of a claim dividends request's body.
+	claimDividendsReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// setTransferFeeReq defines the properties of a set transfer fee request's body, and a nil Policy removes the fee.
+	setTransferFeeReq struct {
+		BaseReq rest.BaseReq             `json:"base_req" yaml:"base_req"`
+		Policy  *types.TransferFeePolicy `json:"policy" yaml:"policy"`
+	}
+	// clawbackReq defines the properties of a clawback request's body, and an empty Recipient burns the token.
+	clawbackReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Holder    sdk.AccAddress `json:"holder" yaml:"holder"`
+		Amount    string         `json:"amount" yaml:"amount"`
+		Recipient sdk.AccAddress `json:"recipient,omitempty" yaml:"recipient"`
+		Reason    string         `json:"reason,omitempty" yaml:"reason"`
+	}
+	// issueTokensReq defines the properties of a batch issue tokens request's body, and the base_req of the tokens is ignored.
+	issueTokensReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Tokens  []issueReq   `json:"tokens" yaml:"tokens"`
+	}
+	// approveOperationReq defines the properties of an approve token operation request's body.
+	approveOperationReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// cancelOperationReq defines the properties of a cancel token operation request's body.
+	cancelOperationReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// acceptOwnershipReq defines the properties of an accept token ownership request's body.
+	acceptOwnershipReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// cancelOwnershipTransferReq defines the properties of a cancel token ownership transfer request's body.
+	cancelOwnershipTransferReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	}
 )
 
@@ -97,9 +209,37 @@ func (req *issueReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, err
 	if !ok {
 		return nil, types.ErrInvalidTokenSupply(req.TotalSupply)
 	}
-	return types.NewMsgIssueToken(req.Name, req.Symbol, amt, owner,
+	msg := types.NewMsgIssueToken(req.Name, req.Symbol, amt, owner,
 		req.Mintable, req.Burnable, req.AddrForbiddable, req.TokenForbiddable,
-		req.URL, req.Description, req.Identity), nil
+		req.URL, req.Description, req.Identity)
+	if req.MintPolicy != nil {
+		msg = msg.WithMintPolicy(*req.MintPolicy)
+	}
+	if req.Permissioned {
+		msg = msg.WithPermissioned()
+	}
+	if req.Clawbackable {
+		msg = msg.WithClawbackable()
+	}
+	return msg, nil
+}
+
+func (req *issueTokensReq) New() restutil.RestReq {
+	return new(issueTokensReq)
+}
+func (req *issueTokensReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *issueTokensReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	tokens := make([]types.MsgIssueToken, len(req.Tokens))
+	for i := range req.Tokens {
+		msg, err := req.Tokens[i].GetMsg(r, owner)
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = msg.(types.MsgIssueToken)
+	}
+	return types.NewMsgIssueTokens(owner, tokens), nil
 }
 
 func (req *transferOwnerReq) New() restutil.RestReq {
@@ -155,7 +295,7 @@ func (req *forbidTokenReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *forbidTokenReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgForbidToken(symbol, owner), nil
+	return types.NewMsgForbidToken(symbol, owner).WithReason(req.Reason), nil
 }
 
 func (req *unForbidTokenReq) New() restutil.RestReq {
@@ -166,7 +306,7 @@ func (req *unForbidTokenReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *unForbidTokenReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgUnForbidToken(symbol, owner), nil
+	return types.NewMsgUnForbidToken(symbol, owner).WithReason(req.Reason), nil
 }
 
 func (req *addWhiteListReq) New() restutil.RestReq {
@@ -199,7 +339,7 @@ func (req *forbidAddrReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *forbidAddrReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgForbidAddr(symbol, owner, req.Addresses), nil
+	return types.NewMsgForbidAddr(symbol, owner, req.Addresses).WithReason(req.Reason), nil
 }
 
 func (req *unforbidAddrReq) New() restutil.RestReq {
@@ -210,7 +350,7 @@ func (req *unforbidAddrReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *unforbidAddrReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgUnForbidAddr(symbol, owner, req.Addresses), nil
+	return types.NewMsgUnForbidAddr(symbol, owner, req.Addresses).WithReason(req.Reason), nil
 }
 
 func (req *modifyTokenInfoReq) New() restutil.RestReq {
@@ -231,8 +371,9 @@ func (req *modifyTokenInfoReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sd
 	addrForbiddable := getNewTokenInfo(req.AddrForbiddable)
 	tokenForbiddable := getNewTokenInfo(req.TokenForbiddable)
 
-	return types.NewMsgModifyTokenInfo(symbol, url, description, identity, owner,
-		name, supply, mintable, burnable, addrForbiddable, tokenForbiddable), nil
+	msg := types.NewMsgModifyTokenInfo(symbol, url, description, identity, owner,
+		name, supply, mintable, burnable, addrForbiddable, tokenForbiddable)
+	return msg.WithMetadata(req.Decimals, req.LogoURI, req.LogoHash, req.SocialLinks, req.Attributes), nil
 }
 
 func getNewTokenInfo(ptr *string) string {
@@ -246,3 +387,209 @@ func getSymbol(r *http.Request) string {
 	vars := mux.Vars(r)
 	return vars[symbol]
 }
+
+func (req *createVestingReq) New() restutil.RestReq {
+	return new(createVestingReq)
+}
+func (req *createVestingReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *createVestingReq) GetMsg(r *http.Request, creator sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidVestingSchedule("invalid amount")
+	}
+	return types.NewMsgCreateVestingSchedule(creator, req.Beneficiary, symbol, amt,
+		req.StartTime, req.CliffTime, req.EndTime, req.Period, req.Revocable), nil
+}
+
+func (req *revokeVestingReq) New() restutil.RestReq {
+	return new(revokeVestingReq)
+}
+func (req *revokeVestingReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *revokeVestingReq) GetMsg(r *http.Request, creator sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, types.ErrInvalidVestingSchedule("invalid id")
+	}
+	return types.NewMsgRevokeVestingSchedule(creator, id), nil
+}
+
+func (req *grantRoleReq) New() restutil.RestReq {
+	return new(grantRoleReq)
+}
+func (req *grantRoleReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *grantRoleReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgGrantTokenRole(symbol, owner, req.Role, req.Address), nil
+}
+
+func (req *revokeRoleReq) New() restutil.RestReq {
+	return new(revokeRoleReq)
+}
+func (req *revokeRoleReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *revokeRoleReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgRevokeTokenRole(symbol, owner, req.Role, req.Address), nil
+}
+
+func (req *setApprovalPolicyReq) New() restutil.RestReq {
+	return new(setApprovalPolicyReq)
+}
+func (req *setApprovalPolicyReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setApprovalPolicyReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgSetApprovalPolicy(symbol, owner, req.Approvers, req.Threshold), nil
+}
+
+func (req *setMintPolicyReq) New() restutil.RestReq {
+	return new(setMintPolicyReq)
+}
+func (req *setMintPolicyReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setMintPolicyReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgSetMintPolicy(symbol, owner, req.Policy), nil
+}
+
+func (req *approveOperationReq) New() restutil.RestReq {
+	return new(approveOperationReq)
+}
+func (req *approveOperationReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *approveOperationReq) GetMsg(r *http.Request, approver sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, types.ErrInvalidApproval("invalid id")
+	}
+	return types.NewMsgApproveTokenOperation(approver, id), nil
+}
+
+func (req *cancelOperationReq) New() restutil.RestReq {
+	return new(cancelOperationReq)
+}
+func (req *cancelOperationReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *cancelOperationReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, types.ErrInvalidApproval("invalid id")
+	}
+	return types.NewMsgCancelTokenOperation(sender, id), nil
+}
+
+func (req *acceptOwnershipReq) New() restutil.RestReq {
+	return new(acceptOwnershipReq)
+}
+func (req *acceptOwnershipReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *acceptOwnershipReq) GetMsg(r *http.Request, newOwner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgAcceptOwnership(symbol, newOwner), nil
+}
+
+func (req *cancelOwnershipTransferReq) New() restutil.RestReq {
+	return new(cancelOwnershipTransferReq)
+}
+func (req *cancelOwnershipTransferReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *cancelOwnershipTransferReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgCancelOwnershipTransfer(symbol, owner), nil
+}
+
+func (req *createSnapshotReq) New() restutil.RestReq {
+	return new(createSnapshotReq)
+}
+func (req *createSnapshotReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *createSnapshotReq) GetMsg(r *http.Request, creator sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgCreateHolderSnapshot(creator, req.Denom, req.Height), nil
+}
+
+func (req *createAirdropReq) New() restutil.RestReq {
+	return new(createAirdropReq)
+}
+func (req *createAirdropReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *createAirdropReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, types.ErrInvalidAirdrop("invalid snapshot id")
+	}
+	return types.NewMsgCreateAirdrop(sender, id, req.Amount), nil
+}
+
+func (req *bidSymbolReq) New() restutil.RestReq {
+	return new(bidSymbolReq)
+}
+func (req *bidSymbolReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *bidSymbolReq) GetMsg(r *http.Request, bidder sdk.AccAddress) (sdk.Msg, error) {
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid amount")
+	}
+	return types.NewMsgBidSymbol(bidder, getSymbol(r), amt), nil
+}
+
+func (req *distributeDividendReq) New() restutil.RestReq {
+	return new(distributeDividendReq)
+}
+func (req *distributeDividendReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *distributeDividendReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgDistributeDividend(sender, getSymbol(r), req.Amount), nil
+}
+
+func (req *claimDividendsReq) New() restutil.RestReq {
+	return new(claimDividendsReq)
+}
+func (req *claimDividendsReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *claimDividendsReq) GetMsg(r *http.Request, holder sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgClaimDividends(holder, getSymbol(r)), nil
+}
+
+func (req *setTransferFeeReq) New() restutil.RestReq {
+	return new(setTransferFeeReq)
+}
+func (req *setTransferFeeReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setTransferFeeReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgSetTransferFee(getSymbol(r), owner, req.Policy), nil
+}
+
+func (req *clawbackReq) New() restutil.RestReq {
+	return new(clawbackReq)
+}
+func (req *clawbackReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *clawbackReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidClawback("invalid amount " + req.Amount)
+	}
+	return types.NewMsgClawback(getSymbol(r), owner, req.Holder, amt, req.Recipient).WithReason(req.Reason), nil
+}
@@ -0,0 +1,116 @@
+package asset_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+func claimable(input testInput, holder sdk.AccAddress) sdk.Int {
+	for _, c := range input.tk.GetClaimableDividends(input.ctx, "abc", holder) {
+		if c.Denom == "xyz" {
+			return c.Amount
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+func Test_Dividends(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	res := h(input.ctx, asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(100000), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+	require.NoError(t, input.bxk.SendCoins(input.ctx, testAddr, addrs[0], types.NewTokenCoins("abc", sdk.NewInt(700))))
+
+	// only the owner pays dividends
+	res = h(input.ctx, asset.NewMsgDistributeDividend(addrs[0], "abc", sdk.NewCoin("xyz", sdk.NewInt(2100))))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgClaimDividends(addrs[0], "abc"))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("xyz", sdk.NewInt(2100))))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(1400), claimable(input, testAddr))
+	require.Equal(t, sdk.NewInt(700), claimable(input, addrs[0]))
+
+	// the dividends accrued before the transfer stay with the sender
+	require.NoError(t, input.bxk.SendCoins(input.ctx, addrs[0], addrs[1], types.NewTokenCoins("abc", sdk.NewInt(700))))
+	require.Equal(t, sdk.NewInt(700), claimable(input, addrs[0]))
+	require.Equal(t, sdk.ZeroInt(), claimable(input, addrs[1]))
+	res = h(input.ctx, asset.NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("xyz", sdk.NewInt(210))))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(700), claimable(input, addrs[0]))
+	require.Equal(t, sdk.NewInt(70), claimable(input, addrs[1]))
+	require.Equal(t, sdk.NewInt(1540), claimable(input, testAddr))
+
+	res = h(input.ctx, asset.NewMsgClaimDividends(addrs[0], "abc"))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(700), input.tk.GetAccTotalToken(input.ctx, addrs[0]).AmountOf("xyz"))
+	require.Equal(t, sdk.ZeroInt(), claimable(input, addrs[0]))
+	res = h(input.ctx, asset.NewMsgClaimDividends(addrs[0], "abc"))
+	require.False(t, res.IsOK())
+
+	pools := input.tk.GetDividendPools(input.ctx, "abc")
+	require.Equal(t, 1, len(pools))
+	require.Equal(t, sdk.NewInt(2310), pools[0].TotalPaid)
+	require.Equal(t, sdk.NewInt(700), pools[0].TotalClaimed)
+
+	// burning the tokens of the owner raises the share of the other holders in later dividends
+	res = h(input.ctx, asset.NewMsgBurnToken("abc", sdk.NewInt(700), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("xyz", sdk.NewInt(1400))))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(770), claimable(input, addrs[1]))
+	require.Equal(t, sdk.NewInt(2240), claimable(input, testAddr))
+}
+
+func Test_Dividends_ModuleAccounts(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	res := h(input.ctx, asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(100000), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the tokens held by the module accounts are not eligible, so the holders share all the dividends
+	require.NoError(t, input.bxk.DonateCoins(input.ctx, testAddr, types.NewTokenCoins("abc", sdk.NewInt(700))))
+	res = h(input.ctx, asset.NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("xyz", sdk.NewInt(2100))))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(2100), claimable(input, testAddr))
+	res = h(input.ctx, asset.NewMsgClaimDividends(testAddr, "abc"))
+	require.True(t, res.IsOK(), res.Log)
+	pools := input.tk.GetDividendPools(input.ctx, "abc")
+	require.Equal(t, pools[0].TotalPaid, pools[0].TotalClaimed)
+}
+
+// mockReserveKeeper holds the reserves of all the tokens in one pool account
+type mockReserveKeeper struct {
+	addr sdk.AccAddress
+}
+
+func (rk mockReserveKeeper) GetReserveAccounts(ctx sdk.Context, denom string) []sdk.AccAddress {
+	return []sdk.AccAddress{rk.addr}
+}
+
+func Test_Dividends_ReserveAccounts(t *testing.T) {
+	input := createTestInput()
+	reserve := mockAddrList()[2]
+	input.tk.SetReserveKeeper(mockReserveKeeper{addr: reserve})
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	res := h(input.ctx, asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(100000), testAddr,
+		false, true, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the reserves of the pools are not eligible, so the holders share all the dividends
+	require.NoError(t, input.bxk.SendCoins(input.ctx, testAddr, reserve, types.NewTokenCoins("abc", sdk.NewInt(700))))
+	res = h(input.ctx, asset.NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("xyz", sdk.NewInt(2100))))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(2100), claimable(input, testAddr))
+}
@@ -0,0 +1,21 @@
+package asset
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker mints the scheduled inflation of the tokens with mint policies
+func BeginBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.MintInflation(ctx)
+}
+
+// EndBlocker releases the coins of the vesting schedules whose release time has come,
+// removes the ownership transfers which were not accepted in time, takes the holder snapshots
+// scheduled at this height, pays the next batch of the airdrops and settles the ended symbol auctions
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.ReleaseVestingSchedules(ctx)
+	keeper.RemoveExpiredOwnershipTransfers(ctx)
+	keeper.TakeHolderSnapshots(ctx)
+	keeper.DistributeAirdrops(ctx)
+	keeper.SettleSymbolAuctions(ctx)
+}
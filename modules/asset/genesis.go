@@ -17,6 +17,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		if err := keeper.SetToken(ctx, token); err != nil {
 			panic(err)
 		}
+		keeper.SetInflationSchedule(ctx, token)
 	}
 	for _, addr := range data.Whitelist {
 		if err := keeper.ImportGenesisAddrKeys(ctx, types.WhitelistKey, addr); err != nil {
@@ -28,15 +29,64 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+	for _, vs := range data.VestingSchedules {
+		keeper.SetVestingSchedule(ctx, vs)
+	}
+	for _, tr := range data.TokenRoles {
+		keeper.SetTokenRole(ctx, tr)
+	}
+	for _, policy := range data.ApprovalPolicies {
+		keeper.SetApprovalPolicyForGenesis(ctx, policy)
+	}
+	for _, op := range data.TokenOperations {
+		keeper.SetTokenOperation(ctx, op)
+	}
+	for _, po := range data.PendingOwnerships {
+		keeper.SetPendingOwnership(ctx, po)
+	}
+	for _, snapshot := range data.HolderSnapshots {
+		keeper.SetHolderSnapshot(ctx, snapshot)
+	}
+	for _, holder := range data.SnapshotHolders {
+		keeper.SetSnapshotHolder(ctx, holder)
+	}
+	for _, airdrop := range data.Airdrops {
+		keeper.SetAirdrop(ctx, airdrop)
+	}
+	for _, auction := range data.SymbolAuctions {
+		keeper.SetSymbolAuction(ctx, auction)
+	}
+	for _, pool := range data.DividendPools {
+		keeper.SetDividendPool(ctx, pool)
+	}
+	for _, account := range data.DividendAccounts {
+		keeper.SetDividendAccount(ctx, account)
+	}
+	for _, action := range data.AdminActions {
+		keeper.SetAdminAction(ctx, action)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(
+	gs := NewGenesisState(
 		keeper.GetParams(ctx),
 		keeper.GetAllTokens(ctx),
 		keeper.ExportGenesisAddrKeys(ctx, types.WhitelistKey),
 		keeper.ExportGenesisAddrKeys(ctx, types.ForbiddenAddrKey))
+	gs.VestingSchedules = keeper.GetAllVestingSchedules(ctx)
+	gs.TokenRoles = keeper.GetAllTokenRoles(ctx)
+	gs.ApprovalPolicies = keeper.GetAllApprovalPolicies(ctx)
+	gs.TokenOperations = keeper.GetAllTokenOperations(ctx)
+	gs.PendingOwnerships = keeper.GetAllPendingOwnerships(ctx)
+	gs.HolderSnapshots = keeper.GetAllHolderSnapshots(ctx)
+	gs.SnapshotHolders = keeper.GetAllSnapshotHolders(ctx)
+	gs.Airdrops = keeper.GetAllAirdrops(ctx)
+	gs.SymbolAuctions = keeper.GetAllSymbolAuctions(ctx)
+	gs.DividendPools = keeper.GetAllDividendPools(ctx)
+	gs.DividendAccounts = keeper.GetAllDividendAccounts(ctx)
+	gs.AdminActions = keeper.GetAllAdminActions(ctx)
+	return gs
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -91,5 +141,160 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	vestingIDs := make(map[uint64]bool)
+	for _, vs := range data.VestingSchedules {
+		if err := vs.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[vs.Symbol]; !exists {
+			return types.ErrTokenNotFound(vs.Symbol)
+		}
+		if vestingIDs[vs.ID] {
+			return errors.New("duplicate vesting schedule id found in GenesisState")
+		}
+		vestingIDs[vs.ID] = true
+	}
+
+	for _, tr := range data.TokenRoles {
+		if err := tr.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[tr.Symbol]; !exists {
+			return types.ErrTokenNotFound(tr.Symbol)
+		}
+	}
+
+	policySymbols := make(map[string]bool)
+	for _, policy := range data.ApprovalPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[policy.Symbol]; !exists {
+			return types.ErrTokenNotFound(policy.Symbol)
+		}
+		if policySymbols[policy.Symbol] {
+			return errors.New("duplicate approval policy found in GenesisState")
+		}
+		policySymbols[policy.Symbol] = true
+	}
+
+	operationIDs := make(map[uint64]bool)
+	for _, op := range data.TokenOperations {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+		if !policySymbols[op.Symbol] {
+			return types.ErrInvalidApproval("the token of the operation has no approval policy")
+		}
+		if operationIDs[op.ID] {
+			return errors.New("duplicate token operation id found in GenesisState")
+		}
+		operationIDs[op.ID] = true
+	}
+
+	pendingSymbols := make(map[string]bool)
+	for _, po := range data.PendingOwnerships {
+		if err := po.Validate(); err != nil {
+			return err
+		}
+		token, exists := tokenSymbols[po.Symbol]
+		if !exists {
+			return types.ErrTokenNotFound(po.Symbol)
+		}
+		if !token.GetOwner().Equals(po.OriginalOwner) {
+			return types.ErrInvalidOwnershipTransfer("the original owner does not own the token")
+		}
+		if pendingSymbols[po.Symbol] {
+			return errors.New("duplicate pending ownership found in GenesisState")
+		}
+		pendingSymbols[po.Symbol] = true
+	}
+
+	snapshots := make(map[uint64]HolderSnapshot)
+	for _, snapshot := range data.HolderSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+		if _, exists := snapshots[snapshot.ID]; exists {
+			return errors.New("duplicate holder snapshot id found in GenesisState")
+		}
+		snapshots[snapshot.ID] = snapshot
+	}
+	for _, holder := range data.SnapshotHolders {
+		if snapshot, exists := snapshots[holder.SnapshotID]; !exists || !snapshot.Taken {
+			return types.ErrHolderSnapshotNotFound(holder.SnapshotID)
+		}
+		if holder.Address.Empty() || !holder.Amount.IsPositive() {
+			return types.ErrInvalidHolderSnapshot("invalid holder")
+		}
+	}
+
+	airdropIDs := make(map[uint64]bool)
+	for _, airdrop := range data.Airdrops {
+		if err := airdrop.Validate(); err != nil {
+			return err
+		}
+		if _, exists := snapshots[airdrop.SnapshotID]; !exists {
+			return types.ErrHolderSnapshotNotFound(airdrop.SnapshotID)
+		}
+		if airdropIDs[airdrop.ID] {
+			return errors.New("duplicate airdrop id found in GenesisState")
+		}
+		airdropIDs[airdrop.ID] = true
+	}
+
+	auctionSymbols := make(map[string]bool)
+	for _, auction := range data.SymbolAuctions {
+		if err := auction.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[auction.Symbol]; exists {
+			return types.ErrDuplicateTokenSymbol(auction.Symbol)
+		}
+		if auctionSymbols[auction.Symbol] {
+			return errors.New("duplicate symbol auction found in GenesisState")
+		}
+		auctionSymbols[auction.Symbol] = true
+	}
+
+	pools := make(map[string]bool)
+	for _, pool := range data.DividendPools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[pool.Symbol]; !exists {
+			return types.ErrTokenNotFound(pool.Symbol)
+		}
+		if _, exists := tokenSymbols[pool.Denom]; !exists {
+			return types.ErrTokenNotFound(pool.Denom)
+		}
+		if pools[pool.Symbol+":"+pool.Denom] {
+			return errors.New("duplicate dividend pool found in GenesisState")
+		}
+		pools[pool.Symbol+":"+pool.Denom] = true
+	}
+	for _, account := range data.DividendAccounts {
+		if err := account.Validate(); err != nil {
+			return err
+		}
+		if !pools[account.Symbol+":"+account.Denom] {
+			return types.ErrDividendPoolNotFound(account.Symbol)
+		}
+	}
+
+	actionIDs := make(map[uint64]bool)
+	for _, action := range data.AdminActions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+		if _, exists := tokenSymbols[action.Symbol]; !exists {
+			return types.ErrTokenNotFound(action.Symbol)
+		}
+		if actionIDs[action.ID] {
+			return errors.New("duplicate admin action id found in GenesisState")
+		}
+		actionIDs[action.ID] = true
+	}
+
 	return nil
 }
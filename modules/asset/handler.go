@@ -3,6 +3,7 @@ package asset
 import (
 	"errors"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -18,8 +19,30 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgIssueToken:
 			return handleMsgIssueToken(ctx, keeper, msg)
+		case types.MsgIssueTokens:
+			return handleMsgIssueTokens(ctx, keeper, msg)
 		case types.MsgTransferOwnership:
 			return handleMsgTransferOwnership(ctx, keeper, msg)
+		case types.MsgAcceptOwnership:
+			return handleMsgAcceptOwnership(ctx, keeper, msg)
+		case types.MsgCancelOwnershipTransfer:
+			return handleMsgCancelOwnershipTransfer(ctx, keeper, msg)
+		case types.MsgSetMintPolicy:
+			return handleMsgSetMintPolicy(ctx, keeper, msg)
+		case types.MsgCreateHolderSnapshot:
+			return handleMsgCreateHolderSnapshot(ctx, keeper, msg)
+		case types.MsgCreateAirdrop:
+			return handleMsgCreateAirdrop(ctx, keeper, msg)
+		case types.MsgBidSymbol:
+			return handleMsgBidSymbol(ctx, keeper, msg)
+		case types.MsgDistributeDividend:
+			return handleMsgDistributeDividend(ctx, keeper, msg)
+		case types.MsgClaimDividends:
+			return handleMsgClaimDividends(ctx, keeper, msg)
+		case types.MsgSetTransferFee:
+			return handleMsgSetTransferFee(ctx, keeper, msg)
+		case types.MsgClawback:
+			return handleMsgClawback(ctx, keeper, msg)
 		case types.MsgMintToken:
 			return handleMsgMintToken(ctx, keeper, msg)
 		case types.MsgBurnToken:
@@ -38,6 +61,20 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgUnForbidAddr(ctx, keeper, msg)
 		case types.MsgModifyTokenInfo:
 			return handleMsgModifyTokenInfo(ctx, keeper, msg)
+		case types.MsgCreateVestingSchedule:
+			return handleMsgCreateVestingSchedule(ctx, keeper, msg)
+		case types.MsgRevokeVestingSchedule:
+			return handleMsgRevokeVestingSchedule(ctx, keeper, msg)
+		case types.MsgGrantTokenRole:
+			return handleMsgGrantTokenRole(ctx, keeper, msg)
+		case types.MsgRevokeTokenRole:
+			return handleMsgRevokeTokenRole(ctx, keeper, msg)
+		case types.MsgSetApprovalPolicy:
+			return handleMsgSetApprovalPolicy(ctx, keeper, msg)
+		case types.MsgApproveTokenOperation:
+			return handleMsgApproveTokenOperation(ctx, keeper, msg)
+		case types.MsgCancelTokenOperation:
+			return handleMsgCancelTokenOperation(ctx, keeper, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -46,20 +83,99 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 // handleMsgIssueToken - Handle MsgIssueToken
 func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken) sdk.Result {
-	issueFee := keeper.GetParams(ctx).GetIssueTokenFee(msg.Symbol)
-
-	if err := keeper.DeductIssueFee(ctx, msg.Owner, issueFee); err != nil {
+	if err := issueToken(ctx, keeper, msg); err != nil {
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeIssueToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.Owner.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgIssueTokens - Handle MsgIssueTokens, and a failed token fails the whole batch
+func handleMsgIssueTokens(ctx sdk.Context, keeper Keeper, msg types.MsgIssueTokens) sdk.Result {
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	}
+	for i, token := range msg.Tokens {
+		if err := issueToken(ctx, keeper, token); err != nil {
+			return types.ErrInTokenBatch(i, token.Symbol, err).Result()
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypeIssueToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, token.Symbol),
+			sdk.NewAttribute(types.AttributeKeyTokenOwner, token.Owner.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func issueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken) sdk.Error {
+	// the winner of the symbol auction has paid for the symbol
+	if !keeper.IsSymbolAuctionWinner(ctx, msg.Symbol, msg.Owner) {
+		issueFee := keeper.GetIssueTokenFee(ctx, msg.Symbol)
+		if err := keeper.DeductIssueFee(ctx, msg.Owner, issueFee); err != nil {
+			return err
+		}
+	}
+
 	err := keeper.IssueToken(ctx, msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
 		msg.Mintable, msg.Burnable, msg.AddrForbiddable, msg.TokenForbiddable, msg.URL, msg.Description, msg.Identity)
 
 	if err != nil {
-		return err.Result()
+		return err
 	}
 
 	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, msg.Owner, types.NewTokenCoins(msg.Symbol, msg.TotalSupply)); err != nil {
+		return err
+	}
+	if msg.MintPolicy != nil {
+		if err := keeper.SetMintPolicy(ctx, msg.Symbol, msg.Owner, *msg.MintPolicy); err != nil {
+			return err
+		}
+	}
+	if msg.Permissioned {
+		if err := keeper.EnablePermissionedMode(ctx, msg.Symbol, msg.Owner); err != nil {
+			return err
+		}
+	}
+	if msg.Clawbackable {
+		if err := keeper.EnableClawback(ctx, msg.Symbol, msg.Owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleMsgTransferOwnership - Handle MsgTransferOwnership, which proposes the new owner
+func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferOwnership) sdk.Result {
+	if _, ok := keeper.GetApprovalPolicy(ctx, msg.Symbol); ok {
+		return handleTokenOperationProposal(ctx, keeper,
+			types.NewTransferOwnershipOperation(msg.Symbol, msg.OriginalOwner, msg.NewOwner))
+	}
+	po, err := keeper.ProposeOwnershipTransfer(ctx, msg.Symbol, msg.OriginalOwner, msg.NewOwner)
+	if err != nil {
 		return err.Result()
 	}
 
@@ -67,23 +183,25 @@ func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OriginalOwner.String()),
 		),
 		sdk.NewEvent(
-			types.EventTypeIssueToken,
+			types.EventTypeProposeOwnership,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
-			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyOriginalOwner, msg.OriginalOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyDeadline, strconv.FormatInt(po.Deadline, 10)),
 		),
 	})
-
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// handleMsgTransferOwnership - Handle MsgTransferOwnership
-func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferOwnership) sdk.Result {
-	if err := keeper.TransferOwnership(ctx, msg.Symbol, msg.OriginalOwner, msg.NewOwner); err != nil {
+// handleMsgAcceptOwnership - Handle MsgAcceptOwnership
+func handleMsgAcceptOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgAcceptOwnership) sdk.Result {
+	po, err := keeper.AcceptOwnership(ctx, msg.Symbol, msg.NewOwner)
+	if err != nil {
 		return err.Result()
 	}
 
@@ -91,13 +209,58 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTra
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.OriginalOwner.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.NewOwner.String()),
 		),
 		sdk.NewEvent(
 			types.EventTypeTransferOwnership,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyTokenOwner, msg.NewOwner.String()),
-			sdk.NewAttribute(types.AttributeKeyOriginalOwner, msg.OriginalOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyOriginalOwner, po.OriginalOwner.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgCancelOwnershipTransfer - Handle MsgCancelOwnershipTransfer
+func handleMsgCancelOwnershipTransfer(ctx sdk.Context, keeper Keeper, msg types.MsgCancelOwnershipTransfer) sdk.Result {
+	if err := keeper.CancelOwnershipTransfer(ctx, msg.Symbol, msg.OwnerAddress); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelOwnership,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSetMintPolicy - Handle MsgSetMintPolicy
+func handleMsgSetMintPolicy(ctx sdk.Context, keeper Keeper, msg types.MsgSetMintPolicy) sdk.Result {
+	if err := keeper.SetMintPolicy(ctx, msg.Symbol, msg.OwnerAddress, msg.Policy); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetMintPolicy,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyHardCap, msg.Policy.HardCap.String()),
 		),
 	})
 	return sdk.Result{
@@ -107,6 +270,10 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTra
 
 // handleMsgMintToken - Handle MsgMintToken
 func handleMsgMintToken(ctx sdk.Context, keeper Keeper, msg types.MsgMintToken) sdk.Result {
+	if _, ok := keeper.GetApprovalPolicy(ctx, msg.Symbol); ok {
+		return handleTokenOperationProposal(ctx, keeper,
+			types.NewMintOperation(msg.Symbol, msg.OwnerAddress, msg.Amount))
+	}
 	if err := keeper.MintToken(ctx, msg.Symbol, msg.OwnerAddress, msg.Amount); err != nil {
 		return err.Result()
 	}
@@ -164,6 +331,7 @@ func handleMsgForbidToken(ctx sdk.Context, keeper Keeper, msg types.MsgForbidTok
 	if err := keeper.ForbidToken(ctx, msg.Symbol, msg.OwnerAddress); err != nil {
 		return err.Result()
 	}
+	action := keeper.AddAdminAction(ctx, types.NewAdminAction(msg.Symbol, msg.Type(), msg.OwnerAddress, nil, msg.Reason))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -173,6 +341,8 @@ func handleMsgForbidToken(ctx sdk.Context, keeper Keeper, msg types.MsgForbidTok
 		),
 		sdk.NewEvent(types.EventTypeForbidToken,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
 		),
 	})
 
@@ -186,6 +356,7 @@ func handleMsgUnForbidToken(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbi
 	if err := keeper.UnForbidToken(ctx, msg.Symbol, msg.OwnerAddress); err != nil {
 		return err.Result()
 	}
+	action := keeper.AddAdminAction(ctx, types.NewAdminAction(msg.Symbol, msg.Type(), msg.OwnerAddress, nil, msg.Reason))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -195,6 +366,8 @@ func handleMsgUnForbidToken(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbi
 		),
 		sdk.NewEvent(types.EventTypeUnForbidToken,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
 		),
 	})
 
@@ -263,6 +436,7 @@ func handleMsgForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgForbidAddr
 	if err := keeper.ForbidAddress(ctx, msg.Symbol, msg.OwnerAddr, msg.Addresses); err != nil {
 		return err.Result()
 	}
+	action := keeper.AddAdminAction(ctx, types.NewAdminAction(msg.Symbol, msg.Type(), msg.OwnerAddr, msg.Addresses, msg.Reason))
 
 	var str string
 	for _, addr := range msg.Addresses {
@@ -278,6 +452,8 @@ func handleMsgForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgForbidAddr
 		sdk.NewEvent(types.EventTypeForbidAddr,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyAddrList, str),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
 		),
 	})
 
@@ -291,6 +467,7 @@ func handleMsgUnForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbid
 	if err := keeper.UnForbidAddress(ctx, msg.Symbol, msg.OwnerAddr, msg.Addresses); err != nil {
 		return err.Result()
 	}
+	action := keeper.AddAdminAction(ctx, types.NewAdminAction(msg.Symbol, msg.Type(), msg.OwnerAddr, msg.Addresses, msg.Reason))
 
 	var str string
 	for _, addr := range msg.Addresses {
@@ -306,6 +483,8 @@ func handleMsgUnForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbid
 		sdk.NewEvent(types.EventTypeUnForbidAddr,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyAddrList, str),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
 		),
 	})
 
@@ -328,12 +507,20 @@ func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModif
 		return err.Result()
 	}
 
+	newMetadata, err := CollectTokenMetadata(token, msg)
+	if err != nil {
+		return err.Result()
+	}
+
 	if err := keeper.ModifyTokenInfo(ctx, msg.Symbol, msg.OwnerAddress,
 		newURL, newDesc, newID, newName, newSupply,
 		newMintable, newBurnable, newAddrForbiddable, newTokenForbiddable); err != nil {
 
 		return err.Result()
 	}
+	if err := keeper.ModifyTokenMetadata(ctx, msg.Symbol, msg.OwnerAddress, newMetadata); err != nil {
+		return err.Result()
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -346,6 +533,8 @@ func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModif
 			sdk.NewAttribute(types.AttributeKeyURL, msg.URL),
 			sdk.NewAttribute(types.AttributeKeyDescription, msg.Description),
 			sdk.NewAttribute(types.AttributeKeyIdentity, msg.Identity),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.Itoa(int(newMetadata.Decimals))),
+			sdk.NewAttribute(types.AttributeKeyLogoURI, newMetadata.LogoURI),
 		),
 	})
 	return sdk.Result{
@@ -388,6 +577,236 @@ func CollectTokenModificationInfo(token types.Token, msg types.MsgModifyTokenInf
 	return
 }
 
+// handleMsgCreateVestingSchedule - Handle MsgCreateVestingSchedule
+func handleMsgCreateVestingSchedule(ctx sdk.Context, keeper Keeper, msg types.MsgCreateVestingSchedule) sdk.Result {
+	id, err := keeper.CreateVestingSchedule(ctx, msg.Schedule())
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateVesting,
+			sdk.NewAttribute(types.AttributeKeyVestingID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Beneficiary.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgRevokeVestingSchedule - Handle MsgRevokeVestingSchedule
+func handleMsgRevokeVestingSchedule(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeVestingSchedule) sdk.Result {
+	info, err := keeper.RevokeVestingSchedule(ctx, msg.ID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRevokeVesting,
+			sdk.NewAttribute(types.AttributeKeyVestingID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, info.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAmount, info.Returned.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleTokenOperationProposal - Handle MsgMintToken, MsgTransferOwnership and MsgSetApprovalPolicy of the tokens with approval policies
+func handleTokenOperationProposal(ctx sdk.Context, keeper Keeper, op types.TokenOperation) sdk.Result {
+	id, executed, err := keeper.ProposeTokenOperation(ctx, op)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, op.Proposer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeProposeOperation,
+			sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperationType, op.Type),
+			sdk.NewAttribute(types.AttributeKeySymbol, op.Symbol),
+			sdk.NewAttribute(types.AttributeKeyExecuted, strconv.FormatBool(executed)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgGrantTokenRole - Handle MsgGrantTokenRole
+func handleMsgGrantTokenRole(ctx sdk.Context, keeper Keeper, msg types.MsgGrantTokenRole) sdk.Result {
+	if err := keeper.GrantTokenRole(ctx, msg.Symbol, msg.OwnerAddress, msg.Role, msg.Address); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeGrantTokenRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgRevokeTokenRole - Handle MsgRevokeTokenRole
+func handleMsgRevokeTokenRole(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeTokenRole) sdk.Result {
+	if err := keeper.RevokeTokenRole(ctx, msg.Symbol, msg.OwnerAddress, msg.Role, msg.Address); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRevokeTokenRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSetApprovalPolicy - Handle MsgSetApprovalPolicy
+func handleMsgSetApprovalPolicy(ctx sdk.Context, keeper Keeper, msg types.MsgSetApprovalPolicy) sdk.Result {
+	if _, ok := keeper.GetApprovalPolicy(ctx, msg.Symbol); ok {
+		return handleTokenOperationProposal(ctx, keeper,
+			types.NewSetApprovalPolicyOperation(msg.Symbol, msg.OwnerAddress, msg.Approvers, msg.Threshold))
+	}
+	if err := keeper.SetApprovalPolicy(ctx, msg.Symbol, msg.OwnerAddress, msg.Approvers, msg.Threshold); err != nil {
+		return err.Result()
+	}
+
+	var str string
+	for _, addr := range msg.Approvers {
+		str = str + addr.String() + ","
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetApprovalPolicy,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(msg.Threshold, 10)),
+			sdk.NewAttribute(types.AttributeKeyAddrList, str),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgApproveTokenOperation - Handle MsgApproveTokenOperation
+func handleMsgApproveTokenOperation(ctx sdk.Context, keeper Keeper, msg types.MsgApproveTokenOperation) sdk.Result {
+	op, executed, err := keeper.ApproveTokenOperation(ctx, msg.ID, msg.Approver)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeApproveOperation,
+			sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperationType, op.Type),
+			sdk.NewAttribute(types.AttributeKeySymbol, op.Symbol),
+			sdk.NewAttribute(types.AttributeKeyExecuted, strconv.FormatBool(executed)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgCancelTokenOperation - Handle MsgCancelTokenOperation
+func handleMsgCancelTokenOperation(ctx sdk.Context, keeper Keeper, msg types.MsgCancelTokenOperation) sdk.Result {
+	op, err := keeper.CancelTokenOperation(ctx, msg.ID, msg.Sender)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelOperation,
+			sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperationType, op.Type),
+			sdk.NewAttribute(types.AttributeKeySymbol, op.Symbol),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// CollectTokenMetadata returns the metadata of the token after applying msg
+func CollectTokenMetadata(token types.Token, msg types.MsgModifyTokenInfo) (types.TokenMetadata, sdk.Error) {
+	metadata := token.GetMetadata()
+	if msg.Decimals != "" {
+		decimals, err := strconv.ParseUint(msg.Decimals, 10, 8)
+		if err != nil {
+			return metadata, types.ErrInvalidTokenInfo("Decimals", msg.Decimals)
+		}
+		metadata.Decimals = uint8(decimals)
+	}
+	if msg.LogoURI != "" {
+		metadata.LogoURI = msg.LogoURI
+	}
+	if msg.LogoHash != "" {
+		metadata.LogoHash = msg.LogoHash
+	}
+	metadata.SocialLinks = types.MergeEntries(metadata.SocialLinks, msg.SocialLinks)
+	metadata.Attributes = types.MergeEntries(metadata.Attributes, msg.Attributes)
+	return metadata, metadata.ValidateBasic()
+}
+
 func getNewStringVal(newVal, oldVal string) string {
 	if newVal == types.DoNotModifyTokenInfo {
 		return oldVal
@@ -410,3 +829,192 @@ func getNewIntVal(newVal string, oldVal sdk.Int) (sdk.Int, error) {
 	}
 	return n, nil
 }
+
+// handleMsgCreateHolderSnapshot - Handle MsgCreateHolderSnapshot
+func handleMsgCreateHolderSnapshot(ctx sdk.Context, keeper Keeper, msg types.MsgCreateHolderSnapshot) sdk.Result {
+	id, err := keeper.CreateHolderSnapshot(ctx, msg.Creator, msg.Denom, msg.Height)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateSnapshot,
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(msg.Height, 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgCreateAirdrop - Handle MsgCreateAirdrop
+func handleMsgCreateAirdrop(ctx sdk.Context, keeper Keeper, msg types.MsgCreateAirdrop) sdk.Result {
+	id, err := keeper.CreateAirdrop(ctx, msg.Sender, msg.SnapshotID, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(msg.SnapshotID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgBidSymbol - Handle MsgBidSymbol
+func handleMsgBidSymbol(ctx sdk.Context, keeper Keeper, msg types.MsgBidSymbol) sdk.Result {
+	auction, err := keeper.BidSymbol(ctx, msg.Bidder, msg.Symbol, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeBidSymbol,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(auction.EndTime, 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgDistributeDividend - Handle MsgDistributeDividend
+func handleMsgDistributeDividend(ctx sdk.Context, keeper Keeper, msg types.MsgDistributeDividend) sdk.Result {
+	if err := keeper.DistributeDividend(ctx, msg.Sender, msg.Symbol, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeDistributeDividend,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgClaimDividends - Handle MsgClaimDividends
+func handleMsgClaimDividends(ctx sdk.Context, keeper Keeper, msg types.MsgClaimDividends) sdk.Result {
+	claimed, err := keeper.ClaimDividends(ctx, msg.Holder, msg.Symbol)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Holder.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeClaimDividends,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyHolder, msg.Holder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSetTransferFee - Handle MsgSetTransferFee
+func handleMsgSetTransferFee(ctx sdk.Context, keeper Keeper, msg types.MsgSetTransferFee) sdk.Result {
+	if err := keeper.SetTransferFee(ctx, msg.Symbol, msg.OwnerAddress, msg.Policy); err != nil {
+		return err.Result()
+	}
+
+	rate, receiver := int64(0), ""
+	if msg.Policy != nil {
+		rate, receiver = msg.Policy.Rate, msg.Policy.Receiver.String()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetTransferFee,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRate, strconv.FormatInt(rate, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgClawback - Handle MsgClawback, which is recorded in the admin action log
+func handleMsgClawback(ctx sdk.Context, keeper Keeper, msg types.MsgClawback) sdk.Result {
+	orders, err := keeper.Clawback(ctx, msg.Symbol, msg.OwnerAddress, msg.Holder, msg.Amount, msg.Recipient)
+	if err != nil {
+		return err.Result()
+	}
+	addresses := []sdk.AccAddress{msg.Holder}
+	if !msg.Recipient.Empty() {
+		addresses = append(addresses, msg.Recipient)
+	}
+	action := types.NewAdminAction(msg.Symbol, msg.Type(), msg.OwnerAddress, addresses, msg.Reason).
+		WithCoins(types.NewTokenCoins(msg.Symbol, msg.Amount))
+	action = keeper.AddAdminAction(ctx, action)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyHolder, msg.Holder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnt, strconv.FormatBool(msg.Recipient.Empty())),
+			sdk.NewAttribute(types.AttributeKeyOrders, strings.Join(orders, ",")),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
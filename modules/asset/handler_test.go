@@ -3,6 +3,7 @@ package asset_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,11 @@ func Test_handleMsg(t *testing.T) {
 			asset.NewMsgTransferOwnership("abc", testAddr, owner),
 			true,
 		},
+		{
+			"accept_ownership",
+			asset.NewMsgAcceptOwnership("abc", owner),
+			true,
+		},
 		{
 			"transfer_ownership_invalid",
 			asset.NewMsgTransferOwnership("abc", testAddr, owner),
@@ -324,3 +330,170 @@ func newMsgModifyTokenInfo() asset.MsgModifyTokenInfo {
 		TokenForbiddable: types.DoNotModifyTokenInfo,
 	}
 }
+
+func Test_ModifyTokenMetadata(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	h := asset.NewHandler(input.tk)
+
+	// issue token
+	msgIssue := asset.NewMsgIssueToken("ABC Token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	res := h(input.ctx, msgIssue)
+	require.True(t, res.IsOK())
+
+	// set metadata
+	logoHash := strings.Repeat("0f", 32)
+	msg := newMsgModifyTokenInfo()
+	msg.Symbol = symbol
+	msg.OwnerAddress = testAddr
+	msg = msg.WithMetadata("8", "https://abc.org/logo.png", logoHash,
+		[]asset.MetadataEntry{{Key: "twitter", Value: "t"}, {Key: "github", Value: "g"}},
+		[]asset.MetadataEntry{{Key: "audit", Value: "ok"}})
+	res = h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	md := input.tk.GetToken(input.ctx, symbol).GetMetadata()
+	require.Equal(t, uint8(8), md.Decimals)
+	require.Equal(t, "https://abc.org/logo.png", md.LogoURI)
+	require.Equal(t, logoHash, md.LogoHash)
+	require.Equal(t, []asset.MetadataEntry{{Key: "github", Value: "g"}, {Key: "twitter", Value: "t"}}, md.SocialLinks)
+	require.Equal(t, []asset.MetadataEntry{{Key: "audit", Value: "ok"}}, md.Attributes)
+
+	// the empty fields are not modified, and an empty value removes the entry
+	msg = msg.WithMetadata("", "", "", []asset.MetadataEntry{{Key: "twitter", Value: ""}}, nil)
+	res = h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	md = input.tk.GetToken(input.ctx, symbol).GetMetadata()
+	require.Equal(t, uint8(8), md.Decimals)
+	require.Equal(t, "https://abc.org/logo.png", md.LogoURI)
+	require.Equal(t, []asset.MetadataEntry{{Key: "github", Value: "g"}}, md.SocialLinks)
+	require.Equal(t, []asset.MetadataEntry{{Key: "audit", Value: "ok"}}, md.Attributes)
+
+	// limited by params
+	params := input.tk.GetParams(input.ctx)
+	params.MaxMetadataEntries = 1
+	input.tk.SetParams(input.ctx, params)
+	msg = msg.WithMetadata("", "", "", nil, []asset.MetadataEntry{{Key: "kyc", Value: "yes"}})
+	res = h(input.ctx, msg)
+	require.False(t, res.IsOK())
+
+	// only the owner can modify it
+	msg = msg.WithMetadata("6", "", "", nil, nil)
+	msg.OwnerAddress = mockAddrList()[0]
+	res = h(input.ctx, msg)
+	require.False(t, res.IsOK())
+}
+
+func Test_PermissionedToken(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	addrs := mockAddrList()
+
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	msg := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString).WithPermissioned()
+	res := h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.tk.GetToken(input.ctx, "abc").GetPermissioned())
+
+	// only the owner could receive the token before anyone is whitelisted
+	require.True(t, input.tk.IsPermittedRecipient(input.ctx, "abc", testAddr))
+	require.False(t, input.tk.IsPermittedRecipient(input.ctx, "abc", addrs[0]))
+	require.True(t, input.tk.IsPermittedRecipient(input.ctx, "cet", addrs[0]))
+
+	// the whitelist could be used though the token is not forbiddable
+	res = h(input.ctx, asset.NewMsgAddTokenWhitelist("abc", testAddr, addrs[:1]))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, input.tk.IsPermittedRecipient(input.ctx, "abc", addrs[0]))
+	require.False(t, input.tk.IsPermittedRecipient(input.ctx, "abc", addrs[1]))
+	require.False(t, input.tk.IsForbiddenByTokenIssuer(input.ctx, "abc", addrs[1]))
+
+	res = h(input.ctx, asset.NewMsgRemoveTokenWhitelist("abc", testAddr, addrs[:1]))
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, input.tk.IsPermittedRecipient(input.ctx, "abc", addrs[0]))
+}
+
+func Test_PermissionedToken_Recipients(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	addrs := mockAddrListNoOwner()
+	whitelisted, outsider := addrs[0], addrs[1]
+
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18)))
+	msg := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, false, false, false, "", "", types.TestIdentityString).WithPermissioned()
+	res := h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgAddTokenWhitelist("abc", testAddr, []sdk.AccAddress{whitelisted}))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the coins of the module account are only paid to the permitted recipients
+	coins := types.NewTokenCoins("abc", sdk.NewInt(100))
+	require.NoError(t, input.tk.SendCoinsFromAccountToAssetModule(input.ctx, testAddr, coins))
+	err := input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, outsider, coins)
+	require.Equal(t, types.CodeRecipientNotWhitelisted, err.Code())
+	require.NoError(t, input.tk.SendCoinsFromAssetModuleToAccount(input.ctx, whitelisted, coins))
+
+	// the recipients set up by the owner must be permitted
+	res = h(input.ctx, asset.NewMsgSetTransferFee("abc", testAddr, &types.TransferFeePolicy{
+		Rate: 10, Cap: sdk.ZeroInt(), Receiver: outsider}))
+	require.Equal(t, types.CodeRecipientNotWhitelisted, res.Code)
+	policy := asset.NewMintPolicy(sdk.ZeroInt(), 0, sdk.ZeroInt(), sdk.NewInt(10), 100, outsider)
+	res = h(input.ctx, asset.NewMsgSetMintPolicy("abc", testAddr, policy))
+	require.Equal(t, types.CodeRecipientNotWhitelisted, res.Code)
+	res = h(input.ctx, asset.NewMsgCreateVestingSchedule(testAddr, outsider, "abc", sdk.NewInt(100),
+		1000, 0, 2000, 100, false))
+	require.Equal(t, types.CodeRecipientNotWhitelisted, res.Code)
+
+	// the vested coins are held while the beneficiary is removed from the whitelist
+	ctx := input.ctx.WithBlockTime(time.Unix(500, 0))
+	res = h(ctx, asset.NewMsgCreateVestingSchedule(testAddr, whitelisted, "abc", sdk.NewInt(100),
+		1000, 0, 2000, 100, false))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, asset.NewMsgRemoveTokenWhitelist("abc", testAddr, []sdk.AccAddress{whitelisted}))
+	require.True(t, res.IsOK(), res.Log)
+	ctx = ctx.WithBlockTime(time.Unix(2100, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(100), input.tk.GetAccTotalToken(ctx, whitelisted).AmountOf("abc"))
+	vs, ok := input.tk.GetVestingSchedule(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, 2100+types.VestingRetryInterval, vs.NextReleaseTime)
+
+	// and released once it is whitelisted again
+	res = h(ctx, asset.NewMsgAddTokenWhitelist("abc", testAddr, []sdk.AccAddress{whitelisted}))
+	require.True(t, res.IsOK(), res.Log)
+	ctx = ctx.WithBlockTime(time.Unix(2100+types.VestingRetryInterval, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(200), input.tk.GetAccTotalToken(ctx, whitelisted).AmountOf("abc"))
+	_, ok = input.tk.GetVestingSchedule(ctx, 1)
+	require.False(t, ok)
+}
+
+func Test_IssueTokens(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	abc := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	xyz := asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(1000), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString).WithPermissioned()
+	res := h(input.ctx, asset.NewMsgIssueTokens(testAddr, []asset.MsgIssueToken{abc, xyz}))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(2100), input.tk.GetToken(input.ctx, "abc").GetTotalSupply())
+	require.True(t, input.tk.GetToken(input.ctx, "xyz").GetPermissioned())
+	require.Equal(t, int64(1000), input.tk.GetAccTotalToken(input.ctx, testAddr).AmountOf("xyz").Int64())
+
+	// the error tells which token of the batch fails
+	cache, _ := input.ctx.CacheContext()
+	def := asset.NewMsgIssueToken("DEF Token", "def", sdk.NewInt(1000), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	res = h(cache, asset.NewMsgIssueTokens(testAddr, []asset.MsgIssueToken{def, abc}))
+	require.Equal(t, types.CodeDuplicateTokenSymbol, res.Code)
+	require.Contains(t, res.Log, "token #2 (abc)")
+}
@@ -0,0 +1,61 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// AddAdminAction appends an entry to the admin action log of the token, at the current block
+func (keeper BaseKeeper) AddAdminAction(ctx sdk.Context, action types.AdminAction) types.AdminAction {
+	action.ID = keeper.getNextID(ctx, types.AdminActionNextIDKey)
+	action.Height = ctx.BlockHeight()
+	action.Time = ctx.BlockHeader().Time.Unix()
+	keeper.SetAdminAction(ctx, action)
+	return action
+}
+
+// SetAdminAction - set an entry of the admin action log to store
+func (keeper BaseKeeper) SetAdminAction(ctx sdk.Context, action types.AdminAction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetAdminActionKey(action.Symbol, action.ID), keeper.cdc.MustMarshalBinaryBare(action))
+	keeper.updateNextID(ctx, types.AdminActionNextIDKey, action.ID)
+}
+
+// GetAdminActions returns a page of the admin action log of the token, the latest first.
+// The page number starts from 1.
+func (keeper BaseKeeper) GetAdminActions(ctx sdk.Context, symbol string, page, limit int) []types.AdminAction {
+	actions := make([]types.AdminAction, 0, limit)
+	if page < 1 || limit < 1 {
+		return actions
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetAdminActionPrefix(symbol))
+	defer iterator.Close()
+
+	for skip := (page - 1) * limit; iterator.Valid() && len(actions) < limit; iterator.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		var action types.AdminAction
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &action)
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// GetAllAdminActions returns the admin action logs of all the tokens
+func (keeper BaseKeeper) GetAllAdminActions(ctx sdk.Context) []types.AdminAction {
+	actions := make([]types.AdminAction, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AdminActionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.AdminAction
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &action)
+		actions = append(actions, action)
+	}
+	return actions
+}
@@ -0,0 +1,314 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// CreateHolderSnapshot charges the fee from the creator and schedules a snapshot of the holders of denom,
+// which is taken in the EndBlocker of the height
+func (keeper BaseKeeper) CreateHolderSnapshot(ctx sdk.Context, creator sdk.AccAddress, denom string, height int64) (uint64, sdk.Error) {
+	if height < ctx.BlockHeight() {
+		return 0, types.ErrInvalidHolderSnapshot("the height has passed")
+	}
+	if !keeper.IsTokenExists(ctx, denom) {
+		return 0, types.ErrTokenNotFound(denom)
+	}
+	if err := keeper.bkx.DeductInt64CetFee(ctx, creator, keeper.GetParams(ctx).HolderSnapshotFee); err != nil {
+		return 0, err
+	}
+
+	snapshot := types.HolderSnapshot{
+		ID:          keeper.getNextID(ctx, types.HolderSnapshotNextIDKey),
+		Denom:       denom,
+		Creator:     creator,
+		Height:      height,
+		TotalAmount: sdk.ZeroInt(),
+	}
+	keeper.SetHolderSnapshot(ctx, snapshot)
+	return snapshot.ID, nil
+}
+
+// TakeHolderSnapshots records the holders of the snapshots scheduled at or before the current height,
+// at most MaxHolderSnapshotsPerBlock of them in a block
+func (keeper BaseKeeper) TakeHolderSnapshots(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.SnapshotQueueKey, sdk.PrefixEndBytes(types.GetSnapshotQueueHeightKey(ctx.BlockHeight())))
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < types.MaxHolderSnapshotsPerBlock; iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		snapshot, ok := keeper.GetHolderSnapshot(ctx, id)
+		if !ok {
+			continue
+		}
+		store.Delete(types.GetSnapshotQueueKey(snapshot.Height, id))
+		snapshot.Height = ctx.BlockHeight()
+		keeper.bkx.IterateTokenHolders(ctx, snapshot.Denom, func(addr sdk.AccAddress, amount sdk.Int) bool {
+			keeper.setSnapshotHolder(ctx, id, addr, amount)
+			snapshot.HolderCount++
+			snapshot.TotalAmount = snapshot.TotalAmount.Add(amount)
+			return false
+		})
+		snapshot.Taken = true
+		keeper.SetHolderSnapshot(ctx, snapshot)
+		keeper.fillMsgQueue(ctx, types.KafkaHolderSnapshot, snapshot)
+	}
+}
+
+// CreateAirdrop escrows the amount from the sender into the asset module account,
+// which is distributed to the holders of the snapshot at EndBlocker
+func (keeper BaseKeeper) CreateAirdrop(ctx sdk.Context, sender sdk.AccAddress, snapshotID uint64, amount sdk.Coin) (uint64, sdk.Error) {
+	snapshot, ok := keeper.GetHolderSnapshot(ctx, snapshotID)
+	if !ok {
+		return 0, types.ErrHolderSnapshotNotFound(snapshotID)
+	}
+	if !snapshot.Taken {
+		return 0, types.ErrInvalidAirdrop("the snapshot has not been taken yet")
+	}
+	if !snapshot.TotalAmount.IsPositive() {
+		return 0, types.ErrInvalidAirdrop("the snapshot has no holders")
+	}
+	if !keeper.IsTokenExists(ctx, amount.Denom) {
+		return 0, types.ErrTokenNotFound(amount.Denom)
+	}
+	if keeper.IsForbiddenByTokenIssuer(ctx, amount.Denom, sender) {
+		return 0, types.ErrInvalidAirdrop("the token is forbidden for the sender")
+	}
+	if err := keeper.SendCoinsFromAccountToAssetModule(ctx, sender, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	airdrop := types.Airdrop{
+		ID:          keeper.getNextID(ctx, types.AirdropNextIDKey),
+		SnapshotID:  snapshotID,
+		Sender:      sender,
+		Amount:      amount,
+		Distributed: sdk.ZeroInt(),
+	}
+	keeper.SetAirdrop(ctx, airdrop)
+	return airdrop.ID, nil
+}
+
+// DistributeAirdrops pays the holders of the unfinished airdrops in the order of their IDs,
+// at most AirdropBatchSize holders in a block
+func (keeper BaseKeeper) DistributeAirdrops(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActiveAirdropKey)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	budget := keeper.GetParams(ctx).AirdropBatchSize
+	for _, id := range ids {
+		if budget <= 0 {
+			break
+		}
+		airdrop, ok := keeper.GetAirdrop(ctx, id)
+		if !ok {
+			continue
+		}
+		budget -= keeper.payAirdrop(ctx, &airdrop, budget)
+	}
+}
+
+// payAirdrop pays at most n holders after the cursor, and finishes the airdrop when all have been paid
+func (keeper BaseKeeper) payAirdrop(ctx sdk.Context, airdrop *types.Airdrop, n int64) int64 {
+	snapshot, _ := keeper.GetHolderSnapshot(ctx, airdrop.SnapshotID)
+	holders := keeper.getSnapshotHoldersAfter(ctx, airdrop.SnapshotID, airdrop.Cursor, n)
+	for _, holder := range holders {
+		share := airdrop.ShareOf(holder.Amount, snapshot.TotalAmount)
+		// the share of a holder who is not allowed to receive the permissioned token is returned
+		if share.IsPositive() && keeper.IsPermittedRecipient(ctx, airdrop.Amount.Denom, holder.Address) {
+			// the coins have been escrowed when the airdrop was created
+			if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, holder.Address, types.NewTokenCoins(airdrop.Amount.Denom, share)); err != nil {
+				panic(err)
+			}
+			airdrop.Distributed = airdrop.Distributed.Add(share)
+		}
+		airdrop.Paid++
+		airdrop.Cursor = holder.Address
+	}
+
+	returned := sdk.ZeroInt()
+	if int64(len(holders)) < n {
+		returned = airdrop.Amount.Amount.Sub(airdrop.Distributed)
+		if returned.IsPositive() {
+			// to the token owner, who is always allowed, if the sender can not receive the permissioned token any more
+			receiver := airdrop.Sender
+			if !keeper.IsPermittedRecipient(ctx, airdrop.Amount.Denom, receiver) {
+				receiver = keeper.GetToken(ctx, airdrop.Amount.Denom).GetOwner()
+			}
+			if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, receiver, types.NewTokenCoins(airdrop.Amount.Denom, returned)); err != nil {
+				panic(err)
+			}
+		}
+		airdrop.Finished = true
+	}
+	keeper.SetAirdrop(ctx, *airdrop)
+	keeper.fillMsgQueue(ctx, types.KafkaAirdropProgress, types.AirdropProgressInfo{
+		ID:          airdrop.ID,
+		SnapshotID:  airdrop.SnapshotID,
+		Sender:      airdrop.Sender,
+		Denom:       airdrop.Amount.Denom,
+		Distributed: airdrop.Distributed,
+		Paid:        airdrop.Paid,
+		HolderCount: snapshot.HolderCount,
+		Finished:    airdrop.Finished,
+		Returned:    returned,
+		Height:      ctx.BlockHeight(),
+	})
+	return int64(len(holders))
+}
+
+// getSnapshotHoldersAfter returns at most n holders of a snapshot whose addresses are after the cursor
+func (keeper BaseKeeper) getSnapshotHoldersAfter(ctx sdk.Context, id uint64, cursor sdk.AccAddress, n int64) []types.SnapshotHolder {
+	prefix := types.GetSnapshotHolderPrefix(id)
+	start := prefix
+	if !cursor.Empty() {
+		start = append(types.GetSnapshotHolderKey(id, cursor), 0)
+	}
+	iterator := ctx.KVStore(keeper.storeKey).Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+	holders := make([]types.SnapshotHolder, 0)
+	for ; iterator.Valid() && int64(len(holders)) < n; iterator.Next() {
+		holders = append(holders, keeper.decodeSnapshotHolder(id, iterator.Key()[len(prefix):], iterator.Value()))
+	}
+	return holders
+}
+
+func (keeper BaseKeeper) decodeSnapshotHolder(id uint64, addr, bz []byte) types.SnapshotHolder {
+	var amount sdk.Int
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return types.SnapshotHolder{
+		SnapshotID: id,
+		Address:    append(sdk.AccAddress{}, addr...),
+		Amount:     amount,
+	}
+}
+
+func (keeper BaseKeeper) setSnapshotHolder(ctx sdk.Context, id uint64, addr sdk.AccAddress, amount sdk.Int) {
+	ctx.KVStore(keeper.storeKey).Set(types.GetSnapshotHolderKey(id, addr), keeper.cdc.MustMarshalBinaryBare(amount))
+}
+
+// SetSnapshotHolder - stores the balance of a holder in a snapshot, used at genesis
+func (keeper BaseKeeper) SetSnapshotHolder(ctx sdk.Context, holder types.SnapshotHolder) {
+	keeper.setSnapshotHolder(ctx, holder.SnapshotID, holder.Address, holder.Amount)
+}
+
+// GetAllSnapshotHolders - returns the holders of all the snapshots
+func (keeper BaseKeeper) GetAllSnapshotHolders(ctx sdk.Context) []types.SnapshotHolder {
+	holders := make([]types.SnapshotHolder, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SnapshotHolderKey)
+	defer iterator.Close()
+	prefixLen := len(types.GetSnapshotHolderPrefix(0))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		id := binary.BigEndian.Uint64(key[len(types.SnapshotHolderKey):prefixLen])
+		holders = append(holders, keeper.decodeSnapshotHolder(id, key[prefixLen:], iterator.Value()))
+	}
+	return holders
+}
+
+// SetHolderSnapshot stores a snapshot, with its entry in the snapshot queue if it is not taken yet
+func (keeper BaseKeeper) SetHolderSnapshot(ctx sdk.Context, snapshot types.HolderSnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetHolderSnapshotKey(snapshot.ID), keeper.cdc.MustMarshalBinaryBare(snapshot))
+	if !snapshot.Taken {
+		store.Set(types.GetSnapshotQueueKey(snapshot.Height, snapshot.ID), sdk.Uint64ToBigEndian(snapshot.ID))
+	}
+	keeper.updateNextID(ctx, types.HolderSnapshotNextIDKey, snapshot.ID)
+}
+
+// GetHolderSnapshot - returns the snapshot by its ID
+func (keeper BaseKeeper) GetHolderSnapshot(ctx sdk.Context, id uint64) (snapshot types.HolderSnapshot, ok bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetHolderSnapshotKey(id))
+	if bz == nil {
+		return snapshot, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+	return snapshot, true
+}
+
+// GetAllHolderSnapshots - returns all the snapshots
+func (keeper BaseKeeper) GetAllHolderSnapshots(ctx sdk.Context) []types.HolderSnapshot {
+	snapshots := make([]types.HolderSnapshot, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HolderSnapshotKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.HolderSnapshot
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// SetAirdrop stores an airdrop, with its entry in the active airdrops if it is not finished
+func (keeper BaseKeeper) SetAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetAirdropKey(airdrop.ID), keeper.cdc.MustMarshalBinaryBare(airdrop))
+	if airdrop.Finished {
+		store.Delete(types.GetActiveAirdropKey(airdrop.ID))
+	} else {
+		store.Set(types.GetActiveAirdropKey(airdrop.ID), sdk.Uint64ToBigEndian(airdrop.ID))
+	}
+	keeper.updateNextID(ctx, types.AirdropNextIDKey, airdrop.ID)
+}
+
+// GetAirdrop - returns the airdrop by its ID
+func (keeper BaseKeeper) GetAirdrop(ctx sdk.Context, id uint64) (airdrop types.Airdrop, ok bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetAirdropKey(id))
+	if bz == nil {
+		return airdrop, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &airdrop)
+	return airdrop, true
+}
+
+// GetAllAirdrops - returns all the airdrops
+func (keeper BaseKeeper) GetAllAirdrops(ctx sdk.Context) []types.Airdrop {
+	airdrops := make([]types.Airdrop, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AirdropKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var airdrop types.Airdrop
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &airdrop)
+		airdrops = append(airdrops, airdrop)
+	}
+	return airdrops
+}
+
+// getNextID returns the next ID of the counter stored at key, which starts from 1, and increases the counter.
+// All the ID counters of the module, such as the ones of snapshots, airdrops, vestings and token operations, use it.
+func (keeper BaseKeeper) getNextID(ctx sdk.Context, key []byte) uint64 {
+	id := keeper.peekNextID(ctx, key)
+	ctx.KVStore(keeper.storeKey).Set(key, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// peekNextID returns the next ID of the counter stored at key without increasing it
+func (keeper BaseKeeper) peekNextID(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// updateNextID raises the counter stored at key above id, when an object is stored with its ID, e.g. from genesis
+func (keeper BaseKeeper) updateNextID(ctx sdk.Context, key []byte, id uint64) {
+	if id >= keeper.peekNextID(ctx, key) {
+		ctx.KVStore(keeper.storeKey).Set(key, sdk.Uint64ToBigEndian(id+1))
+	}
+}
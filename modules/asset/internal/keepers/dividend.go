@@ -0,0 +1,230 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// the tokens held by the asset module account, such as vesting and airdrop escrows and unclaimed dividends,
+// are not paid dividends
+var assetModuleAddr = supply.NewModuleAddress(types.ModuleName)
+
+// HasDividends returns whether the holders of the token have been paid dividends,
+// which must be settled before their balances change
+func (keeper BaseTokenKeeper) HasDividends(ctx sdk.Context, symbol string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDividendPoolPrefix(symbol))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// SettleDividends is the hook called before the balance of addr in symbol changes,
+// which accrues the dividends of the current balance
+func (keeper BaseTokenKeeper) SettleDividends(ctx sdk.Context, symbol string, addr sdk.AccAddress, balance sdk.Int) {
+	if addr.Equals(assetModuleAddr) {
+		return
+	}
+	for _, pool := range keeper.GetDividendPools(ctx, symbol) {
+		account := keeper.getDividendAccount(ctx, pool, addr)
+		account.Settle(pool, balance)
+		keeper.SetDividendAccount(ctx, account)
+	}
+}
+
+// GetDividendPools returns the dividend pools of a token, one for each denom paid
+func (keeper BaseTokenKeeper) GetDividendPools(ctx sdk.Context, symbol string) []types.DividendPool {
+	return keeper.getDividendPools(ctx, types.GetDividendPoolPrefix(symbol))
+}
+
+// GetAllDividendPools returns the dividend pools of all the tokens
+func (keeper BaseTokenKeeper) GetAllDividendPools(ctx sdk.Context) []types.DividendPool {
+	return keeper.getDividendPools(ctx, types.DividendPoolKey)
+}
+
+func (keeper BaseTokenKeeper) getDividendPools(ctx sdk.Context, prefix []byte) []types.DividendPool {
+	pools := make([]types.DividendPool, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.DividendPool
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// SetDividendPool - set dividend pool to store
+func (keeper BaseTokenKeeper) SetDividendPool(ctx sdk.Context, pool types.DividendPool) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetDividendPoolKey(pool.Symbol, pool.Denom), keeper.cdc.MustMarshalBinaryBare(pool))
+}
+
+// GetAllDividendAccounts returns the dividends accrued to all the holders
+func (keeper BaseTokenKeeper) GetAllDividendAccounts(ctx sdk.Context) []types.DividendAccount {
+	accounts := make([]types.DividendAccount, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DividendAccountKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var account types.DividendAccount
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &account)
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// SetDividendAccount - set the dividends accrued to a holder to store
+func (keeper BaseTokenKeeper) SetDividendAccount(ctx sdk.Context, account types.DividendAccount) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.GetDividendAccountKey(account.Symbol, account.Denom, account.Address)
+	store.Set(key, keeper.cdc.MustMarshalBinaryBare(account))
+}
+
+// getDividendAccount returns the dividends accrued to a holder, who has held the same balance
+// since the pool was created if it has not been settled
+func (keeper BaseTokenKeeper) getDividendAccount(ctx sdk.Context, pool types.DividendPool, addr sdk.AccAddress) types.DividendAccount {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetDividendAccountKey(pool.Symbol, pool.Denom, addr))
+	if bz == nil {
+		return types.DividendAccount{
+			Symbol:             pool.Symbol,
+			Denom:              pool.Denom,
+			Address:            addr,
+			RewardPerSharePaid: sdk.ZeroDec(),
+			Pending:            sdk.ZeroDec(),
+		}
+	}
+	var account types.DividendAccount
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &account)
+	return account
+}
+
+// DistributeDividend escrows the amount from the token owner into the asset module account,
+// and raises the reward per share of the token by amount/eligible-supply, which excludes the module accounts
+// and the reserves of the pools
+func (keeper BaseKeeper) DistributeDividend(ctx sdk.Context, sender sdk.AccAddress, symbol string, amount sdk.Coin) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, sender); err != nil {
+		return err
+	}
+	if !keeper.IsTokenExists(ctx, amount.Denom) {
+		return types.ErrTokenNotFound(amount.Denom)
+	}
+	if keeper.IsForbiddenByTokenIssuer(ctx, amount.Denom, sender) {
+		return types.ErrInvalidDividend("the token is forbidden for the sender")
+	}
+	if err := keeper.SendCoinsFromAccountToAssetModule(ctx, sender, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	token := keeper.GetToken(ctx, symbol)
+	eligible := token.GetTotalSupply().Sub(keeper.getModuleAccountsBalance(ctx, symbol))
+	if !eligible.IsPositive() {
+		return types.ErrInvalidDividend("the token is not held by anyone")
+	}
+	delta := amount.Amount.ToDec().QuoInt(eligible)
+	if !delta.IsPositive() {
+		return types.ErrInvalidDividend("the amount is too small to be distributed")
+	}
+
+	pool, ok := keeper.getDividendPool(ctx, symbol, amount.Denom)
+	if !ok {
+		pool = types.NewDividendPool(symbol, amount.Denom)
+	}
+	pool.RewardPerShare = pool.RewardPerShare.Add(delta)
+	pool.TotalPaid = pool.TotalPaid.Add(amount.Amount)
+	keeper.SetDividendPool(ctx, pool)
+	return nil
+}
+
+// getModuleAccountsBalance returns the amount of the token held by the asset module account, the other module accounts
+// and the reserve accounts of the pools
+func (keeper BaseKeeper) getModuleAccountsBalance(ctx sdk.Context, symbol string) sdk.Int {
+	balance := keeper.bkx.GetTotalCoins(ctx, assetModuleAddr).AmountOf(symbol)
+	for _, addr := range keeper.moduleAccounts {
+		if !addr.Equals(assetModuleAddr) {
+			balance = balance.Add(keeper.bkx.GetTotalCoins(ctx, addr).AmountOf(symbol))
+		}
+	}
+	if keeper.rk != nil {
+		for _, addr := range keeper.rk.GetReserveAccounts(ctx, symbol) {
+			balance = balance.Add(keeper.bkx.GetTotalCoins(ctx, addr).AmountOf(symbol))
+		}
+	}
+	return balance
+}
+
+// ClaimDividends pays the holder all the dividends accrued in the pools of the token
+func (keeper BaseKeeper) ClaimDividends(ctx sdk.Context, holder sdk.AccAddress, symbol string) (sdk.Coins, sdk.Error) {
+	pools := keeper.GetDividendPools(ctx, symbol)
+	if len(pools) == 0 {
+		return nil, types.ErrDividendPoolNotFound(symbol)
+	}
+
+	balance := keeper.bkx.GetTotalCoins(ctx, holder).AmountOf(symbol)
+	claimed := sdk.NewCoins()
+	for _, pool := range pools {
+		account := keeper.getDividendAccount(ctx, pool, holder)
+		account.Settle(pool, balance)
+		amount := sdk.MinInt(account.Pending.TruncateInt(), pool.Unclaimed())
+		if amount.IsPositive() {
+			account.Pending = account.Pending.Sub(amount.ToDec())
+			pool.TotalClaimed = pool.TotalClaimed.Add(amount)
+			keeper.SetDividendPool(ctx, pool)
+			claimed = claimed.Add(types.NewTokenCoins(pool.Denom, amount))
+		}
+		keeper.SetDividendAccount(ctx, account)
+	}
+	if claimed.Empty() {
+		return nil, types.ErrInvalidDividend("no dividends to claim")
+	}
+
+	// the accounts must have been settled before the dividends are paid, in case they are paid in the token itself
+	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, holder, claimed); err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// GetClaimableDividends returns the dividends that the holder can claim now
+func (keeper BaseKeeper) GetClaimableDividends(ctx sdk.Context, symbol string, holder sdk.AccAddress) []types.ClaimableDividend {
+	balance := keeper.bkx.GetTotalCoins(ctx, holder).AmountOf(symbol)
+	claimable := make([]types.ClaimableDividend, 0)
+	for _, pool := range keeper.GetDividendPools(ctx, symbol) {
+		account := keeper.getDividendAccount(ctx, pool, holder)
+		account.Settle(pool, balance)
+		claimable = append(claimable, types.ClaimableDividend{
+			Denom:  pool.Denom,
+			Amount: sdk.MinInt(account.Pending.TruncateInt(), pool.Unclaimed()),
+		})
+	}
+	return claimable
+}
+
+// settleDividends settles the dividends of addr before amt is moved in or out of its account
+func (keeper BaseKeeper) settleDividends(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	var total sdk.Coins
+	for _, coin := range amt {
+		if !keeper.HasDividends(ctx, coin.Denom) {
+			continue
+		}
+		if total == nil {
+			total = keeper.bkx.GetTotalCoins(ctx, addr)
+		}
+		keeper.SettleDividends(ctx, coin.Denom, addr, total.AmountOf(coin.Denom))
+	}
+}
+
+func (keeper BaseTokenKeeper) getDividendPool(ctx sdk.Context, symbol, denom string) (pool types.DividendPool, ok bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetDividendPoolKey(symbol, denom))
+	if bz == nil {
+		return pool, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &pool)
+	return pool, true
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -37,9 +38,71 @@ type Keeper interface {
 	ModifyTokenInfo(ctx sdk.Context, symbol string, owner sdk.AccAddress,
 		url, description, identity, name string, totalSupply sdk.Int,
 		mintable, burnable, addrForbiddable, tokenForbiddable bool) sdk.Error
+	ModifyTokenMetadata(ctx sdk.Context, symbol string, owner sdk.AccAddress, metadata types.TokenMetadata) sdk.Error
+
+	CreateVestingSchedule(ctx sdk.Context, vs types.VestingSchedule) (uint64, sdk.Error)
+	RevokeVestingSchedule(ctx sdk.Context, id uint64, creator sdk.AccAddress) (types.VestingRevokeInfo, sdk.Error)
+	ReleaseVestingSchedules(ctx sdk.Context)
+	GetVestingSchedule(ctx sdk.Context, id uint64) (types.VestingSchedule, bool)
+	GetVestingSchedulesOf(ctx sdk.Context, beneficiary sdk.AccAddress) []types.VestingSchedule
+
+	GrantTokenRole(ctx sdk.Context, symbol string, owner sdk.AccAddress, role string, addr sdk.AccAddress) sdk.Error
+	RevokeTokenRole(ctx sdk.Context, symbol string, owner sdk.AccAddress, role string, addr sdk.AccAddress) sdk.Error
+	GetTokenRoles(ctx sdk.Context, symbol string) []types.TokenRole
+	SetApprovalPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, approvers []sdk.AccAddress, threshold int64) sdk.Error
+	GetApprovalPolicy(ctx sdk.Context, symbol string) (types.ApprovalPolicy, bool)
+	ProposeTokenOperation(ctx sdk.Context, op types.TokenOperation) (uint64, bool, sdk.Error)
+	ApproveTokenOperation(ctx sdk.Context, id uint64, approver sdk.AccAddress) (types.TokenOperation, bool, sdk.Error)
+	CancelTokenOperation(ctx sdk.Context, id uint64, sender sdk.AccAddress) (types.TokenOperation, sdk.Error)
+	GetTokenOperations(ctx sdk.Context, symbol string) []types.TokenOperation
+
+	ProposeOwnershipTransfer(ctx sdk.Context, symbol string, originalOwner sdk.AccAddress, newOwner sdk.AccAddress) (types.PendingOwnership, sdk.Error)
+	AcceptOwnership(ctx sdk.Context, symbol string, newOwner sdk.AccAddress) (types.PendingOwnership, sdk.Error)
+	CancelOwnershipTransfer(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error
+	RemoveExpiredOwnershipTransfers(ctx sdk.Context)
+
+	SetMintPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy types.MintPolicy) sdk.Error
+	MintInflation(ctx sdk.Context)
+
+	CreateHolderSnapshot(ctx sdk.Context, creator sdk.AccAddress, denom string, height int64) (uint64, sdk.Error)
+	TakeHolderSnapshots(ctx sdk.Context)
+	GetHolderSnapshot(ctx sdk.Context, id uint64) (types.HolderSnapshot, bool)
+	CreateAirdrop(ctx sdk.Context, sender sdk.AccAddress, snapshotID uint64, amount sdk.Coin) (uint64, sdk.Error)
+	DistributeAirdrops(ctx sdk.Context)
+	GetAirdrop(ctx sdk.Context, id uint64) (types.Airdrop, bool)
+
+	BidSymbol(ctx sdk.Context, bidder sdk.AccAddress, symbol string, amount sdk.Int) (types.SymbolAuction, sdk.Error)
+	SettleSymbolAuctions(ctx sdk.Context)
+	IsSymbolAuctionWinner(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
+	GetSymbolAuction(ctx sdk.Context, symbol string) (types.SymbolAuction, bool)
+	GetAllSymbolAuctions(ctx sdk.Context) []types.SymbolAuction
+
+	DistributeDividend(ctx sdk.Context, sender sdk.AccAddress, symbol string, amount sdk.Coin) sdk.Error
+	ClaimDividends(ctx sdk.Context, holder sdk.AccAddress, symbol string) (sdk.Coins, sdk.Error)
+	GetClaimableDividends(ctx sdk.Context, symbol string, holder sdk.AccAddress) []types.ClaimableDividend
+	GetDividendPools(ctx sdk.Context, symbol string) []types.DividendPool
+	GetAllDividendPools(ctx sdk.Context) []types.DividendPool
+	SetDividendPool(ctx sdk.Context, pool types.DividendPool)
+	GetAllDividendAccounts(ctx sdk.Context) []types.DividendAccount
+	SetDividendAccount(ctx sdk.Context, account types.DividendAccount)
+
+	SetTransferFee(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy *types.TransferFeePolicy) sdk.Error
+	EnablePermissionedMode(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error
+	EnableClawback(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error
+	Clawback(ctx sdk.Context, symbol string, owner, holder sdk.AccAddress, amount sdk.Int, recipient sdk.AccAddress) ([]string, sdk.Error)
+
+	AddAdminAction(ctx sdk.Context, action types.AdminAction) types.AdminAction
+	SetAdminAction(ctx sdk.Context, action types.AdminAction)
+	GetAdminActions(ctx sdk.Context, symbol string, page, limit int) []types.AdminAction
+	GetAllAdminActions(ctx sdk.Context) []types.AdminAction
+
+	SearchTokens(ctx sdk.Context, filter types.TokenFilter, page, limit int) []types.Token
+
+	GetPendingOwnership(ctx sdk.Context, symbol string) (types.PendingOwnership, bool)
 
 	SetParams(ctx sdk.Context, params types.Params)
 	GetParams(ctx sdk.Context) (params types.Params)
+	GetIssueTokenFee(ctx sdk.Context, symbol string) int64
 }
 
 var _ Keeper = (*BaseKeeper)(nil)
@@ -57,11 +120,20 @@ type BaseKeeper struct {
 
 	bkx types.ExpectedBankxKeeper
 	sk  types.ExpectedSupplyKeeper
+
+	msgProducer msgqueue.MsgSender
+	oc          types.ExpectedOrderCanceller
+	pk          dex.CetPriceKeeper
+	rk          types.ExpectedReserveKeeper
+
+	// the module accounts, which are excluded from the supply eligible for dividends
+	moduleAccounts []sdk.AccAddress
 }
 
 // NewBaseKeeper returns a new BaseKeeper that uses go-amino to (binary) encode and decode concrete Token.
 func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramStore params.Subspace, bkx types.ExpectedBankxKeeper, sk supply.Keeper) BaseKeeper {
+	paramStore params.Subspace, bkx types.ExpectedBankxKeeper, sk supply.Keeper,
+	msgProducer msgqueue.MsgSender) BaseKeeper {
 	return BaseKeeper{
 		BaseTokenKeeper: NewBaseTokenKeeper(cdc, key),
 
@@ -70,9 +142,41 @@ func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey,
 		paramSubspace: paramStore.WithKeyTable(ParamKeyTable()),
 		bkx:           bkx,
 		sk:            sk,
+		msgProducer:   msgProducer,
+	}
+}
+
+// SetOrderCanceller enables clawbacks to unwind the market orders of the holders.
+// The coins frozen in orders can not be clawed back if it is not set.
+func (keeper *BaseKeeper) SetOrderCanceller(oc types.ExpectedOrderCanceller) {
+	keeper.oc = oc
+}
+
+// SetCetPriceKeeper enables pricing the issue fees in a reference token, with the price of CET it gives.
+// The fees are fixed in CET if it is not set.
+func (keeper *BaseKeeper) SetCetPriceKeeper(pk dex.CetPriceKeeper) {
+	keeper.pk = pk
+}
+
+// SetModuleAccountAddrs sets the bech32 addresses of the module accounts, such as the fee collector and the
+// staking pools, whose balances are not paid dividends. Only the asset module account is excluded if it is not set.
+func (keeper *BaseKeeper) SetModuleAccountAddrs(addrs map[string]bool) {
+	keeper.moduleAccounts = keeper.moduleAccounts[:0]
+	for bech32 := range addrs {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			panic(err)
+		}
+		keeper.moduleAccounts = append(keeper.moduleAccounts, addr)
 	}
 }
 
+// SetReserveKeeper sets the keeper of the pools whose reserves are not paid dividends, like the module accounts.
+// The reserves are counted as held by anyone if it is not set.
+func (keeper *BaseKeeper) SetReserveKeeper(rk types.ExpectedReserveKeeper) {
+	keeper.rk = rk
+}
+
 // IssueToken - new token and store it
 func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string, totalSupply sdk.Int, owner sdk.AccAddress,
 	mintable bool, burnable bool, addrForbiddable bool, tokenForbiddable bool,
@@ -85,9 +189,15 @@ func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string,
 		return types.ErrDuplicateTokenSymbol(symbol)
 	}
 
+	// an auctioned symbol can only be issued by the winner
+	wonInAuction, err := keeper.checkSymbolAuction(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
 	var cetToken types.Token
 	// only cet owner can issue reserved token
-	if types.IsReservedSymbol(symbol) && symbol != dex.CET {
+	if types.IsReservedSymbol(symbol) && symbol != dex.CET && !wonInAuction {
 		cetToken = keeper.GetToken(ctx, dex.CET)
 		if cetToken == nil || !owner.Equals(cetToken.GetOwner()) {
 			return types.ErrInvalidIssueOwner()
@@ -123,6 +233,10 @@ func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string,
 	if err := keeper.SetToken(ctx, token); err != nil {
 		return err
 	}
+	if wonInAuction {
+		auction, _ := keeper.GetSymbolAuction(ctx, symbol)
+		keeper.removeSymbolAuction(ctx, auction)
+	}
 
 	return keeper.sk.MintCoins(ctx, types.ModuleName, types.NewTokenCoins(symbol, totalSupply))
 }
@@ -141,12 +255,14 @@ func (keeper BaseKeeper) TransferOwnership(ctx sdk.Context, symbol string, origi
 		return err
 	}
 
+	// the roles granted by the original owner do not survive the transfer
+	keeper.removeTokenRoles(ctx, symbol)
 	return keeper.SetToken(ctx, token)
 }
 
 // MintToken - mint token
 func (keeper BaseKeeper) MintToken(ctx sdk.Context, symbol string, owner sdk.AccAddress, amount sdk.Int) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleMinter)
 	if err != nil {
 		return err
 	}
@@ -155,6 +271,10 @@ func (keeper BaseKeeper) MintToken(ctx sdk.Context, symbol string, owner sdk.Acc
 		return types.ErrTokenMintNotSupported(symbol)
 	}
 
+	if err := keeper.checkMintPolicy(ctx, token, amount); err != nil {
+		return err
+	}
+
 	if err := token.SetTotalMint(token.GetTotalMint().Add(amount)); err != nil {
 		return err
 	}
@@ -172,7 +292,7 @@ func (keeper BaseKeeper) MintToken(ctx sdk.Context, symbol string, owner sdk.Acc
 
 // BurnToken - burn token
 func (keeper BaseKeeper) BurnToken(ctx sdk.Context, symbol string, owner sdk.AccAddress, amount sdk.Int) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleBurner)
 	if err != nil {
 		return err
 	}
@@ -199,7 +319,7 @@ func (keeper BaseKeeper) BurnToken(ctx sdk.Context, symbol string, owner sdk.Acc
 
 // ForbidToken - forbid token
 func (keeper BaseKeeper) ForbidToken(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
@@ -217,7 +337,7 @@ func (keeper BaseKeeper) ForbidToken(ctx sdk.Context, symbol string, owner sdk.A
 
 // UnForbidToken - unforbid token
 func (keeper BaseKeeper) UnForbidToken(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
@@ -235,12 +355,13 @@ func (keeper BaseKeeper) UnForbidToken(ctx sdk.Context, symbol string, owner sdk
 
 // AddTokenWhitelist - add token forbidden whitelist
 func (keeper BaseKeeper) AddTokenWhitelist(ctx sdk.Context, symbol string, owner sdk.AccAddress, whitelist []sdk.AccAddress) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
 
-	if !token.GetTokenForbiddable() {
+	// the whitelist of a permissioned token lists the addresses allowed to receive it
+	if !token.GetTokenForbiddable() && !token.GetPermissioned() {
 		return types.ErrTokenForbiddenNotSupported(symbol)
 	}
 	if err = keeper.addWhitelist(ctx, symbol, whitelist); err != nil {
@@ -251,12 +372,12 @@ func (keeper BaseKeeper) AddTokenWhitelist(ctx sdk.Context, symbol string, owner
 
 // RemoveTokenWhitelist - remove token forbidden whitelist
 func (keeper BaseKeeper) RemoveTokenWhitelist(ctx sdk.Context, symbol string, owner sdk.AccAddress, whitelist []sdk.AccAddress) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
 
-	if !token.GetTokenForbiddable() {
+	if !token.GetTokenForbiddable() && !token.GetPermissioned() {
 		return types.ErrTokenForbiddenNotSupported(symbol)
 	}
 	if err = keeper.removeWhitelist(ctx, symbol, whitelist); err != nil {
@@ -265,9 +386,106 @@ func (keeper BaseKeeper) RemoveTokenWhitelist(ctx sdk.Context, symbol string, ow
 	return nil
 }
 
+// EnablePermissionedMode - only the owner and the whitelisted addresses could receive the token afterwards,
+// which is done when the token is issued
+func (keeper BaseKeeper) EnablePermissionedMode(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	token.SetPermissioned(true)
+	return keeper.SetToken(ctx, token)
+}
+
+// EnableClawback - the owner could claw back the token from its holders afterwards, which could not be turned off
+func (keeper BaseKeeper) EnableClawback(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	token.SetClawbackable(true)
+	return keeper.SetToken(ctx, token)
+}
+
+// Clawback - take the amount of token from the holder and move it to the recipient, or burn it without a recipient.
+// If the spendable coins of the holder are not enough, the coins locked for it are unlocked, and then its market
+// orders trading the token are cancelled to unfreeze their coins. The ids of the cancelled orders are returned.
+// The coins frozen otherwise, e.g. as the reserve of a bancor pool, are out of the scope of clawback: they could
+// not be recovered and are reported in the error.
+func (keeper BaseKeeper) Clawback(ctx sdk.Context, symbol string, owner, holder sdk.AccAddress,
+	amount sdk.Int, recipient sdk.AccAddress) ([]string, sdk.Error) {
+
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return nil, err
+	}
+	if !token.GetClawbackable() {
+		return nil, types.ErrClawbackNotSupported(symbol)
+	}
+	if recipient.Empty() && !token.GetBurnable() {
+		return nil, types.ErrTokenBurnNotSupported(symbol)
+	}
+	if !recipient.Empty() && !keeper.IsPermittedRecipient(ctx, symbol, recipient) {
+		return nil, types.ErrInvalidClawback("the recipient is not whitelisted")
+	}
+
+	orders, err := keeper.unlockForClawback(ctx, symbol, holder, amount)
+	if err != nil {
+		return nil, err
+	}
+	coins := types.NewTokenCoins(symbol, amount)
+	if err := keeper.SendCoinsFromAccountToAssetModule(ctx, holder, coins); err != nil {
+		return nil, err
+	}
+	if recipient.Empty() {
+		err = keeper.BurnToken(ctx, symbol, owner, amount)
+	} else {
+		err = keeper.SendCoinsFromAssetModuleToAccount(ctx, recipient, coins)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// unlockForClawback makes the amount spendable by the holder, by unlocking its locked coins first and then
+// cancelling its orders, only as far as its spendable coins are not enough. The ids of the cancelled orders are returned.
+func (keeper BaseKeeper) unlockForClawback(ctx sdk.Context, symbol string, holder sdk.AccAddress, amount sdk.Int) ([]string, sdk.Error) {
+	spendable := keeper.bkx.GetCoins(ctx, holder).AmountOf(symbol)
+	shortage := amount.Sub(spendable)
+	if !shortage.IsPositive() {
+		return nil, nil
+	}
+	frozen := keeper.bkx.GetFrozenCoins(ctx, holder).AmountOf(symbol)
+	locked := keeper.bkx.GetTotalCoins(ctx, holder).AmountOf(symbol).Sub(spendable).Sub(frozen)
+	if unrecovered := shortage.Sub(locked).Sub(frozen); unrecovered.IsPositive() {
+		return nil, types.ErrInvalidClawback(unrecovered.String() + " could not be recovered")
+	}
+	if locked.IsPositive() {
+		unlocked, err := keeper.bkx.UnlockCoins(ctx, holder, symbol, sdk.MinInt(locked, shortage))
+		if err != nil {
+			return nil, err
+		}
+		if shortage = shortage.Sub(unlocked); !shortage.IsPositive() {
+			return nil, nil
+		}
+	}
+
+	var orders []string
+	if keeper.oc != nil {
+		orders = keeper.oc.CancelOrdersOfHolder(ctx, holder, symbol)
+	}
+	if unrecovered := amount.Sub(keeper.bkx.GetCoins(ctx, holder).AmountOf(symbol)); unrecovered.IsPositive() {
+		return nil, types.ErrInvalidClawback(unrecovered.String() + " could not be recovered, which is frozen out of market orders")
+	}
+	return orders, nil
+}
+
 // ForbidAddress - add forbidden addresses
 func (keeper BaseKeeper) ForbidAddress(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
@@ -283,7 +501,7 @@ func (keeper BaseKeeper) ForbidAddress(ctx sdk.Context, symbol string, owner sdk
 
 // UnForbidAddress - remove forbidden addresses
 func (keeper BaseKeeper) UnForbidAddress(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) sdk.Error {
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
@@ -302,7 +520,7 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 	url, description, identity, name string, totalSupply sdk.Int,
 	mintable, burnable, addrForbiddable, tokenForbiddable bool) sdk.Error {
 
-	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleMetadataAdmin)
 	if err != nil {
 		return err
 	}
@@ -324,6 +542,16 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 		}
 	}
 
+	// a metadata admin can only modify the fields above
+	if !token.GetOwner().Equals(owner) {
+		if name != token.GetName() || !totalSupply.Equal(token.GetTotalSupply()) ||
+			mintable != token.GetMintable() || burnable != token.GetBurnable() ||
+			addrForbiddable != token.GetAddrForbiddable() || tokenForbiddable != token.GetTokenForbiddable() {
+			return types.ErrNeedTokenOwner(token.GetOwner())
+		}
+		return keeper.SetToken(ctx, token)
+	}
+
 	ownerAmt := keeper.bkx.GetTotalCoins(ctx, owner).AmountOf(symbol)
 	distributed := !ownerAmt.Equal(token.GetTotalSupply())
 
@@ -340,6 +568,9 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 		if distributed {
 			return types.ErrCodeTokenInfoSealed("TotalSupply")
 		}
+		if policy := token.GetMintPolicy(); policy != nil && policy.HasHardCap() && totalSupply.GT(policy.HardCap) {
+			return types.ErrMintLimitExceeded("the total supply would exceed the hard cap " + policy.HardCap.String())
+		}
 		if err := token.SetTotalSupply(totalSupply); err != nil {
 			return err
 		}
@@ -374,6 +605,9 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 			if err := token.SetTotalMint(sdk.ZeroInt()); err != nil {
 				return err
 			}
+			if err := token.SetMintPolicy(nil); err != nil {
+				return err
+			}
 		}
 	}
 	if burnable != token.GetBurnable() {
@@ -412,14 +646,47 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 	return keeper.SetToken(ctx, token)
 }
 
+// ModifyTokenMetadata - replaces the metadata of a token, whose sizes are limited by params
+func (keeper BaseKeeper) ModifyTokenMetadata(ctx sdk.Context, symbol string, owner sdk.AccAddress,
+	metadata types.TokenMetadata) sdk.Error {
+
+	token, err := keeper.checkPermission(ctx, symbol, owner, types.RoleMetadataAdmin)
+	if err != nil {
+		return err
+	}
+	if err := metadata.CheckLimits(keeper.GetParams(ctx)); err != nil {
+		return err
+	}
+	if err := token.SetMetadata(metadata); err != nil {
+		return err
+	}
+
+	return keeper.SetToken(ctx, token)
+}
+
 func (keeper BaseKeeper) SendCoinsFromAssetModuleToAccount(ctx sdk.Context, addresses sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := keeper.checkRecipient(ctx, addresses, amt); err != nil {
+		return err
+	}
+	keeper.settleDividends(ctx, addresses, amt)
 	return keeper.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addresses, amt)
 }
 
 func (keeper BaseKeeper) SendCoinsFromAccountToAssetModule(ctx sdk.Context, addresses sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	keeper.settleDividends(ctx, addresses, amt)
 	return keeper.sk.SendCoinsFromAccountToModule(ctx, addresses, types.ModuleName, amt)
 }
 
+// checkRecipient returns an error if addr is not allowed to receive any of the permissioned tokens in amt
+func (keeper BaseKeeper) checkRecipient(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !keeper.IsPermittedRecipient(ctx, coin.Denom, addr) {
+			return types.ErrRecipientNotWhitelisted(coin.Denom, addr)
+		}
+	}
+	return nil
+}
+
 // DeductIssueFee - deduct issue token fee
 func (keeper BaseKeeper) DeductIssueFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error {
 	return keeper.bkx.DeductInt64CetFee(ctx, addr, amt)
@@ -452,6 +719,7 @@ func (keeper BaseKeeper) RemoveToken(ctx sdk.Context, token types.Token) {
 	symbol := token.GetSymbol()
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.GetTokenStoreKey(symbol))
+	deleteTokenIndexes(store, token)
 }
 
 func (keeper BaseKeeper) addWhitelist(ctx sdk.Context, symbol string, whitelist []sdk.AccAddress) sdk.Error {
@@ -515,7 +783,12 @@ type TokenKeeper interface {
 	IsTokenExists(ctx sdk.Context, symbol string) bool
 	IsTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
 	IsForbiddenByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
+	IsPermittedRecipient(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
 	UpdateTokenSendLock(ctx sdk.Context, symbol string, amount sdk.Int, lock bool) sdk.Error
+	HasDividends(ctx sdk.Context, symbol string) bool
+	SettleDividends(ctx sdk.Context, symbol string, addr sdk.AccAddress, balance sdk.Int)
+	GetTransferFee(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, sdk.AccAddress)
+	UpdateTokenBurn(ctx sdk.Context, symbol string, amount sdk.Int) sdk.Error
 }
 
 var _ TokenKeeper = (*BaseTokenKeeper)(nil)
@@ -640,6 +913,22 @@ func (keeper BaseTokenKeeper) IsForbiddenByTokenIssuer(ctx sdk.Context, symbol s
 	return true
 }
 
+// IsPermittedRecipient - check whether addr could receive the token, which is limited to the owner
+// and the whitelisted addresses if the token is permissioned
+func (keeper BaseTokenKeeper) IsPermittedRecipient(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil || !token.GetPermissioned() {
+		return true
+	}
+
+	if token.GetOwner().Equals(addr) {
+		return true
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.GetWhitelistStoreKey(symbol, addr))
+}
+
 // UpdateTokenSendLock - set token SendLock amount
 func (keeper BaseTokenKeeper) UpdateTokenSendLock(ctx sdk.Context, symbol string, amount sdk.Int, lock bool) sdk.Error {
 	token := keeper.GetToken(ctx, symbol)
@@ -672,7 +961,9 @@ func (keeper BaseTokenKeeper) SetToken(ctx sdk.Context, token types.Token) sdk.E
 	if err != nil {
 		return sdk.ErrInternal(err.Error())
 	}
+	old := keeper.GetToken(ctx, symbol)
 	store.Set(types.GetTokenStoreKey(symbol), bz)
+	setTokenIndexes(store, old, token)
 	return nil
 }
 
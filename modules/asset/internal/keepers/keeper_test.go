@@ -11,6 +11,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestTokenKeeper_IssueToken(t *testing.T) {
@@ -850,3 +851,29 @@ func checkTokensEqual(t *testing.T, token, newToken types.Token) {
 	require.Equal(t, token.GetAddrForbiddable(), newToken.GetAddrForbiddable())
 	require.Equal(t, token.GetTokenForbiddable(), newToken.GetTokenForbiddable())
 }
+
+type mockCetPriceKeeper struct {
+	price sdk.Dec
+}
+
+func (k mockCetPriceKeeper) GetCetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	return k.price, denom == "usdt"
+}
+
+func TestTokenKeeper_GetIssueTokenFee(t *testing.T) {
+	input := createTestInput()
+	params := input.tk.GetParams(input.ctx)
+	require.Equal(t, params.IssueTokenFee, input.tk.GetIssueTokenFee(input.ctx, "abcdefg"))
+
+	// the fees are set at 0.02 usdt per CET, which is now 0.01 usdt
+	input.tk.SetCetPriceKeeper(mockCetPriceKeeper{price: sdk.NewDecWithPrec(1, 2)})
+	params.FeePricing = dex.NewFeePricing("usdt", sdk.NewDecWithPrec(2, 2))
+	input.tk.SetParams(input.ctx, params)
+	require.Equal(t, 2*params.IssueTokenFee, input.tk.GetIssueTokenFee(input.ctx, "abcdefg"))
+	require.Equal(t, 2*params.Issue3CharTokenFee, input.tk.GetIssueTokenFee(input.ctx, "abc"))
+
+	// no price of CET in usdc
+	params.FeePricing = dex.NewFeePricing("usdc", sdk.NewDecWithPrec(2, 2))
+	input.tk.SetParams(input.ctx, params)
+	require.Equal(t, params.IssueTokenFee, input.tk.GetIssueTokenFee(input.ctx, "abcdefg"))
+}
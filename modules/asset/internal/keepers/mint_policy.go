@@ -0,0 +1,163 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetMintPolicy - the token owner adopts a mint policy, which can not be changed or removed later
+func (keeper BaseKeeper) SetMintPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy types.MintPolicy) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+	if !token.GetMintable() {
+		return types.ErrTokenMintNotSupported(symbol)
+	}
+	if token.GetMintPolicy() != nil {
+		return types.ErrInvalidMintPolicy("the token already has a mint policy")
+	}
+	if err := policy.Validate(token.GetTotalSupply()); err != nil {
+		return err
+	}
+	if policy.HasInflation() && !keeper.IsPermittedRecipient(ctx, symbol, policy.InflationRecipient) {
+		return types.ErrRecipientNotWhitelisted(symbol, policy.InflationRecipient)
+	}
+
+	state := types.NewMintState(policy, ctx.BlockHeader().Time.Unix())
+	if policy.HasInflation() {
+		ctx.KVStore(keeper.storeKey).Set(types.GetInflationQueueKey(state.NextInflationTime, symbol), []byte(symbol))
+	}
+	if err := token.SetMintPolicy(&policy); err != nil {
+		return err
+	}
+	if err := token.SetMintState(&state); err != nil {
+		return err
+	}
+	return keeper.SetToken(ctx, token)
+}
+
+// checkMintPolicy checks the amount to mint against the hard cap and the limit of the current period,
+// and counts it into the period
+func (keeper BaseKeeper) checkMintPolicy(ctx sdk.Context, token types.Token, amount sdk.Int) sdk.Error {
+	policy := token.GetMintPolicy()
+	if policy == nil {
+		return nil
+	}
+	if policy.HasHardCap() && token.GetTotalSupply().Add(amount).GT(policy.HardCap) {
+		return types.ErrMintLimitExceeded("the total supply would exceed the hard cap " + policy.HardCap.String())
+	}
+	if !policy.HasPeriodLimit() {
+		return nil
+	}
+	state := token.GetMintState()
+	now := ctx.BlockHeader().Time.Unix()
+	if now >= state.PeriodStart+policy.MintPeriod {
+		state.PeriodStart = now - (now-state.PeriodStart)%policy.MintPeriod
+		state.PeriodMinted = sdk.ZeroInt()
+	}
+	if state.PeriodMinted.Add(amount).GT(policy.MaxMintPerPeriod) {
+		return types.ErrMintLimitExceeded("at most " + policy.MaxMintPerPeriod.String() + " can be minted in a period")
+	}
+	state.PeriodMinted = state.PeriodMinted.Add(amount)
+	return token.SetMintState(state)
+}
+
+// MintInflation mints the scheduled inflation of the tokens whose inflation time has come,
+// the periods skipped are caught up at once, and neither the hard cap nor MaxMintAmount is exceeded
+func (keeper BaseKeeper) MintInflation(ctx sdk.Context) {
+	now := ctx.BlockHeader().Time.Unix()
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.InflationQueueKey, sdk.PrefixEndBytes(types.GetInflationQueueTimeKey(now)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		symbol := string(key[len(types.InflationQueueKey)+8:])
+		token := keeper.GetToken(ctx, symbol)
+		if token == nil || !token.GetMintable() || token.GetMintPolicy() == nil || !token.GetMintPolicy().HasInflation() {
+			continue
+		}
+		policy, state := token.GetMintPolicy(), token.GetMintState()
+		periods := (now-state.NextInflationTime)/policy.InflationPeriod + 1
+		amount := inflationAmount(policy.InflationAmount, periods, token.GetTotalSupply())
+		if policy.HasHardCap() {
+			amount = sdk.MinInt(amount, policy.HardCap.Sub(token.GetTotalSupply()))
+		}
+		// the inflation of the periods is skipped if the recipient is not allowed to receive the permissioned token
+		if !keeper.IsPermittedRecipient(ctx, symbol, policy.InflationRecipient) {
+			amount = sdk.ZeroInt()
+		}
+		state.NextInflationTime += periods * policy.InflationPeriod
+		if err := token.SetMintState(state); err != nil {
+			panic(err)
+		}
+
+		if amount.IsPositive() {
+			keeper.mintInflation(ctx, token, policy.InflationRecipient, amount)
+		} else if err := keeper.SetToken(ctx, token); err != nil {
+			panic(err)
+		}
+		// the inflation stops once the hard cap or the max amount is reached
+		if (!policy.HasHardCap() || token.GetTotalSupply().LT(policy.HardCap)) && token.GetTotalSupply().LT(types.MaxMintAmount) {
+			store.Set(types.GetInflationQueueKey(state.NextInflationTime, symbol), []byte(symbol))
+		}
+	}
+}
+
+// inflationAmount returns the inflation of the periods, which is bounded to keep the total supply
+// within MaxMintAmount, so that the amounts never overflow
+func inflationAmount(amountPerPeriod sdk.Int, periods int64, totalSupply sdk.Int) sdk.Int {
+	room := types.MaxMintAmount.Sub(totalSupply)
+	if !room.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	if sdk.NewInt(periods).GT(room.Quo(amountPerPeriod)) {
+		return room
+	}
+	return amountPerPeriod.MulRaw(periods)
+}
+
+func (keeper BaseKeeper) mintInflation(ctx sdk.Context, token types.Token, recipient sdk.AccAddress, amount sdk.Int) {
+	symbol := token.GetSymbol()
+	if err := token.SetTotalMint(token.GetTotalMint().Add(amount)); err != nil {
+		panic(err)
+	}
+	if err := token.SetTotalSupply(token.GetTotalSupply().Add(amount)); err != nil {
+		panic(err)
+	}
+	if err := keeper.SetToken(ctx, token); err != nil {
+		panic(err)
+	}
+	coins := types.NewTokenCoins(symbol, amount)
+	if err := keeper.sk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		panic(err)
+	}
+	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, recipient, coins); err != nil {
+		panic(err)
+	}
+	keeper.fillMsgQueue(ctx, types.KafkaTokenInflation, types.InflationInfo{
+		Symbol:    symbol,
+		Recipient: recipient,
+		Amount:    amount,
+		Height:    ctx.BlockHeight(),
+	})
+}
+
+// SetInflationSchedule puts the token into the inflation queue at its next inflation time, used at genesis
+func (keeper BaseKeeper) SetInflationSchedule(ctx sdk.Context, token types.Token) {
+	policy := token.GetMintPolicy()
+	if policy == nil || !policy.HasInflation() || !token.GetMintable() {
+		return
+	}
+	if policy.HasHardCap() && !token.GetTotalSupply().LT(policy.HardCap) {
+		return
+	}
+	ctx.KVStore(keeper.storeKey).Set(types.GetInflationQueueKey(token.GetMintState().NextInflationTime, token.GetSymbol()),
+		[]byte(token.GetSymbol()))
+}
@@ -0,0 +1,128 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// ProposeOwnershipTransfer - the token owner proposes a new owner, who must accept it before the deadline.
+// An earlier proposal of the token is replaced.
+func (keeper BaseKeeper) ProposeOwnershipTransfer(ctx sdk.Context, symbol string, originalOwner sdk.AccAddress,
+	newOwner sdk.AccAddress) (types.PendingOwnership, sdk.Error) {
+
+	if keeper.bkx.BlacklistedAddr(newOwner) {
+		return types.PendingOwnership{}, types.ErrAccInBlackList(newOwner)
+	}
+	if _, err := keeper.checkPrecondition(ctx, symbol, originalOwner); err != nil {
+		return types.PendingOwnership{}, err
+	}
+
+	if old, ok := keeper.GetPendingOwnership(ctx, symbol); ok {
+		keeper.removePendingOwnership(ctx, old)
+	}
+	po := types.PendingOwnership{
+		Symbol:        symbol,
+		OriginalOwner: originalOwner,
+		NewOwner:      newOwner,
+		Deadline:      ctx.BlockHeader().Time.Unix() + keeper.GetParams(ctx).OwnershipTransferTimeout,
+	}
+	keeper.SetPendingOwnership(ctx, po)
+	return po, nil
+}
+
+// AcceptOwnership - the proposed new owner accepts the ownership before the deadline
+func (keeper BaseKeeper) AcceptOwnership(ctx sdk.Context, symbol string, newOwner sdk.AccAddress) (types.PendingOwnership, sdk.Error) {
+	po, ok := keeper.GetPendingOwnership(ctx, symbol)
+	if !ok {
+		return po, types.ErrPendingOwnershipNotFound(symbol)
+	}
+	if !po.NewOwner.Equals(newOwner) {
+		return po, types.ErrInvalidOwnershipTransfer("only the proposed new owner can accept it")
+	}
+	if po.IsExpired(ctx.BlockHeader().Time.Unix()) {
+		return po, types.ErrInvalidOwnershipTransfer("the proposal has expired")
+	}
+	if err := keeper.TransferOwnership(ctx, symbol, po.OriginalOwner, po.NewOwner); err != nil {
+		return po, err
+	}
+	keeper.removePendingOwnership(ctx, po)
+	return po, nil
+}
+
+// CancelOwnershipTransfer - the token owner cancels the pending ownership transfer
+func (keeper BaseKeeper) CancelOwnershipTransfer(ctx sdk.Context, symbol string, owner sdk.AccAddress) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+	po, ok := keeper.GetPendingOwnership(ctx, symbol)
+	if !ok {
+		return types.ErrPendingOwnershipNotFound(symbol)
+	}
+	keeper.removePendingOwnership(ctx, po)
+	return nil
+}
+
+// RemoveExpiredOwnershipTransfers deletes the pending ownership transfers which were not accepted in time
+func (keeper BaseKeeper) RemoveExpiredOwnershipTransfers(ctx sdk.Context) {
+	now := ctx.BlockHeader().Time.Unix()
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.OwnershipQueueKey, types.GetOwnershipQueueTimeKey(now))
+	var symbols []string
+	for ; iterator.Valid(); iterator.Next() {
+		symbols = append(symbols, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, symbol := range symbols {
+		po, ok := keeper.GetPendingOwnership(ctx, symbol)
+		if !ok {
+			continue
+		}
+		keeper.removePendingOwnership(ctx, po)
+		keeper.fillMsgQueue(ctx, types.KafkaOwnershipExpire, types.OwnershipExpireInfo{
+			Symbol:        po.Symbol,
+			OriginalOwner: po.OriginalOwner,
+			NewOwner:      po.NewOwner,
+			Deadline:      po.Deadline,
+			Height:        ctx.BlockHeight(),
+		})
+	}
+}
+
+// SetPendingOwnership stores a pending ownership transfer with its entry in the expiry queue
+func (keeper BaseKeeper) SetPendingOwnership(ctx sdk.Context, po types.PendingOwnership) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetPendingOwnershipKey(po.Symbol), keeper.cdc.MustMarshalBinaryBare(po))
+	store.Set(types.GetOwnershipQueueKey(po.Deadline, po.Symbol), []byte(po.Symbol))
+}
+
+func (keeper BaseKeeper) removePendingOwnership(ctx sdk.Context, po types.PendingOwnership) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetPendingOwnershipKey(po.Symbol))
+	store.Delete(types.GetOwnershipQueueKey(po.Deadline, po.Symbol))
+}
+
+// GetPendingOwnership - returns the pending ownership transfer of the token
+func (keeper BaseKeeper) GetPendingOwnership(ctx sdk.Context, symbol string) (po types.PendingOwnership, ok bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetPendingOwnershipKey(symbol))
+	if bz == nil {
+		return po, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &po)
+	return po, true
+}
+
+// GetAllPendingOwnerships - returns all the pending ownership transfers
+func (keeper BaseKeeper) GetAllPendingOwnerships(ctx sdk.Context) []types.PendingOwnership {
+	list := make([]types.PendingOwnership, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingOwnershipKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var po types.PendingOwnership
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &po)
+		list = append(list, po)
+	}
+	return list
+}
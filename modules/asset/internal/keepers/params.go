@@ -22,3 +22,9 @@ func (keeper BaseKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	keeper.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetIssueTokenFee returns the fee of issuing the token, which may be priced in a reference token
+func (keeper BaseKeeper) GetIssueTokenFee(ctx sdk.Context, symbol string) int64 {
+	params := keeper.GetParams(ctx)
+	return params.FeePricing.GetFee(ctx, keeper.pk, params.GetIssueTokenFee(symbol))
+}
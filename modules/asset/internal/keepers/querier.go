@@ -27,6 +27,34 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryForbiddenAddr(ctx, req, keeper)
 		case types.QueryReservedSymbols:
 			return queryReservedSymbols()
+		case types.QueryVesting:
+			return queryVesting(ctx, req, keeper)
+		case types.QueryVestings:
+			return queryVestings(ctx, req, keeper)
+		case types.QueryTokenRoles:
+			return queryTokenRoles(ctx, req, keeper)
+		case types.QueryApprovalPolicy:
+			return queryApprovalPolicy(ctx, req, keeper)
+		case types.QueryOperations:
+			return queryTokenOperations(ctx, req, keeper)
+		case types.QueryPendingOwner:
+			return queryPendingOwnership(ctx, req, keeper)
+		case types.QueryHolderSnapshot:
+			return queryHolderSnapshot(ctx, req, keeper)
+		case types.QueryAirdrop:
+			return queryAirdrop(ctx, req, keeper)
+		case types.QuerySymbolAuction:
+			return querySymbolAuction(ctx, req, keeper)
+		case types.QuerySymbolAuctions:
+			return querySymbolAuctions(ctx, keeper)
+		case types.QueryDividendPools:
+			return queryDividendPools(ctx, req, keeper)
+		case types.QueryClaimable:
+			return queryClaimableDividends(ctx, req, keeper)
+		case types.QueryAdminActions:
+			return queryAdminActions(ctx, req, keeper)
+		case types.QueryTokenSearch:
+			return queryTokenSearch(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown asset query endpoint")
 		}
@@ -114,3 +142,242 @@ func queryReservedSymbols() ([]byte, sdk.Error) {
 
 	return bz, nil
 }
+
+func queryVesting(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVestingParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	vs, ok := keeper.GetVestingSchedule(ctx, params.ID)
+	if !ok {
+		return nil, types.ErrVestingScheduleNotFound(params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, vs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryVestings(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVestingsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetVestingSchedulesOf(ctx, params.Beneficiary))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenRoles(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetTokenRoles(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryApprovalPolicy(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	policy, ok := keeper.GetApprovalPolicy(ctx, params.Symbol)
+	if !ok {
+		return nil, types.ErrInvalidApprovalPolicy("no approval policy for " + params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, policy)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenOperations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetTokenOperations(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryPendingOwnership(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	po, ok := keeper.GetPendingOwnership(ctx, params.Symbol)
+	if !ok {
+		return nil, types.ErrPendingOwnershipNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, po)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryHolderSnapshot(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHolderSnapshotParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot, ok := keeper.GetHolderSnapshot(ctx, params.ID)
+	if !ok {
+		return nil, types.ErrHolderSnapshotNotFound(params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryAirdrop(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAirdropParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	airdrop, ok := keeper.GetAirdrop(ctx, params.ID)
+	if !ok {
+		return nil, types.ErrAirdropNotFound(params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, airdrop)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolAuction(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	auction, ok := keeper.GetSymbolAuction(ctx, params.Symbol)
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("no auction of symbol " + params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, auction)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolAuctions(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetAllSymbolAuctions(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryDividendPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetDividendPools(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryClaimableDividends(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryClaimableParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	claimable := keeper.GetClaimableDividends(ctx, params.Symbol, params.Holder)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, claimable)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryAdminActions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAdminActionsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Limit == 0 {
+		params.Limit = types.DefaultAdminActionsLimit
+	}
+	if params.Page < 1 || params.Limit < 0 || params.Limit > types.MaxAdminActionsLimit {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("the page must be positive and the limit must not exceed %d", types.MaxAdminActionsLimit))
+	}
+
+	actions := keeper.GetAdminActions(ctx, params.Symbol, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, actions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenSearch(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenSearchParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Limit == 0 {
+		params.Limit = types.DefaultTokenSearchLimit
+	}
+	if params.Page < 1 || params.Limit < 0 || params.Limit > types.MaxTokenSearchLimit {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("the page must be positive and the limit must not exceed %d", types.MaxTokenSearchLimit))
+	}
+	if err := params.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	tokens := keeper.SearchTokens(ctx, params.Filter, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, tokens)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -226,3 +226,100 @@ func Test_queryDefault(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, res)
 }
+
+func Test_queryTokenSearch(t *testing.T) {
+	input := createTestInput()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryTokenSearch),
+		Data: []byte{},
+	}
+	path0 := []string{types.QueryTokenSearch}
+	query := keepers.NewQuerier(input.tk)
+	var addr1, _ = sdk.AccAddressFromBech32("coinex133w8vwj73s4h2uynqft9gyyy52cr6rg8dskv3h")
+
+	for _, token := range []struct {
+		name, symbol, description string
+		owner                     sdk.AccAddress
+		mintable                  bool
+	}{
+		{"ABC Token", "abc", "", testAddr, true},
+		{"Another Dollar", "abd", "a stable coin", testAddr, false},
+		{"Bitcoin", "btc", "", addr1, true},
+		{"XYZ Token", "xyz", "Wrapped ABC", addr1, false},
+	} {
+		tk, err := types.NewToken(token.name, token.symbol, sdk.NewInt(2100), token.owner,
+			token.mintable, false, false, false, "", token.description, types.TestIdentityString)
+		require.NoError(t, err)
+		require.NoError(t, input.tk.SetToken(input.ctx, tk))
+	}
+
+	search := func(filter types.TokenFilter, page, limit int) []string {
+		req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenSearchParams(filter, page, limit))
+		res, err := query(input.ctx, path0, req)
+		require.NoError(t, err)
+		var tokens []types.Token
+		input.cdc.MustUnmarshalJSON(res, &tokens)
+		symbols := make([]string, len(tokens))
+		for i, token := range tokens {
+			symbols[i] = token.GetSymbol()
+		}
+		return symbols
+	}
+	yes, no := true, false
+
+	// the symbol prefix, and the words of the name or description starting with the words of the query
+	require.Equal(t, []string{"abc", "abd"}, search(types.TokenFilter{Symbol: "AB"}, 1, 0))
+	require.Equal(t, []string{"abc", "xyz"}, search(types.TokenFilter{Query: "AB"}, 1, 0))
+	require.Equal(t, []string{"abd"}, search(types.TokenFilter{Query: "stable"}, 1, 0))
+	require.Equal(t, []string{"abd"}, search(types.TokenFilter{Query: "coin, sta"}, 1, 0))
+	require.Equal(t, []string{"abc", "xyz"}, search(types.TokenFilter{Query: "token"}, 1, 0))
+	require.Empty(t, search(types.TokenFilter{Query: "oken"}, 1, 0))
+
+	// all the filters must match
+	require.Equal(t, []string{"abc"}, search(types.TokenFilter{Symbol: "ab", Query: "token"}, 1, 0))
+	require.Empty(t, search(types.TokenFilter{Symbol: "ab", Query: "wrapped"}, 1, 0))
+	require.Equal(t, []string{"abd"}, search(types.TokenFilter{Symbol: "ab", Owner: testAddr, Mintable: &no}, 1, 0))
+	require.Empty(t, search(types.TokenFilter{Symbol: "ab", Owner: addr1}, 1, 0))
+
+	// filters
+	require.Equal(t, []string{"abc", "btc"}, search(types.TokenFilter{Mintable: &yes}, 1, 0))
+	require.Equal(t, []string{"xyz"}, search(types.TokenFilter{Query: "abc", Mintable: &no, Owner: addr1}, 1, 0))
+	require.Equal(t, []string{"btc", "xyz"}, search(types.TokenFilter{Owner: addr1}, 1, 0))
+	require.Empty(t, search(types.TokenFilter{Forbidden: &yes}, 1, 0))
+
+	// pagination
+	require.Equal(t, []string{"abc", "abd"}, search(types.TokenFilter{}, 1, 2))
+	require.Equal(t, []string{"btc", "xyz"}, search(types.TokenFilter{}, 2, 2))
+	require.Empty(t, search(types.TokenFilter{}, 3, 2))
+
+	// the owner index follows the owner
+	token := input.tk.GetToken(input.ctx, "btc")
+	require.NoError(t, token.SetOwner(testAddr))
+	require.NoError(t, input.tk.SetToken(input.ctx, token))
+	require.Equal(t, []string{"xyz"}, search(types.TokenFilter{Owner: addr1}, 1, 0))
+	require.Equal(t, []string{"abc", "abd", "btc"}, search(types.TokenFilter{Owner: testAddr}, 1, 0))
+
+	// so do the word and flag indexes
+	token = input.tk.GetToken(input.ctx, "abd")
+	require.NoError(t, token.SetDescription("a wrapped coin"))
+	token.SetIsForbidden(true)
+	require.NoError(t, input.tk.SetToken(input.ctx, token))
+	require.Empty(t, search(types.TokenFilter{Query: "stable"}, 1, 0))
+	require.Equal(t, []string{"abd", "xyz"}, search(types.TokenFilter{Query: "wrap"}, 1, 0))
+	require.Equal(t, []string{"abd"}, search(types.TokenFilter{Forbidden: &yes}, 1, 0))
+	require.Equal(t, []string{"abc", "btc", "xyz"}, search(types.TokenFilter{Forbidden: &no}, 1, 0))
+	input.tk.RemoveToken(input.ctx, token)
+	require.Empty(t, search(types.TokenFilter{Forbidden: &yes}, 1, 0))
+	require.Equal(t, []string{"xyz"}, search(types.TokenFilter{Query: "wrap"}, 1, 0))
+
+	// invalid params
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenSearchParams(types.TokenFilter{}, 1, types.MaxTokenSearchLimit+1))
+	_, err := query(input.ctx, path0, req)
+	require.Error(t, err)
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenSearchParams(types.TokenFilter{}, 0, 0))
+	_, err = query(input.ctx, path0, req)
+	require.Error(t, err)
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenSearchParams(types.TokenFilter{Query: "--"}, 1, 0))
+	_, err = query(input.ctx, path0, req)
+	require.Error(t, err)
+}
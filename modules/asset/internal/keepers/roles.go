@@ -0,0 +1,311 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// GrantTokenRole - the token owner grants a role to an address
+func (keeper BaseKeeper) GrantTokenRole(ctx sdk.Context, symbol string, owner sdk.AccAddress, role string, addr sdk.AccAddress) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+	tr := types.TokenRole{Symbol: symbol, Role: role, Address: addr}
+	if err := tr.Validate(); err != nil {
+		return err
+	}
+	keeper.SetTokenRole(ctx, tr)
+	return nil
+}
+
+// RevokeTokenRole - the token owner revokes a role from an address
+func (keeper BaseKeeper) RevokeTokenRole(ctx sdk.Context, symbol string, owner sdk.AccAddress, role string, addr sdk.AccAddress) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+	if !keeper.HasTokenRole(ctx, symbol, role, addr) {
+		return types.ErrInvalidTokenRole(addr.String() + " is not a " + role)
+	}
+	ctx.KVStore(keeper.storeKey).Delete(types.GetTokenRoleKey(symbol, role, addr))
+	return nil
+}
+
+// HasTokenRole - whether the address has been granted the role, the token owner is not checked
+func (keeper BaseKeeper) HasTokenRole(ctx sdk.Context, symbol, role string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(keeper.storeKey).Has(types.GetTokenRoleKey(symbol, role, addr))
+}
+
+// SetTokenRole - stores a granted role
+func (keeper BaseKeeper) SetTokenRole(ctx sdk.Context, tr types.TokenRole) {
+	ctx.KVStore(keeper.storeKey).Set(types.GetTokenRoleKey(tr.Symbol, tr.Role, tr.Address), []byte{})
+}
+
+// GetTokenRoles - returns the roles granted on the token
+func (keeper BaseKeeper) GetTokenRoles(ctx sdk.Context, symbol string) []types.TokenRole {
+	roles := make([]types.TokenRole, 0)
+	prefix := types.GetTokenRolePrefix(symbol)
+	keeper.iterateAddrKey(ctx, prefix, func(key []byte) (stop bool) {
+		// role | : | address
+		roleAndAddr := key[len(prefix):]
+		addrLen := sdk.AddrLen
+		if len(roleAndAddr) <= addrLen {
+			return false
+		}
+		roles = append(roles, types.TokenRole{
+			Symbol:  symbol,
+			Role:    string(roleAndAddr[:len(roleAndAddr)-addrLen-1]),
+			Address: sdk.AccAddress(roleAndAddr[len(roleAndAddr)-addrLen:]),
+		})
+		return false
+	})
+	return roles
+}
+
+func (keeper BaseKeeper) removeTokenRoles(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, tr := range keeper.GetTokenRoles(ctx, symbol) {
+		store.Delete(types.GetTokenRoleKey(tr.Symbol, tr.Role, tr.Address))
+	}
+}
+
+// GetAllTokenRoles - returns the roles granted on all the tokens
+func (keeper BaseKeeper) GetAllTokenRoles(ctx sdk.Context) []types.TokenRole {
+	roles := make([]types.TokenRole, 0)
+	for _, token := range keeper.GetAllTokens(ctx) {
+		roles = append(roles, keeper.GetTokenRoles(ctx, token.GetSymbol())...)
+	}
+	return roles
+}
+
+// checkPermission returns the token if addr is its owner or has the role
+func (keeper BaseKeeper) checkPermission(ctx sdk.Context, symbol string, addr sdk.AccAddress, role string) (types.Token, sdk.Error) {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil {
+		return nil, types.ErrTokenNotFound(symbol)
+	}
+
+	if !token.GetOwner().Equals(addr) && !keeper.HasTokenRole(ctx, symbol, role, addr) {
+		return nil, types.ErrNeedTokenRole(role)
+	}
+
+	return token, nil
+}
+
+// SetApprovalPolicy - the token owner sets the approval policy of a token without one.
+// Once set, the policy can only be changed or removed by an approved OperationSetApprovalPolicy.
+func (keeper BaseKeeper) SetApprovalPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress,
+	approvers []sdk.AccAddress, threshold int64) sdk.Error {
+
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+	if _, ok := keeper.GetApprovalPolicy(ctx, symbol); ok {
+		return types.ErrInvalidApproval("the approval policy can only be changed with the approvals of its approvers")
+	}
+	op := types.NewSetApprovalPolicyOperation(symbol, owner, approvers, threshold)
+	if err := op.Validate(); err != nil {
+		return err
+	}
+	keeper.setApprovalPolicy(ctx, op)
+	return nil
+}
+
+// setApprovalPolicy sets or removes the approval policy, and drops the operations proposed under the old one
+func (keeper BaseKeeper) setApprovalPolicy(ctx sdk.Context, op types.TokenOperation) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, pending := range keeper.GetTokenOperations(ctx, op.Symbol) {
+		store.Delete(types.GetTokenOperationKey(pending.ID))
+	}
+	if op.RemovesPolicy() {
+		store.Delete(types.GetApprovalPolicyKey(op.Symbol))
+		return
+	}
+	keeper.SetApprovalPolicyForGenesis(ctx, op.Policy())
+}
+
+// SetApprovalPolicyForGenesis - stores the approval policy without checking the owner
+func (keeper BaseKeeper) SetApprovalPolicyForGenesis(ctx sdk.Context, policy types.ApprovalPolicy) {
+	ctx.KVStore(keeper.storeKey).Set(types.GetApprovalPolicyKey(policy.Symbol), keeper.cdc.MustMarshalBinaryBare(policy))
+}
+
+// GetApprovalPolicy - returns the approval policy of the token
+func (keeper BaseKeeper) GetApprovalPolicy(ctx sdk.Context, symbol string) (policy types.ApprovalPolicy, ok bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetApprovalPolicyKey(symbol))
+	if bz == nil {
+		return policy, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &policy)
+	return policy, true
+}
+
+// GetAllApprovalPolicies - returns the approval policies of all the tokens
+func (keeper BaseKeeper) GetAllApprovalPolicies(ctx sdk.Context) []types.ApprovalPolicy {
+	policies := make([]types.ApprovalPolicy, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ApprovalPolicyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.ApprovalPolicy
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// ProposeTokenOperation - checks the permission of the proposer and stores the operation,
+// which is executed at once if the approval of the proposer is enough
+func (keeper BaseKeeper) ProposeTokenOperation(ctx sdk.Context, op types.TokenOperation) (uint64, bool, sdk.Error) {
+	if err := op.Validate(); err != nil {
+		return 0, false, err
+	}
+	policy, ok := keeper.GetApprovalPolicy(ctx, op.Symbol)
+	if !ok {
+		return 0, false, types.ErrInvalidApproval("the token has no approval policy")
+	}
+	if err := keeper.checkOperation(ctx, op); err != nil {
+		return 0, false, err
+	}
+
+	op.ID = keeper.getNextID(ctx, types.TokenOperationNextIDKey)
+	op.CreateHeight = ctx.BlockHeight()
+	op.Approvals = nil
+	if policy.IsApprover(op.Proposer) {
+		op.Approvals = []sdk.AccAddress{op.Proposer}
+	}
+	if policy.CountApprovals(op.Approvals) >= policy.Threshold {
+		return op.ID, true, keeper.executeTokenOperation(ctx, op)
+	}
+	keeper.SetTokenOperation(ctx, op)
+	return op.ID, false, nil
+}
+
+// ApproveTokenOperation - an approver of the token approves the operation,
+// which is executed and deleted when the approvals reach the threshold
+func (keeper BaseKeeper) ApproveTokenOperation(ctx sdk.Context, id uint64, approver sdk.AccAddress) (types.TokenOperation, bool, sdk.Error) {
+	op, ok := keeper.GetTokenOperation(ctx, id)
+	if !ok {
+		return op, false, types.ErrTokenOperationNotFound(id)
+	}
+	policy, ok := keeper.GetApprovalPolicy(ctx, op.Symbol)
+	if !ok {
+		return op, false, types.ErrInvalidApproval("the token has no approval policy")
+	}
+	if !policy.IsApprover(approver) {
+		return op, false, types.ErrInvalidApproval(approver.String() + " is not an approver")
+	}
+	if op.HasApproved(approver) {
+		return op, false, types.ErrInvalidApproval(approver.String() + " has approved")
+	}
+
+	op.Approvals = append(op.Approvals, approver)
+	if policy.CountApprovals(op.Approvals) < policy.Threshold {
+		keeper.SetTokenOperation(ctx, op)
+		return op, false, nil
+	}
+	if err := keeper.executeTokenOperation(ctx, op); err != nil {
+		return op, false, err
+	}
+	ctx.KVStore(keeper.storeKey).Delete(types.GetTokenOperationKey(id))
+	return op, true, nil
+}
+
+// CancelTokenOperation - the proposer or the token owner cancels the operation
+func (keeper BaseKeeper) CancelTokenOperation(ctx sdk.Context, id uint64, sender sdk.AccAddress) (types.TokenOperation, sdk.Error) {
+	op, ok := keeper.GetTokenOperation(ctx, id)
+	if !ok {
+		return op, types.ErrTokenOperationNotFound(id)
+	}
+	token := keeper.GetToken(ctx, op.Symbol)
+	if !op.Proposer.Equals(sender) && (token == nil || !token.GetOwner().Equals(sender)) {
+		return op, types.ErrInvalidApproval("only the proposer or the token owner can cancel it")
+	}
+	ctx.KVStore(keeper.storeKey).Delete(types.GetTokenOperationKey(id))
+	return op, nil
+}
+
+// checkOperation checks whether the proposer can do the operation
+func (keeper BaseKeeper) checkOperation(ctx sdk.Context, op types.TokenOperation) sdk.Error {
+	switch op.Type {
+	case types.OperationMint:
+		token, err := keeper.checkPermission(ctx, op.Symbol, op.Proposer, types.RoleMinter)
+		if err != nil {
+			return err
+		}
+		if !token.GetMintable() {
+			return types.ErrTokenMintNotSupported(op.Symbol)
+		}
+	case types.OperationTransferOwnership:
+		if keeper.bkx.BlacklistedAddr(op.NewOwner) {
+			return types.ErrAccInBlackList(op.NewOwner)
+		}
+		if _, err := keeper.checkPrecondition(ctx, op.Symbol, op.Proposer); err != nil {
+			return err
+		}
+	case types.OperationSetApprovalPolicy:
+		if _, err := keeper.checkPrecondition(ctx, op.Symbol, op.Proposer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (keeper BaseKeeper) executeTokenOperation(ctx sdk.Context, op types.TokenOperation) sdk.Error {
+	switch op.Type {
+	case types.OperationMint:
+		if err := keeper.MintToken(ctx, op.Symbol, op.Proposer, op.Amount); err != nil {
+			return err
+		}
+		return keeper.SendCoinsFromAssetModuleToAccount(ctx, op.Proposer, types.NewTokenCoins(op.Symbol, op.Amount))
+	case types.OperationTransferOwnership:
+		_, err := keeper.ProposeOwnershipTransfer(ctx, op.Symbol, op.Proposer, op.NewOwner)
+		return err
+	case types.OperationSetApprovalPolicy:
+		keeper.setApprovalPolicy(ctx, op)
+		return nil
+	default:
+		return types.ErrInvalidApproval("unknown operation " + op.Type)
+	}
+}
+
+// SetTokenOperation - stores an operation waiting for approvals
+func (keeper BaseKeeper) SetTokenOperation(ctx sdk.Context, op types.TokenOperation) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetTokenOperationKey(op.ID), keeper.cdc.MustMarshalBinaryBare(op))
+	keeper.updateNextID(ctx, types.TokenOperationNextIDKey, op.ID)
+}
+
+// GetTokenOperation - returns the operation by its ID
+func (keeper BaseKeeper) GetTokenOperation(ctx sdk.Context, id uint64) (op types.TokenOperation, ok bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetTokenOperationKey(id))
+	if bz == nil {
+		return op, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &op)
+	return op, true
+}
+
+// GetTokenOperations - returns the operations of the token waiting for approvals
+func (keeper BaseKeeper) GetTokenOperations(ctx sdk.Context, symbol string) []types.TokenOperation {
+	ops := make([]types.TokenOperation, 0)
+	for _, op := range keeper.GetAllTokenOperations(ctx) {
+		if op.Symbol == symbol {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// GetAllTokenOperations - returns all the operations waiting for approvals
+func (keeper BaseKeeper) GetAllTokenOperations(ctx sdk.Context) []types.TokenOperation {
+	ops := make([]types.TokenOperation, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenOperationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var op types.TokenOperation
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &op)
+		ops = append(ops, op)
+	}
+	return ops
+}
@@ -0,0 +1,160 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// BidSymbol places a bid on a short or reserved symbol, which starts the auction if it is the first one.
+// The bid is frozen in the account of the bidder, and the outbid one is unfrozen.
+func (keeper BaseKeeper) BidSymbol(ctx sdk.Context, bidder sdk.AccAddress, symbol string, amount sdk.Int) (types.SymbolAuction, sdk.Error) {
+	if !types.IsAuctionableSymbol(symbol) {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("the symbol can not be auctioned")
+	}
+	if keeper.IsTokenExists(ctx, symbol) {
+		return types.SymbolAuction{}, types.ErrDuplicateTokenSymbol(symbol)
+	}
+	if keeper.bkx.BlacklistedAddr(bidder) {
+		return types.SymbolAuction{}, types.ErrAccInBlackList(bidder)
+	}
+
+	params := keeper.GetParams(ctx)
+	now := ctx.BlockHeader().Time.Unix()
+	auction, ok := keeper.GetSymbolAuction(ctx, symbol)
+	if !ok {
+		if amount.LT(sdk.NewInt(params.GetSymbolAuctionMinBid(symbol))) {
+			return auction, types.ErrInvalidSymbolAuction("the first bid is below the issue fee of the symbol")
+		}
+		auction = types.SymbolAuction{
+			Symbol:    symbol,
+			StartTime: now,
+			EndTime:   now + params.SymbolAuctionDuration,
+		}
+	} else {
+		if auction.Settled || now >= auction.EndTime {
+			return auction, types.ErrInvalidSymbolAuction("the auction has ended")
+		}
+		if amount.LT(auction.MinNextBid(params.MinBidIncreaseRate)) {
+			return auction, types.ErrInvalidSymbolAuction("the bid must be at least " + auction.MinNextBid(params.MinBidIncreaseRate).String())
+		}
+		if err := keeper.bkx.UnFreezeCoins(ctx, auction.HighestBidder, auction.HighestBidCoins()); err != nil {
+			return auction, err
+		}
+	}
+
+	auction.HighestBidder = bidder
+	auction.HighestBid = amount
+	auction.BidCount++
+	if err := keeper.bkx.FreezeCoins(ctx, bidder, auction.HighestBidCoins()); err != nil {
+		return auction, err
+	}
+	keeper.SetSymbolAuction(ctx, auction)
+	return auction, nil
+}
+
+// SettleSymbolAuctions ends the auctions whose end time has come, and donates the winning bids
+// to the community pool. The auctions settled whose issue deadlines have passed are removed,
+// which frees the symbols their winners have not issued.
+func (keeper BaseKeeper) SettleSymbolAuctions(ctx sdk.Context) {
+	now := ctx.BlockHeader().Time.Unix()
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.SymbolAuctionQueueKey, sdk.PrefixEndBytes(types.GetSymbolAuctionQueueTimeKey(now)))
+	var symbols []string
+	for ; iterator.Valid(); iterator.Next() {
+		symbols = append(symbols, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, symbol := range symbols {
+		auction, ok := keeper.GetSymbolAuction(ctx, symbol)
+		if !ok {
+			continue
+		}
+		if auction.Settled {
+			keeper.removeSymbolAuction(ctx, auction)
+			continue
+		}
+		// the highest bid has been frozen when it was placed
+		if err := keeper.bkx.UnFreezeCoins(ctx, auction.HighestBidder, auction.HighestBidCoins()); err != nil {
+			panic(err)
+		}
+		if err := keeper.bkx.DonateCoins(ctx, auction.HighestBidder, auction.HighestBidCoins()); err != nil {
+			panic(err)
+		}
+		store.Delete(types.GetSymbolAuctionQueueKey(auction.QueueTime(), symbol))
+		auction.Settled = true
+		auction.IssueDeadline = auction.EndTime + keeper.GetParams(ctx).SymbolIssuePeriod
+		keeper.SetSymbolAuction(ctx, auction)
+		keeper.fillMsgQueue(ctx, types.KafkaSymbolAuction, types.SymbolAuctionSettleInfo{
+			Symbol:   symbol,
+			Winner:   auction.HighestBidder,
+			Price:    auction.HighestBid,
+			BidCount: auction.BidCount,
+			Height:   ctx.BlockHeight(),
+		})
+	}
+}
+
+// IsSymbolAuctionWinner returns whether addr has won the auction of the symbol, and can still issue it
+func (keeper BaseKeeper) IsSymbolAuctionWinner(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	auction, ok := keeper.GetSymbolAuction(ctx, symbol)
+	return ok && auction.Settled && auction.HighestBidder.Equals(addr) && !keeper.isIssueDeadlinePassed(ctx, auction)
+}
+
+func (keeper BaseKeeper) isIssueDeadlinePassed(ctx sdk.Context, auction types.SymbolAuction) bool {
+	return auction.Settled && ctx.BlockHeader().Time.Unix() >= auction.IssueDeadline
+}
+
+// checkSymbolAuction returns whether the owner has won the auction of the symbol,
+// and an error if the symbol is auctioned by others. The symbol is free after the issue deadline.
+func (keeper BaseKeeper) checkSymbolAuction(ctx sdk.Context, symbol string, owner sdk.AccAddress) (bool, sdk.Error) {
+	auction, ok := keeper.GetSymbolAuction(ctx, symbol)
+	if !ok || keeper.isIssueDeadlinePassed(ctx, auction) {
+		return false, nil
+	}
+	if !auction.Settled || !auction.HighestBidder.Equals(owner) {
+		return false, types.ErrSymbolInAuction(symbol)
+	}
+	return true, nil
+}
+
+// SetSymbolAuction stores an auction, with its entry in the queue to be settled, or to be removed at
+// the issue deadline if it is settled
+func (keeper BaseKeeper) SetSymbolAuction(ctx sdk.Context, auction types.SymbolAuction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSymbolAuctionKey(auction.Symbol), keeper.cdc.MustMarshalBinaryBare(auction))
+	store.Set(types.GetSymbolAuctionQueueKey(auction.QueueTime(), auction.Symbol), []byte(auction.Symbol))
+}
+
+// removeSymbolAuction removes a settled auction with its entry in the queue, when the symbol is issued
+// or the issue deadline has passed
+func (keeper BaseKeeper) removeSymbolAuction(ctx sdk.Context, auction types.SymbolAuction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetSymbolAuctionKey(auction.Symbol))
+	store.Delete(types.GetSymbolAuctionQueueKey(auction.QueueTime(), auction.Symbol))
+}
+
+// GetSymbolAuction - returns the auction of a symbol
+func (keeper BaseKeeper) GetSymbolAuction(ctx sdk.Context, symbol string) (auction types.SymbolAuction, ok bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetSymbolAuctionKey(symbol))
+	if bz == nil {
+		return auction, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &auction)
+	return auction, true
+}
+
+// GetAllSymbolAuctions - returns the auctions in progress and the settled ones whose symbols are not issued yet
+func (keeper BaseKeeper) GetAllSymbolAuctions(ctx sdk.Context) []types.SymbolAuction {
+	auctions := make([]types.SymbolAuction, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SymbolAuctionKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.SymbolAuction
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
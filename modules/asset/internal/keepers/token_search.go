@@ -0,0 +1,140 @@
+package keepers
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SearchTokens returns a page of the tokens selected by the filter, ordered by symbol.
+// Only the candidates in the most selective index of the filter are scanned, which is the word index
+// if a query is given, otherwise the owner index, the flag index or the token store, under the symbol prefix.
+// The page number starts from 1.
+func (keeper BaseTokenKeeper) SearchTokens(ctx sdk.Context, filter types.TokenFilter, page, limit int) []types.Token {
+	tokens := make([]types.Token, 0)
+	if page < 1 || limit < 1 {
+		return tokens
+	}
+	skip := (page - 1) * limit
+	keeper.iterateSearchCandidates(ctx, filter, func(symbol string) bool {
+		token := keeper.GetToken(ctx, symbol)
+		if token == nil || !filter.Match(token) {
+			return false
+		}
+		if skip > 0 {
+			skip--
+			return false
+		}
+		tokens = append(tokens, token)
+		return len(tokens) >= limit
+	})
+	return tokens
+}
+
+// iterateSearchCandidates calls process with the symbols of the candidates of the filter in order
+func (keeper BaseTokenKeeper) iterateSearchCandidates(ctx sdk.Context, filter types.TokenFilter, process func(symbol string) (stop bool)) {
+	symbolPrefix := filter.SymbolPrefix()
+	if word := filter.LongestQueryWord(); word != "" {
+		for _, symbol := range keeper.getSymbolsByWordPrefix(ctx, word) {
+			if strings.HasPrefix(symbol, symbolPrefix) && process(symbol) {
+				return
+			}
+		}
+		return
+	}
+
+	var prefix []byte
+	switch {
+	case !filter.Owner.Empty():
+		prefix = types.GetTokenByOwnerKey(filter.Owner, symbolPrefix)
+	case filter.Mintable != nil:
+		prefix = types.GetTokenByFlagKey(types.TokenFlagMintable, *filter.Mintable, symbolPrefix)
+	case filter.Forbidden != nil:
+		prefix = types.GetTokenByFlagKey(types.TokenFlagForbidden, *filter.Forbidden, symbolPrefix)
+	default:
+		prefix = types.GetTokenStoreKey(symbolPrefix)
+	}
+	symbolStart := len(prefix) - len(symbolPrefix)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if process(string(iterator.Key()[symbolStart:])) {
+			return
+		}
+	}
+}
+
+// getSymbolsByWordPrefix returns the sorted symbols of the tokens which have a word starting with prefix
+// in their names or descriptions
+func (keeper BaseTokenKeeper) getSymbolsByWordPrefix(ctx sdk.Context, prefix string) []string {
+	store := ctx.KVStore(keeper.storeKey)
+	keyPrefix := types.GetTokenByWordPrefix(prefix)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	symbols := make([]string, 0)
+	seen := make(map[string]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(keyPrefix):]
+		symbol := string(key[bytes.Index(key, types.SeparateKey)+1:])
+		if !seen[symbol] {
+			seen[symbol] = true
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// setTokenIndexes keeps the indexes of token searches in step with the token, which replaces old
+// if it is not nil. Only the indexes of the fields changed are rewritten.
+func setTokenIndexes(store sdk.KVStore, old, token types.Token) {
+	symbol := token.GetSymbol()
+	if old == nil || !old.GetOwner().Equals(token.GetOwner()) {
+		if old != nil {
+			store.Delete(types.GetTokenByOwnerKey(old.GetOwner(), symbol))
+		}
+		store.Set(types.GetTokenByOwnerKey(token.GetOwner(), symbol), []byte{})
+	}
+	if old == nil || old.GetMintable() != token.GetMintable() {
+		if old != nil {
+			store.Delete(types.GetTokenByFlagKey(types.TokenFlagMintable, old.GetMintable(), symbol))
+		}
+		store.Set(types.GetTokenByFlagKey(types.TokenFlagMintable, token.GetMintable(), symbol), []byte{})
+	}
+	if old == nil || old.GetIsForbidden() != token.GetIsForbidden() {
+		if old != nil {
+			store.Delete(types.GetTokenByFlagKey(types.TokenFlagForbidden, old.GetIsForbidden(), symbol))
+		}
+		store.Set(types.GetTokenByFlagKey(types.TokenFlagForbidden, token.GetIsForbidden(), symbol), []byte{})
+	}
+	if old == nil || old.GetName() != token.GetName() || old.GetDescription() != token.GetDescription() {
+		if old != nil {
+			for _, word := range tokenWords(old) {
+				store.Delete(types.GetTokenByWordKey(word, symbol))
+			}
+		}
+		for _, word := range tokenWords(token) {
+			store.Set(types.GetTokenByWordKey(word, symbol), []byte{})
+		}
+	}
+}
+
+// deleteTokenIndexes removes the token from the indexes of token searches
+func deleteTokenIndexes(store sdk.KVStore, token types.Token) {
+	symbol := token.GetSymbol()
+	store.Delete(types.GetTokenByOwnerKey(token.GetOwner(), symbol))
+	store.Delete(types.GetTokenByFlagKey(types.TokenFlagMintable, token.GetMintable(), symbol))
+	store.Delete(types.GetTokenByFlagKey(types.TokenFlagForbidden, token.GetIsForbidden(), symbol))
+	for _, word := range tokenWords(token) {
+		store.Delete(types.GetTokenByWordKey(word, symbol))
+	}
+}
+
+func tokenWords(token types.Token) []string {
+	return types.TokenSearchWords(token.GetName() + " " + token.GetDescription())
+}
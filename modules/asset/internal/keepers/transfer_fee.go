@@ -0,0 +1,56 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// SetTransferFee - the token owner sets or removes the transfer fee policy of the token
+func (keeper BaseKeeper) SetTransferFee(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy *types.TransferFeePolicy) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+	if policy != nil && !policy.IsBurning() && keeper.bkx.BlacklistedAddr(policy.Receiver) {
+		return types.ErrAccInBlackList(policy.Receiver)
+	}
+	if policy != nil && !policy.IsBurning() && !keeper.IsPermittedRecipient(ctx, symbol, policy.Receiver) {
+		return types.ErrRecipientNotWhitelisted(symbol, policy.Receiver)
+	}
+	if err := token.SetTransferFee(policy); err != nil {
+		return err
+	}
+	return keeper.SetToken(ctx, token)
+}
+
+// GetTransferFee returns the fee charged from a transfer of coin and who receives it,
+// and an empty receiver means the fee is burnt, which is also the case when the receiver
+// is not allowed to receive the permissioned token any more
+func (keeper BaseTokenKeeper) GetTransferFee(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, sdk.AccAddress) {
+	token := keeper.GetToken(ctx, coin.Denom)
+	if token == nil || token.GetTransferFee() == nil {
+		return sdk.NewCoin(coin.Denom, sdk.ZeroInt()), nil
+	}
+	policy := token.GetTransferFee()
+	fee := sdk.NewCoin(coin.Denom, policy.FeeOf(coin.Amount))
+	if !policy.IsBurning() && !keeper.IsPermittedRecipient(ctx, coin.Denom, policy.Receiver) {
+		return fee, nil
+	}
+	return fee, policy.Receiver
+}
+
+// UpdateTokenBurn - record the amount of the token burnt outside the asset module, such as the transfer fees
+func (keeper BaseTokenKeeper) UpdateTokenBurn(ctx sdk.Context, symbol string, amount sdk.Int) sdk.Error {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil {
+		return types.ErrTokenNotFound(symbol)
+	}
+	if err := token.SetTotalBurn(token.GetTotalBurn().Add(amount)); err != nil {
+		return err
+	}
+	if err := token.SetTotalSupply(token.GetTotalSupply().Sub(amount)); err != nil {
+		return err
+	}
+	return keeper.SetToken(ctx, token)
+}
@@ -0,0 +1,194 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+// CreateVestingSchedule escrows the coins of a schedule from its creator into the asset module
+// account, and puts the schedule into the release queue at its first release time
+func (keeper BaseKeeper) CreateVestingSchedule(ctx sdk.Context, vs types.VestingSchedule) (uint64, sdk.Error) {
+	if err := vs.Validate(); err != nil {
+		return 0, err
+	}
+	if !keeper.IsTokenExists(ctx, vs.Symbol) {
+		return 0, types.ErrTokenNotFound(vs.Symbol)
+	}
+	if keeper.bkx.BlacklistedAddr(vs.Beneficiary) {
+		return 0, types.ErrAccInBlackList(vs.Beneficiary)
+	}
+	if keeper.IsForbiddenByTokenIssuer(ctx, vs.Symbol, vs.Creator) ||
+		keeper.IsForbiddenByTokenIssuer(ctx, vs.Symbol, vs.Beneficiary) {
+		return 0, types.ErrInvalidVestingSchedule("the token is forbidden for the creator or the beneficiary")
+	}
+	if !keeper.IsPermittedRecipient(ctx, vs.Symbol, vs.Beneficiary) {
+		return 0, types.ErrRecipientNotWhitelisted(vs.Symbol, vs.Beneficiary)
+	}
+	if err := keeper.SendCoinsFromAccountToAssetModule(ctx, vs.Creator, types.NewTokenCoins(vs.Symbol, vs.Total)); err != nil {
+		return 0, err
+	}
+
+	vs.ID = keeper.getNextID(ctx, types.VestingNextIDKey)
+	vs.Released = sdk.ZeroInt()
+	vs.NextReleaseTime = vs.FirstReleaseTime()
+	keeper.SetVestingSchedule(ctx, vs)
+	keeper.fillMsgQueue(ctx, types.KafkaVestingCreate, vs)
+	return vs.ID, nil
+}
+
+// ReleaseVestingSchedules releases the vested coins of the schedules whose release time has come,
+// and deletes the schedules which have been fully released. The coins of a beneficiary who is not
+// allowed to receive the permissioned token are held, and retried every VestingRetryInterval after
+// the last release time.
+func (keeper BaseKeeper) ReleaseVestingSchedules(ctx sdk.Context) {
+	now := ctx.BlockHeader().Time.Unix()
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.VestingQueueKey, sdk.PrefixEndBytes(types.GetVestingQueueTimeKey(now)))
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		vs, ok := keeper.GetVestingSchedule(ctx, id)
+		if !ok {
+			continue
+		}
+		amount := keeper.releaseVested(ctx, &vs, now)
+		keeper.removeVestingSchedule(ctx, vs)
+		if next, ok := vs.ReleaseTimeAfter(now); ok {
+			vs.NextReleaseTime = next
+			keeper.SetVestingSchedule(ctx, vs)
+		} else if vs.Released.LT(vs.Total) {
+			vs.NextReleaseTime = now + types.VestingRetryInterval
+			keeper.SetVestingSchedule(ctx, vs)
+		}
+		if amount.IsPositive() {
+			keeper.fillMsgQueue(ctx, types.KafkaVestingRelease, types.VestingReleaseInfo{
+				ID:          vs.ID,
+				Symbol:      vs.Symbol,
+				Beneficiary: vs.Beneficiary,
+				Amount:      amount,
+				Released:    vs.Released,
+				Total:       vs.Total,
+				Height:      ctx.BlockHeight(),
+			})
+		}
+	}
+}
+
+// RevokeVestingSchedule releases the vested coins of a revocable schedule to its beneficiary,
+// returns the others to its creator and deletes the schedule
+func (keeper BaseKeeper) RevokeVestingSchedule(ctx sdk.Context, id uint64, creator sdk.AccAddress) (types.VestingRevokeInfo, sdk.Error) {
+	vs, ok := keeper.GetVestingSchedule(ctx, id)
+	if !ok {
+		return types.VestingRevokeInfo{}, types.ErrVestingScheduleNotFound(id)
+	}
+	if !vs.Creator.Equals(creator) {
+		return types.VestingRevokeInfo{}, types.ErrInvalidVestingSchedule("only the creator can revoke it")
+	}
+	if !vs.Revocable {
+		return types.VestingRevokeInfo{}, types.ErrVestingNotRevocable(id)
+	}
+
+	keeper.releaseVested(ctx, &vs, ctx.BlockHeader().Time.Unix())
+	returned := vs.Total.Sub(vs.Released)
+	if returned.IsPositive() {
+		if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, vs.Creator, types.NewTokenCoins(vs.Symbol, returned)); err != nil {
+			return types.VestingRevokeInfo{}, err
+		}
+	}
+	keeper.removeVestingSchedule(ctx, vs)
+
+	info := types.VestingRevokeInfo{
+		ID:          vs.ID,
+		Symbol:      vs.Symbol,
+		Creator:     vs.Creator,
+		Beneficiary: vs.Beneficiary,
+		Released:    vs.Released,
+		Returned:    returned,
+		Height:      ctx.BlockHeight(),
+	}
+	keeper.fillMsgQueue(ctx, types.KafkaVestingRevoke, info)
+	return info, nil
+}
+
+// releaseVested sends the coins vested at 'now' but not released yet to the beneficiary,
+// unless the beneficiary is not allowed to receive the permissioned token any more
+func (keeper BaseKeeper) releaseVested(ctx sdk.Context, vs *types.VestingSchedule, now int64) sdk.Int {
+	amount := vs.VestedAmount(now).Sub(vs.Released)
+	if !amount.IsPositive() || !keeper.IsPermittedRecipient(ctx, vs.Symbol, vs.Beneficiary) {
+		return sdk.ZeroInt()
+	}
+	// the coins have been escrowed when the schedule was created
+	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, vs.Beneficiary, types.NewTokenCoins(vs.Symbol, amount)); err != nil {
+		panic(err)
+	}
+	vs.Released = vs.Released.Add(amount)
+	return amount
+}
+
+// SetVestingSchedule stores a schedule with its index by beneficiary and its entry in the release queue
+func (keeper BaseKeeper) SetVestingSchedule(ctx sdk.Context, vs types.VestingSchedule) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetVestingScheduleKey(vs.ID), keeper.cdc.MustMarshalBinaryBare(vs))
+	store.Set(types.GetVestingByBeneficiaryKey(vs.Beneficiary, vs.ID), []byte{})
+	store.Set(types.GetVestingQueueKey(vs.NextReleaseTime, vs.ID), sdk.Uint64ToBigEndian(vs.ID))
+	keeper.updateNextID(ctx, types.VestingNextIDKey, vs.ID)
+}
+
+func (keeper BaseKeeper) removeVestingSchedule(ctx sdk.Context, vs types.VestingSchedule) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetVestingScheduleKey(vs.ID))
+	store.Delete(types.GetVestingByBeneficiaryKey(vs.Beneficiary, vs.ID))
+	store.Delete(types.GetVestingQueueKey(vs.NextReleaseTime, vs.ID))
+}
+
+// GetVestingSchedule - returns the schedule by its ID
+func (keeper BaseKeeper) GetVestingSchedule(ctx sdk.Context, id uint64) (vs types.VestingSchedule, ok bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetVestingScheduleKey(id))
+	if bz == nil {
+		return vs, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &vs)
+	return vs, true
+}
+
+// GetVestingSchedulesOf - returns the schedules of a beneficiary
+func (keeper BaseKeeper) GetVestingSchedulesOf(ctx sdk.Context, beneficiary sdk.AccAddress) []types.VestingSchedule {
+	schedules := make([]types.VestingSchedule, 0)
+	prefix := types.GetVestingByBeneficiaryPrefix(beneficiary)
+	keeper.iterateAddrKey(ctx, prefix, func(key []byte) (stop bool) {
+		if vs, ok := keeper.GetVestingSchedule(ctx, binary.BigEndian.Uint64(key[len(prefix):])); ok {
+			schedules = append(schedules, vs)
+		}
+		return false
+	})
+	return schedules
+}
+
+// GetAllVestingSchedules - returns all the schedules
+func (keeper BaseKeeper) GetAllVestingSchedules(ctx sdk.Context) []types.VestingSchedule {
+	schedules := make([]types.VestingSchedule, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VestingScheduleKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vs types.VestingSchedule
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vs)
+		schedules = append(schedules, vs)
+	}
+	return schedules
+}
+
+func (keeper BaseKeeper) fillMsgQueue(ctx sdk.Context, key string, msg interface{}) {
+	if keeper.msgProducer != nil && keeper.msgProducer.IsSubscribed(types.Topic) {
+		msgqueue.FillMsgs(ctx, key, msg)
+	}
+}
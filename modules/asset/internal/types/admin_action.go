@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxAdminReasonLength limits the reason given for forbidding, unforbidding or clawing back
+	MaxAdminReasonLength = 256
+
+	// DefaultAdminActionsLimit is the page size of the admin action queries if no limit is given,
+	// and MaxAdminActionsLimit is the largest one allowed
+	DefaultAdminActionsLimit = 30
+	MaxAdminActionsLimit     = 100
+)
+
+// the actions recorded in the admin action log, which are the types of the msgs
+const (
+	AdminActionForbidToken   = "forbid_token"
+	AdminActionUnForbidToken = "unforbid_token"
+	AdminActionForbidAddr    = "forbid_addr"
+	AdminActionUnForbidAddr  = "unforbid_addr"
+	AdminActionClawback      = "clawback"
+)
+
+// AdminAction is an entry of the admin action log of a token, which records who forbade or unforbade
+// the token or some addresses, or clawed back the token, when and why.
+// A clawback records the holder and the recipient, if the coins are not burnt, in Addresses,
+// and the coins taken from the holder in Coins.
+type AdminAction struct {
+	ID        uint64           `json:"id"`
+	Symbol    string           `json:"symbol"`
+	Action    string           `json:"action"`
+	Operator  sdk.AccAddress   `json:"operator"`
+	Addresses []sdk.AccAddress `json:"addresses,omitempty"`
+	Coins     sdk.Coins        `json:"coins,omitempty"`
+	Reason    string           `json:"reason,omitempty"`
+	Height    int64            `json:"height"`
+	Time      int64            `json:"time"`
+}
+
+func NewAdminAction(symbol, action string, operator sdk.AccAddress, addresses []sdk.AccAddress, reason string) AdminAction {
+	return AdminAction{
+		Symbol:    symbol,
+		Action:    action,
+		Operator:  operator,
+		Addresses: addresses,
+		Reason:    reason,
+	}
+}
+
+// WithCoins records the coins moved or burnt by the action
+func (a AdminAction) WithCoins(coins sdk.Coins) AdminAction {
+	a.Coins = coins
+	return a
+}
+
+func (a AdminAction) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(a.Symbol); err != nil {
+		return err
+	}
+	switch a.Action {
+	case AdminActionForbidToken, AdminActionUnForbidToken, AdminActionForbidAddr, AdminActionUnForbidAddr,
+		AdminActionClawback:
+	default:
+		return ErrInvalidAdminAction("unknown action " + a.Action)
+	}
+	if a.Operator.Empty() {
+		return ErrInvalidAdminAction("missing operator")
+	}
+	if !a.Coins.IsValid() {
+		return ErrInvalidAdminAction("invalid coins " + a.Coins.String())
+	}
+	return ValidateAdminReason(a.Reason)
+}
+
+// ValidateAdminReason checks the optional reason given for forbidding, unforbidding or clawing back
+func ValidateAdminReason(reason string) sdk.Error {
+	if len(reason) > MaxAdminReasonLength {
+		return ErrInvalidAdminAction("the reason is limited to 256 bytes")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxHolderSnapshotsPerBlock is how many snapshots are taken in an EndBlocker at most, since each of them
+// iterates all the accounts. The snapshots due beyond it are taken in the following blocks.
+const MaxHolderSnapshotsPerBlock = 1
+
+// HolderSnapshot records the balances of Denom held by all the accounts at Height, including
+// the frozen and locked coins. It is taken in the EndBlocker of that height, or of a later one when
+// more than MaxHolderSnapshotsPerBlock snapshots are due, in which case Height is updated to it.
+type HolderSnapshot struct {
+	ID          uint64         `json:"id"`
+	Denom       string         `json:"denom"`
+	Creator     sdk.AccAddress `json:"creator"`
+	Height      int64          `json:"height"`
+	Taken       bool           `json:"taken"`
+	HolderCount int64          `json:"holder_count"`
+	TotalAmount sdk.Int        `json:"total_amount"`
+}
+
+func (s HolderSnapshot) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(s.Denom); err != nil {
+		return err
+	}
+	if s.Creator.Empty() {
+		return ErrInvalidHolderSnapshot("missing creator")
+	}
+	if s.Height <= 0 {
+		return ErrInvalidHolderSnapshot("the height must be positive")
+	}
+	if s.HolderCount < 0 || s.TotalAmount.IsNegative() {
+		return ErrInvalidHolderSnapshot("negative holder count or total amount")
+	}
+	return nil
+}
+
+// SnapshotHolder is the balance of a holder in a snapshot
+type SnapshotHolder struct {
+	SnapshotID uint64         `json:"snapshot_id"`
+	Address    sdk.AccAddress `json:"address"`
+	Amount     sdk.Int        `json:"amount"`
+}
+
+// Airdrop distributes Amount, escrowed in the asset module account, to the holders of a snapshot
+// pro-rata to their balances. The holders are paid in address order in batches at EndBlocker,
+// and the remainder left by rounding down is returned to Sender at last.
+type Airdrop struct {
+	ID          uint64         `json:"id"`
+	SnapshotID  uint64         `json:"snapshot_id"`
+	Sender      sdk.AccAddress `json:"sender"`
+	Amount      sdk.Coin       `json:"amount"`
+	Distributed sdk.Int        `json:"distributed"`
+	Paid        int64          `json:"paid"`   // the number of holders paid
+	Cursor      sdk.AccAddress `json:"cursor"` // the last holder paid
+	Finished    bool           `json:"finished"`
+}
+
+func (a Airdrop) Validate() sdk.Error {
+	if a.Sender.Empty() {
+		return ErrInvalidAirdrop("missing sender")
+	}
+	if !a.Amount.IsValid() || !a.Amount.IsPositive() {
+		return ErrInvalidAirdrop("the amount must be positive")
+	}
+	if a.Distributed.IsNegative() || a.Distributed.GT(a.Amount.Amount) || a.Paid < 0 {
+		return ErrInvalidAirdrop("the progress is out of range")
+	}
+	return nil
+}
+
+// ShareOf returns the amount of the airdrop for a holder with 'amount' out of 'total'
+func (a Airdrop) ShareOf(amount, total sdk.Int) sdk.Int {
+	return a.Amount.Amount.Mul(amount).Quo(total)
+}
+
+// AirdropProgressInfo is sent to the msg queue after each batch of an airdrop is paid
+type AirdropProgressInfo struct {
+	ID          uint64         `json:"id"`
+	SnapshotID  uint64         `json:"snapshot_id"`
+	Sender      sdk.AccAddress `json:"sender"`
+	Denom       string         `json:"denom"`
+	Distributed sdk.Int        `json:"distributed"`
+	Paid        int64          `json:"paid"`
+	HolderCount int64          `json:"holder_count"`
+	Finished    bool           `json:"finished"`
+	Returned    sdk.Int        `json:"returned"`
+	Height      int64          `json:"height"`
+}
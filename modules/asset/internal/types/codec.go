@@ -30,4 +30,22 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgForbidAddr{}, "asset/MsgForbidAddr", nil)
 	cdc.RegisterConcrete(MsgUnForbidAddr{}, "asset/MsgUnForbidAddr", nil)
 	cdc.RegisterConcrete(MsgModifyTokenInfo{}, "asset/MsgModifyTokenInfo", nil)
+	cdc.RegisterConcrete(MsgCreateVestingSchedule{}, "asset/MsgCreateVestingSchedule", nil)
+	cdc.RegisterConcrete(MsgRevokeVestingSchedule{}, "asset/MsgRevokeVestingSchedule", nil)
+	cdc.RegisterConcrete(MsgGrantTokenRole{}, "asset/MsgGrantTokenRole", nil)
+	cdc.RegisterConcrete(MsgRevokeTokenRole{}, "asset/MsgRevokeTokenRole", nil)
+	cdc.RegisterConcrete(MsgSetApprovalPolicy{}, "asset/MsgSetApprovalPolicy", nil)
+	cdc.RegisterConcrete(MsgApproveTokenOperation{}, "asset/MsgApproveTokenOperation", nil)
+	cdc.RegisterConcrete(MsgCancelTokenOperation{}, "asset/MsgCancelTokenOperation", nil)
+	cdc.RegisterConcrete(MsgAcceptOwnership{}, "asset/MsgAcceptOwnership", nil)
+	cdc.RegisterConcrete(MsgCancelOwnershipTransfer{}, "asset/MsgCancelOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgSetMintPolicy{}, "asset/MsgSetMintPolicy", nil)
+	cdc.RegisterConcrete(MsgCreateHolderSnapshot{}, "asset/MsgCreateHolderSnapshot", nil)
+	cdc.RegisterConcrete(MsgCreateAirdrop{}, "asset/MsgCreateAirdrop", nil)
+	cdc.RegisterConcrete(MsgBidSymbol{}, "asset/MsgBidSymbol", nil)
+	cdc.RegisterConcrete(MsgDistributeDividend{}, "asset/MsgDistributeDividend", nil)
+	cdc.RegisterConcrete(MsgClaimDividends{}, "asset/MsgClaimDividends", nil)
+	cdc.RegisterConcrete(MsgSetTransferFee{}, "asset/MsgSetTransferFee", nil)
+	cdc.RegisterConcrete(MsgIssueTokens{}, "asset/MsgIssueTokens", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "asset/MsgClawback", nil)
 }
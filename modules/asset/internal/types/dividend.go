@@ -0,0 +1,97 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// DividendPool accumulates the dividends paid in Denom to the holders of Symbol. RewardPerShare grows by
+// amount/eligible-supply on every distribution, so that the dividends of a holder are
+// balance*(RewardPerShare-paid), which is settled lazily before its balance changes.
+type DividendPool struct {
+	Symbol         string  `json:"symbol"`
+	Denom          string  `json:"denom"`
+	RewardPerShare sdk.Dec `json:"reward_per_share"`
+	TotalPaid      sdk.Int `json:"total_paid"`
+	TotalClaimed   sdk.Int `json:"total_claimed"`
+}
+
+func NewDividendPool(symbol, denom string) DividendPool {
+	return DividendPool{
+		Symbol:         symbol,
+		Denom:          denom,
+		RewardPerShare: sdk.ZeroDec(),
+		TotalPaid:      sdk.ZeroInt(),
+		TotalClaimed:   sdk.ZeroInt(),
+	}
+}
+
+func (p DividendPool) Validate() sdk.Error {
+	if err := ValidateDividendSymbol(p.Symbol); err != nil {
+		return err
+	}
+	if err := ValidateTokenSymbol(p.Denom); err != nil {
+		return err
+	}
+	if p.RewardPerShare.IsNil() || p.RewardPerShare.IsNegative() {
+		return ErrInvalidDividend("invalid reward per share")
+	}
+	if p.TotalPaid.IsNegative() || p.TotalClaimed.IsNegative() || p.TotalClaimed.GT(p.TotalPaid) {
+		return ErrInvalidDividend("invalid total paid or claimed amount")
+	}
+	return nil
+}
+
+// Unclaimed returns the dividends held by the asset module for this pool
+func (p DividendPool) Unclaimed() sdk.Int {
+	return p.TotalPaid.Sub(p.TotalClaimed)
+}
+
+// DividendAccount records the dividends in Denom accrued to a holder of Symbol, until RewardPerSharePaid
+type DividendAccount struct {
+	Symbol             string         `json:"symbol"`
+	Denom              string         `json:"denom"`
+	Address            sdk.AccAddress `json:"address"`
+	RewardPerSharePaid sdk.Dec        `json:"reward_per_share_paid"`
+	Pending            sdk.Dec        `json:"pending"`
+}
+
+func (a DividendAccount) Validate() sdk.Error {
+	if a.Address.Empty() {
+		return ErrInvalidDividend("missing holder address")
+	}
+	if a.RewardPerSharePaid.IsNil() || a.RewardPerSharePaid.IsNegative() {
+		return ErrInvalidDividend("invalid reward per share paid")
+	}
+	if a.Pending.IsNil() || a.Pending.IsNegative() {
+		return ErrInvalidDividend("invalid pending dividends")
+	}
+	return nil
+}
+
+// Settle accrues the dividends of balance since the last settlement
+func (a *DividendAccount) Settle(pool DividendPool, balance sdk.Int) {
+	if balance.IsPositive() {
+		a.Pending = a.Pending.Add(pool.RewardPerShare.Sub(a.RewardPerSharePaid).MulInt(balance))
+	}
+	a.RewardPerSharePaid = pool.RewardPerShare
+}
+
+// ClaimableDividend is the amount of Denom that a holder can claim now
+type ClaimableDividend struct {
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+}
+
+// ValidateDividendSymbol checks the symbol of a token paying dividends, which can not be CET,
+// because CET is also moved by the staking and fee modules without notifying the asset module
+func ValidateDividendSymbol(symbol string) sdk.Error {
+	if err := ValidateTokenSymbol(symbol); err != nil {
+		return err
+	}
+	if symbol == dex.CET {
+		return ErrInvalidDividend("CET holders can not be paid dividends")
+	}
+	return nil
+}
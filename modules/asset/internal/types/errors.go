@@ -41,6 +41,33 @@ const (
 	CodeTokenOwnerSelfForbidden      sdk.CodeType = 530
 	CodeInvalidTokenInfo             sdk.CodeType = 531
 	CodeTokenInfoSealed              sdk.CodeType = 532
+	CodeInvalidVestingSchedule       sdk.CodeType = 533
+	CodeVestingScheduleNotFound      sdk.CodeType = 534
+	CodeVestingNotRevocable          sdk.CodeType = 535
+	CodeInvalidTokenMetadata         sdk.CodeType = 536
+	CodeNeedTokenRole                sdk.CodeType = 537
+	CodeInvalidTokenRole             sdk.CodeType = 538
+	CodeInvalidApprovalPolicy        sdk.CodeType = 539
+	CodeTokenOperationNotFound       sdk.CodeType = 540
+	CodeInvalidApproval              sdk.CodeType = 541
+	CodePendingOwnershipNotFound     sdk.CodeType = 542
+	CodeInvalidOwnershipTransfer     sdk.CodeType = 543
+	CodeInvalidMintPolicy            sdk.CodeType = 544
+	CodeMintLimitExceeded            sdk.CodeType = 545
+	CodeHolderSnapshotNotFound       sdk.CodeType = 546
+	CodeInvalidHolderSnapshot        sdk.CodeType = 547
+	CodeAirdropNotFound              sdk.CodeType = 548
+	CodeInvalidAirdrop               sdk.CodeType = 549
+	CodeInvalidSymbolAuction         sdk.CodeType = 550
+	CodeSymbolInAuction              sdk.CodeType = 551
+	CodeInvalidDividend              sdk.CodeType = 552
+	CodeDividendPoolNotFound         sdk.CodeType = 553
+	CodeInvalidTransferFee           sdk.CodeType = 554
+	CodeInvalidAdminAction           sdk.CodeType = 555
+	CodeInvalidTokenBatch            sdk.CodeType = 556
+	CodeInvalidClawback              sdk.CodeType = 557
+	CodeClawbackNotSupported         sdk.CodeType = 558
+	CodeRecipientNotWhitelisted      sdk.CodeType = 559
 )
 
 func ErrInvalidTokenName(name string) sdk.Error {
@@ -182,3 +209,146 @@ func ErrCodeTokenInfoSealed(field string) sdk.Error {
 	msg := fmt.Sprintf("token %s sealed", field)
 	return sdk.NewError(CodeSpaceAsset, CodeTokenInfoSealed, msg)
 }
+
+func ErrInvalidVestingSchedule(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid vesting schedule: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidVestingSchedule, msg)
+}
+func ErrVestingScheduleNotFound(id uint64) sdk.Error {
+	msg := fmt.Sprintf("vesting schedule %d not found", id)
+	return sdk.NewError(CodeSpaceAsset, CodeVestingScheduleNotFound, msg)
+}
+func ErrVestingNotRevocable(id uint64) sdk.Error {
+	msg := fmt.Sprintf("vesting schedule %d can not be revoked", id)
+	return sdk.NewError(CodeSpaceAsset, CodeVestingNotRevocable, msg)
+}
+
+func ErrInvalidTokenMetadata(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid token metadata: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenMetadata, msg)
+}
+
+func ErrNeedTokenRole(role string) sdk.Error {
+	msg := fmt.Sprintf("only the token owner or the %s can do this", role)
+	return sdk.NewError(CodeSpaceAsset, CodeNeedTokenRole, msg)
+}
+
+func ErrInvalidTokenRole(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid token role: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenRole, msg)
+}
+
+func ErrInvalidApprovalPolicy(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid approval policy: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidApprovalPolicy, msg)
+}
+
+func ErrTokenOperationNotFound(id uint64) sdk.Error {
+	msg := fmt.Sprintf("token operation %d not found", id)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenOperationNotFound, msg)
+}
+
+func ErrInvalidApproval(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid approval: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidApproval, msg)
+}
+
+func ErrPendingOwnershipNotFound(symbol string) sdk.Error {
+	msg := fmt.Sprintf("no pending ownership transfer of token %s", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodePendingOwnershipNotFound, msg)
+}
+
+func ErrInvalidOwnershipTransfer(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid ownership transfer: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidOwnershipTransfer, msg)
+}
+
+func ErrInvalidMintPolicy(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid mint policy: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidMintPolicy, msg)
+}
+
+func ErrMintLimitExceeded(reason string) sdk.Error {
+	msg := fmt.Sprintf("mint limit exceeded: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeMintLimitExceeded, msg)
+}
+
+func ErrHolderSnapshotNotFound(id uint64) sdk.Error {
+	msg := fmt.Sprintf("holder snapshot %d not found", id)
+	return sdk.NewError(CodeSpaceAsset, CodeHolderSnapshotNotFound, msg)
+}
+
+func ErrInvalidHolderSnapshot(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid holder snapshot: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidHolderSnapshot, msg)
+}
+
+func ErrAirdropNotFound(id uint64) sdk.Error {
+	msg := fmt.Sprintf("airdrop %d not found", id)
+	return sdk.NewError(CodeSpaceAsset, CodeAirdropNotFound, msg)
+}
+
+func ErrInvalidAirdrop(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid airdrop: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidAirdrop, msg)
+}
+
+func ErrInvalidSymbolAuction(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid symbol auction: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidSymbolAuction, msg)
+}
+
+func ErrSymbolInAuction(symbol string) sdk.Error {
+	msg := fmt.Sprintf("symbol %s is auctioned and can only be issued by the winner", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolInAuction, msg)
+}
+
+func ErrInvalidDividend(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid dividend: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidDividend, msg)
+}
+
+func ErrDividendPoolNotFound(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s has not paid any dividends", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeDividendPoolNotFound, msg)
+}
+
+func ErrInvalidTransferFee(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid transfer fee: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTransferFee, msg)
+}
+
+func ErrInvalidAdminAction(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid admin action: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidAdminAction, msg)
+}
+
+func ErrInvalidTokenBatch(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid token batch: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenBatch, msg)
+}
+
+// ErrInTokenBatch keeps the code of the error found at a token of a batch, and tells which token it is,
+// with the tokens numbered from 1
+func ErrInTokenBatch(index int, symbol string, err sdk.Error) sdk.Error {
+	reason := err.Error()
+	if data, ok := err.Data().(error); ok {
+		reason = data.Error()
+	}
+	return sdk.NewError(err.Codespace(), err.Code(), "token #%d (%s): %s", index+1, symbol, reason)
+}
+
+func ErrInvalidClawback(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid clawback: %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidClawback, msg)
+}
+
+func ErrClawbackNotSupported(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s do not support clawback", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeClawbackNotSupported, msg)
+}
+
+func ErrRecipientNotWhitelisted(symbol string, addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s is not whitelisted to receive the permissioned token %s", addr, symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeRecipientNotWhitelisted, msg)
+}
@@ -14,6 +14,24 @@ const (
 	EventTypeForbidAddr           = "forbid_addr"
 	EventTypeUnForbidAddr         = "unforbid_addr"
 	EventTypeModifyTokenInfo      = "modify_token_info"
+	EventTypeCreateVesting        = "create_vesting_schedule"
+	EventTypeRevokeVesting        = "revoke_vesting_schedule"
+	EventTypeGrantTokenRole       = "grant_token_role"
+	EventTypeRevokeTokenRole      = "revoke_token_role"
+	EventTypeSetApprovalPolicy    = "set_approval_policy"
+	EventTypeProposeOperation     = "propose_token_operation"
+	EventTypeApproveOperation     = "approve_token_operation"
+	EventTypeCancelOperation      = "cancel_token_operation"
+	EventTypeProposeOwnership     = "propose_ownership"
+	EventTypeCancelOwnership      = "cancel_ownership_transfer"
+	EventTypeSetMintPolicy        = "set_mint_policy"
+	EventTypeCreateSnapshot       = "create_holder_snapshot"
+	EventTypeCreateAirdrop        = "create_airdrop"
+	EventTypeBidSymbol            = "bid_symbol"
+	EventTypeDistributeDividend   = "distribute_dividend"
+	EventTypeClaimDividends       = "claim_dividends"
+	EventTypeSetTransferFee       = "set_transfer_fee"
+	EventTypeClawback             = "clawback"
 
 	AttributeKeySymbol        = "symbol"
 	AttributeKeyTokenOwner    = "owner"
@@ -23,4 +41,38 @@ const (
 	AttributeKeyURL           = "url"
 	AttributeKeyDescription   = "description"
 	AttributeKeyIdentity      = "identity"
+	AttributeKeyDecimals      = "decimals"
+	AttributeKeyLogoURI       = "logo_uri"
+	AttributeKeyVestingID     = "vesting_id"
+	AttributeKeyBeneficiary   = "beneficiary"
+	AttributeKeyRole          = "role"
+	AttributeKeyAddress       = "address"
+	AttributeKeyThreshold     = "threshold"
+	AttributeKeyOperationID   = "operation_id"
+	AttributeKeyOperationType = "operation_type"
+	AttributeKeyExecuted      = "executed"
+	AttributeKeyDeadline      = "deadline"
+	AttributeKeyHardCap       = "hard_cap"
+	AttributeKeySnapshotID    = "snapshot_id"
+	AttributeKeyAirdropID     = "airdrop_id"
+	AttributeKeyHeight        = "height"
+	AttributeKeyBidder        = "bidder"
+	AttributeKeyEndTime       = "end_time"
+	AttributeKeyHolder        = "holder"
+	AttributeKeyRate          = "rate"
+	AttributeKeyReceiver      = "receiver"
+	AttributeKeyReason        = "reason"
+	AttributeKeyActionID      = "action_id"
+	AttributeKeyBurnt         = "burnt"
+	AttributeKeyOrders        = "cancelled_orders"
+
+	// the keys of the msg queue
+	KafkaVestingCreate   = "vesting_create"
+	KafkaVestingRelease  = "vesting_release"
+	KafkaVestingRevoke   = "vesting_revoke"
+	KafkaOwnershipExpire = "ownership_transfer_expire"
+	KafkaTokenInflation  = "token_inflation"
+	KafkaHolderSnapshot  = "holder_snapshot"
+	KafkaAirdropProgress = "airdrop_progress"
+	KafkaSymbolAuction   = "symbol_auction_settle"
 )
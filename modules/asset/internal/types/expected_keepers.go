@@ -12,7 +12,14 @@ type ExpectedBankxKeeper interface {
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	GetTotalCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetFrozenCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	UnlockCoins(ctx sdk.Context, addr sdk.AccAddress, denom string, amount sdk.Int) (sdk.Int, sdk.Error)
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	FreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	UnFreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	DonateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	IterateTokenHolders(ctx sdk.Context, denom string, process func(addr sdk.AccAddress, amount sdk.Int) (stop bool))
 }
 
 // Supply Keeper will implement the interface
@@ -22,3 +29,13 @@ type ExpectedSupplyKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 }
+
+// bancorlite module will implement the interface, to exclude the reserves of the liquidity pools from the dividends
+type ExpectedReserveKeeper interface {
+	GetReserveAccounts(ctx sdk.Context, denom string) []sdk.AccAddress
+}
+
+// market module will implement the interface, to unwind the orders of the holders whose tokens are clawed back
+type ExpectedOrderCanceller interface {
+	CancelOrdersOfHolder(ctx sdk.Context, holder sdk.AccAddress, denom string) []string
+}
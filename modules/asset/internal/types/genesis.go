@@ -2,10 +2,22 @@ package types
 
 // GenesisState - all asset state that must be provided at genesis
 type GenesisState struct {
-	Params             Params   `json:"params" yaml:"params"`
-	Tokens             []Token  `json:"tokens" yaml:"tokens"`
-	Whitelist          []string `json:"whitelist" yaml:"whitelist"`
-	ForbiddenAddresses []string `json:"forbidden_addresses" yaml:"forbidden_addresses"`
+	Params             Params             `json:"params" yaml:"params"`
+	Tokens             []Token            `json:"tokens" yaml:"tokens"`
+	Whitelist          []string           `json:"whitelist" yaml:"whitelist"`
+	ForbiddenAddresses []string           `json:"forbidden_addresses" yaml:"forbidden_addresses"`
+	VestingSchedules   []VestingSchedule  `json:"vesting_schedules" yaml:"vesting_schedules"`
+	TokenRoles         []TokenRole        `json:"token_roles" yaml:"token_roles"`
+	ApprovalPolicies   []ApprovalPolicy   `json:"approval_policies" yaml:"approval_policies"`
+	TokenOperations    []TokenOperation   `json:"token_operations" yaml:"token_operations"`
+	PendingOwnerships  []PendingOwnership `json:"pending_ownerships" yaml:"pending_ownerships"`
+	HolderSnapshots    []HolderSnapshot   `json:"holder_snapshots" yaml:"holder_snapshots"`
+	SnapshotHolders    []SnapshotHolder   `json:"snapshot_holders" yaml:"snapshot_holders"`
+	Airdrops           []Airdrop          `json:"airdrops" yaml:"airdrops"`
+	SymbolAuctions     []SymbolAuction    `json:"symbol_auctions" yaml:"symbol_auctions"`
+	DividendPools      []DividendPool     `json:"dividend_pools" yaml:"dividend_pools"`
+	DividendAccounts   []DividendAccount  `json:"dividend_accounts" yaml:"dividend_accounts"`
+	AdminActions       []AdminAction      `json:"admin_actions" yaml:"admin_actions"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -15,6 +27,18 @@ func NewGenesisState(params Params, tokens []Token, whitelist []string, forbidde
 		Tokens:             tokens,
 		Whitelist:          whitelist,
 		ForbiddenAddresses: forbiddenAddresses,
+		VestingSchedules:   []VestingSchedule{},
+		TokenRoles:         []TokenRole{},
+		ApprovalPolicies:   []ApprovalPolicy{},
+		TokenOperations:    []TokenOperation{},
+		PendingOwnerships:  []PendingOwnership{},
+		HolderSnapshots:    []HolderSnapshot{},
+		SnapshotHolders:    []SnapshotHolder{},
+		Airdrops:           []Airdrop{},
+		SymbolAuctions:     []SymbolAuction{},
+		DividendPools:      []DividendPool{},
+		DividendAccounts:   []DividendAccount{},
+		AdminActions:       []AdminAction{},
 	}
 }
 
@@ -17,6 +17,9 @@ const (
 	// QuerierRoute is the querier route for asset
 	QuerierRoute = ModuleName
 
+	// Topic is the topic of the msg queue for asset
+	Topic = ModuleName
+
 	DefaultParamspace = ModuleName
 )
 
@@ -25,6 +28,42 @@ var (
 	TokenKey         = []byte{0x01}
 	WhitelistKey     = []byte{0x02}
 	ForbiddenAddrKey = []byte{0x03}
+
+	VestingScheduleKey      = []byte{0x04}
+	VestingQueueKey         = []byte{0x05}
+	VestingByBeneficiaryKey = []byte{0x06}
+	VestingNextIDKey        = []byte{0x07}
+
+	TokenRoleKey            = []byte{0x08}
+	ApprovalPolicyKey       = []byte{0x09}
+	TokenOperationKey       = []byte{0x0A}
+	TokenOperationNextIDKey = []byte{0x0B}
+
+	PendingOwnershipKey = []byte{0x0C}
+	OwnershipQueueKey   = []byte{0x0D}
+
+	InflationQueueKey = []byte{0x0E}
+
+	HolderSnapshotKey       = []byte{0x0F}
+	SnapshotHolderKey       = []byte{0x10}
+	SnapshotQueueKey        = []byte{0x11}
+	HolderSnapshotNextIDKey = []byte{0x12}
+	AirdropKey              = []byte{0x13}
+	ActiveAirdropKey        = []byte{0x14}
+	AirdropNextIDKey        = []byte{0x15}
+
+	SymbolAuctionKey      = []byte{0x16}
+	SymbolAuctionQueueKey = []byte{0x17}
+
+	DividendPoolKey    = []byte{0x18}
+	DividendAccountKey = []byte{0x19}
+
+	AdminActionKey       = []byte{0x1A}
+	AdminActionNextIDKey = []byte{0x1B}
+
+	TokenByOwnerKey = []byte{0x1C}
+	TokenByWordKey  = []byte{0x1D}
+	TokenByFlagKey  = []byte{0x1E}
 )
 
 // GetTokenStoreKey - TokenKey | symbol
@@ -61,3 +100,178 @@ func GetForbiddenAddrKeyPrefix(symbol string) []byte {
 func GetForbiddenAddrKeyPrefixLength(symbol string) int {
 	return len(GetForbiddenAddrKeyPrefix(symbol))
 }
+
+// GetVestingScheduleKey - VestingScheduleKey | ID
+func GetVestingScheduleKey(id uint64) []byte {
+	return append(append([]byte{}, VestingScheduleKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVestingQueueKey - VestingQueueKey | Time | ID
+func GetVestingQueueKey(time int64, id uint64) []byte {
+	return append(GetVestingQueueTimeKey(time), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVestingQueueTimeKey - VestingQueueKey | Time
+func GetVestingQueueTimeKey(time int64) []byte {
+	return append(append([]byte{}, VestingQueueKey...), sdk.Uint64ToBigEndian(uint64(time))...)
+}
+
+// GetVestingByBeneficiaryKey - VestingByBeneficiaryKey | AccAddress | ID
+func GetVestingByBeneficiaryKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(GetVestingByBeneficiaryPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVestingByBeneficiaryPrefix - VestingByBeneficiaryKey | AccAddress
+func GetVestingByBeneficiaryPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, VestingByBeneficiaryKey...), addr...)
+}
+
+// GetTokenRoleKey - TokenRoleKey | Symbol | : | Role | : | AccAddress
+func GetTokenRoleKey(symbol, role string, addr sdk.AccAddress) []byte {
+	return append(append(append(GetTokenRolePrefix(symbol), role...), SeparateKey...), addr...)
+}
+
+// GetTokenRolePrefix - TokenRoleKey | Symbol | :
+func GetTokenRolePrefix(symbol string) []byte {
+	return append(append(append([]byte{}, TokenRoleKey...), symbol...), SeparateKey...)
+}
+
+// GetApprovalPolicyKey - ApprovalPolicyKey | Symbol
+func GetApprovalPolicyKey(symbol string) []byte {
+	return append(append([]byte{}, ApprovalPolicyKey...), symbol...)
+}
+
+// GetTokenOperationKey - TokenOperationKey | ID
+func GetTokenOperationKey(id uint64) []byte {
+	return append(append([]byte{}, TokenOperationKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingOwnershipKey - PendingOwnershipKey | Symbol
+func GetPendingOwnershipKey(symbol string) []byte {
+	return append(append([]byte{}, PendingOwnershipKey...), symbol...)
+}
+
+// GetOwnershipQueueKey - OwnershipQueueKey | Deadline | Symbol
+func GetOwnershipQueueKey(deadline int64, symbol string) []byte {
+	return append(GetOwnershipQueueTimeKey(deadline), symbol...)
+}
+
+// GetOwnershipQueueTimeKey - OwnershipQueueKey | Deadline
+func GetOwnershipQueueTimeKey(deadline int64) []byte {
+	return append(append([]byte{}, OwnershipQueueKey...), sdk.Uint64ToBigEndian(uint64(deadline))...)
+}
+
+// GetInflationQueueKey - InflationQueueKey | Time | Symbol
+func GetInflationQueueKey(time int64, symbol string) []byte {
+	return append(GetInflationQueueTimeKey(time), symbol...)
+}
+
+// GetInflationQueueTimeKey - InflationQueueKey | Time
+func GetInflationQueueTimeKey(time int64) []byte {
+	return append(append([]byte{}, InflationQueueKey...), sdk.Uint64ToBigEndian(uint64(time))...)
+}
+
+// GetHolderSnapshotKey - HolderSnapshotKey | ID
+func GetHolderSnapshotKey(id uint64) []byte {
+	return append(append([]byte{}, HolderSnapshotKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSnapshotHolderKey - SnapshotHolderKey | ID | AccAddress
+func GetSnapshotHolderKey(id uint64, addr sdk.AccAddress) []byte {
+	return append(GetSnapshotHolderPrefix(id), addr...)
+}
+
+// GetSnapshotHolderPrefix - SnapshotHolderKey | ID
+func GetSnapshotHolderPrefix(id uint64) []byte {
+	return append(append([]byte{}, SnapshotHolderKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSnapshotQueueKey - SnapshotQueueKey | Height | ID
+func GetSnapshotQueueKey(height int64, id uint64) []byte {
+	return append(GetSnapshotQueueHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSnapshotQueueHeightKey - SnapshotQueueKey | Height
+func GetSnapshotQueueHeightKey(height int64) []byte {
+	return append(append([]byte{}, SnapshotQueueKey...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetAirdropKey - AirdropKey | ID
+func GetAirdropKey(id uint64) []byte {
+	return append(append([]byte{}, AirdropKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetActiveAirdropKey - ActiveAirdropKey | ID
+func GetActiveAirdropKey(id uint64) []byte {
+	return append(append([]byte{}, ActiveAirdropKey...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSymbolAuctionKey - SymbolAuctionKey | Symbol
+func GetSymbolAuctionKey(symbol string) []byte {
+	return append(append([]byte{}, SymbolAuctionKey...), symbol...)
+}
+
+// GetSymbolAuctionQueueKey - SymbolAuctionQueueKey | QueueTime | Symbol
+func GetSymbolAuctionQueueKey(queueTime int64, symbol string) []byte {
+	return append(GetSymbolAuctionQueueTimeKey(queueTime), symbol...)
+}
+
+// GetSymbolAuctionQueueTimeKey - SymbolAuctionQueueKey | QueueTime
+func GetSymbolAuctionQueueTimeKey(queueTime int64) []byte {
+	return append(append([]byte{}, SymbolAuctionQueueKey...), sdk.Uint64ToBigEndian(uint64(queueTime))...)
+}
+
+// GetDividendPoolKey - DividendPoolKey | Symbol | : | Denom
+func GetDividendPoolKey(symbol, denom string) []byte {
+	return append(GetDividendPoolPrefix(symbol), denom...)
+}
+
+// GetDividendPoolPrefix - DividendPoolKey | Symbol | :
+func GetDividendPoolPrefix(symbol string) []byte {
+	return append(append(append([]byte{}, DividendPoolKey...), symbol...), SeparateKey...)
+}
+
+// GetDividendAccountKey - DividendAccountKey | Symbol | : | Denom | : | AccAddress
+func GetDividendAccountKey(symbol, denom string, addr sdk.AccAddress) []byte {
+	return append(append(append(append(append(append([]byte{}, DividendAccountKey...), symbol...), SeparateKey...),
+		denom...), SeparateKey...), addr...)
+}
+
+// GetAdminActionKey - AdminActionKey | symbol | : | id
+func GetAdminActionKey(symbol string, id uint64) []byte {
+	return append(GetAdminActionPrefix(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAdminActionPrefix - AdminActionKey | symbol | :
+func GetAdminActionPrefix(symbol string) []byte {
+	return append(append(append([]byte{}, AdminActionKey...), symbol...), SeparateKey...)
+}
+
+// GetTokenByOwnerKey - TokenByOwnerKey | AccAddress | symbol
+func GetTokenByOwnerKey(owner sdk.AccAddress, symbol string) []byte {
+	return append(GetTokenByOwnerPrefix(owner), symbol...)
+}
+
+// GetTokenByOwnerPrefix - TokenByOwnerKey | AccAddress
+func GetTokenByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, TokenByOwnerKey...), owner...)
+}
+
+// GetTokenByWordKey - TokenByWordKey | word | : | symbol
+func GetTokenByWordKey(word, symbol string) []byte {
+	return append(append(GetTokenByWordPrefix(word), SeparateKey...), symbol...)
+}
+
+// GetTokenByWordPrefix - TokenByWordKey | word, which selects the words starting with it
+func GetTokenByWordPrefix(word string) []byte {
+	return append(append([]byte{}, TokenByWordKey...), word...)
+}
+
+// GetTokenByFlagKey - TokenByFlagKey | flag | 0 or 1 | symbol
+func GetTokenByFlagKey(flag byte, value bool, symbol string) []byte {
+	v := byte(0)
+	if value {
+		v = 1
+	}
+	return append(append(append([]byte{}, TokenByFlagKey...), flag, v), symbol...)
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/hex"
+	"sort"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxTokenDecimals = 18
+	// the logo hash is the hex encoded sha256 of the logo
+	TokenLogoHashLength = 64
+)
+
+// MetadataEntry is a key/value pair of token metadata.
+// Amino can not encode maps, so the entries are kept sorted by key in a slice.
+type MetadataEntry struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// TokenMetadata - the structured information used by wallets and explorers to display a token
+type TokenMetadata struct {
+	Decimals    uint8           `json:"decimals" yaml:"decimals"`         // display decimals
+	LogoURI     string          `json:"logo_uri" yaml:"logo_uri"`         // URI of the logo image
+	LogoHash    string          `json:"logo_hash" yaml:"logo_hash"`       // hex encoded sha256 of the logo image
+	SocialLinks []MetadataEntry `json:"social_links" yaml:"social_links"` // platform -> link
+	Attributes  []MetadataEntry `json:"attributes" yaml:"attributes"`     // arbitrary key/value attributes
+}
+
+// ValidateBasic checks the metadata without the limits from Params
+func (m TokenMetadata) ValidateBasic() sdk.Error {
+	if m.Decimals > MaxTokenDecimals {
+		return ErrInvalidTokenMetadata("decimals is too large")
+	}
+	if err := ValidateLogoHash(m.LogoHash); err != nil {
+		return err
+	}
+	if err := validateSortedEntries(m.SocialLinks); err != nil {
+		return err
+	}
+	return validateSortedEntries(m.Attributes)
+}
+
+// CheckLimits checks the sizes of the metadata against the limits from Params
+func (m TokenMetadata) CheckLimits(p Params) sdk.Error {
+	if int64(utf8.RuneCountInString(m.LogoURI)) > p.MaxMetadataValueLength {
+		return ErrInvalidTokenMetadata("logo uri is too long")
+	}
+	if int64(len(m.SocialLinks)) > p.MaxMetadataEntries || int64(len(m.Attributes)) > p.MaxMetadataEntries {
+		return ErrInvalidTokenMetadata("too many entries")
+	}
+	for _, entries := range [][]MetadataEntry{m.SocialLinks, m.Attributes} {
+		for _, e := range entries {
+			if int64(utf8.RuneCountInString(e.Key)) > p.MaxMetadataKeyLength {
+				return ErrInvalidTokenMetadata("key is too long: " + e.Key)
+			}
+			if int64(utf8.RuneCountInString(e.Value)) > p.MaxMetadataValueLength {
+				return ErrInvalidTokenMetadata("value is too long: " + e.Key)
+			}
+		}
+	}
+	return nil
+}
+
+func ValidateLogoHash(logoHash string) sdk.Error {
+	if logoHash == "" {
+		return nil
+	}
+	if len(logoHash) != TokenLogoHashLength {
+		return ErrInvalidTokenMetadata("logo hash must be a hex encoded sha256")
+	}
+	if _, err := hex.DecodeString(logoHash); err != nil {
+		return ErrInvalidTokenMetadata("logo hash must be a hex encoded sha256")
+	}
+	return nil
+}
+
+func validateSortedEntries(entries []MetadataEntry) sdk.Error {
+	for i, e := range entries {
+		if e.Key == "" || e.Value == "" {
+			return ErrInvalidTokenMetadata("empty key or value")
+		}
+		if i > 0 && entries[i-1].Key >= e.Key {
+			return ErrInvalidTokenMetadata("entries are not sorted by unique keys")
+		}
+	}
+	return nil
+}
+
+// ValidateEntryUpdates checks the entries used to update metadata, in which an empty value removes the key
+func ValidateEntryUpdates(updates []MetadataEntry) sdk.Error {
+	keys := make(map[string]bool, len(updates))
+	for _, e := range updates {
+		if e.Key == "" {
+			return ErrInvalidTokenMetadata("empty key")
+		}
+		if keys[e.Key] {
+			return ErrInvalidTokenMetadata("duplicated key: " + e.Key)
+		}
+		keys[e.Key] = true
+	}
+	return nil
+}
+
+// MergeEntries applies the updates to the entries: an update with empty value removes the key,
+// and the others add or replace the keys. The result is sorted by key.
+func MergeEntries(entries, updates []MetadataEntry) []MetadataEntry {
+	if len(updates) == 0 {
+		return entries
+	}
+	values := make(map[string]string, len(entries)+len(updates))
+	for _, e := range entries {
+		values[e.Key] = e.Value
+	}
+	for _, e := range updates {
+		if e.Value == "" {
+			delete(values, e.Key)
+		} else {
+			values[e.Key] = e.Value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	merged := make([]MetadataEntry, 0, len(values))
+	for k, v := range values {
+		merged = append(merged, MetadataEntry{Key: k, Value: v})
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Key < merged[j].Key
+	})
+	return merged
+}
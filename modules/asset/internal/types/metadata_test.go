@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeEntries(t *testing.T) {
+	entries := []MetadataEntry{{"github", "g"}, {"twitter", "t"}}
+
+	require.Equal(t, entries, MergeEntries(entries, nil))
+
+	merged := MergeEntries(entries, []MetadataEntry{{"twitter", ""}, {"discord", "d"}, {"github", "g2"}})
+	require.Equal(t, []MetadataEntry{{"discord", "d"}, {"github", "g2"}}, merged)
+	require.Nil(t, validateSortedEntries(merged))
+
+	require.Nil(t, MergeEntries(entries, []MetadataEntry{{"twitter", ""}, {"github", ""}}))
+}
+
+func TestTokenMetadata_ValidateBasic(t *testing.T) {
+	md := TokenMetadata{
+		Decimals:    8,
+		LogoURI:     "https://abc.org/logo.png",
+		LogoHash:    strings.Repeat("ab", 32),
+		SocialLinks: []MetadataEntry{{"github", "g"}, {"twitter", "t"}},
+	}
+	require.Nil(t, md.ValidateBasic())
+
+	invalid := md
+	invalid.Decimals = MaxTokenDecimals + 1
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = md
+	invalid.LogoHash = strings.Repeat("xy", 32)
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = md
+	invalid.LogoHash = "abcd"
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = md
+	invalid.SocialLinks = []MetadataEntry{{"twitter", "t"}, {"github", "g"}}
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = md
+	invalid.Attributes = []MetadataEntry{{"k", ""}}
+	require.NotNil(t, invalid.ValidateBasic())
+}
+
+func TestTokenMetadata_CheckLimits(t *testing.T) {
+	p := DefaultParams()
+	p.MaxMetadataEntries = 2
+	p.MaxMetadataKeyLength = 4
+	p.MaxMetadataValueLength = 8
+
+	md := TokenMetadata{LogoURI: "logo.png", Attributes: []MetadataEntry{{"k1", "v1"}, {"k2", "v2"}}}
+	require.Nil(t, md.CheckLimits(p))
+
+	invalid := md
+	invalid.LogoURI = "logo.jpeg"
+	require.NotNil(t, invalid.CheckLimits(p))
+	invalid = md
+	invalid.Attributes = []MetadataEntry{{"k1", "v1"}, {"k2", "v2"}, {"k3", "v3"}}
+	require.NotNil(t, invalid.CheckLimits(p))
+	invalid = md
+	invalid.Attributes = []MetadataEntry{{"key10", "v1"}}
+	require.NotNil(t, invalid.CheckLimits(p))
+	invalid = md
+	invalid.SocialLinks = []MetadataEntry{{"k1", "123456789"}}
+	require.NotNil(t, invalid.CheckLimits(p))
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMintAmount is MaxTokenAmount as sdk.Int, which bounds the amounts of a mint policy and
+// the total supply reached by inflation
+var MaxMintAmount = sdk.NewIntWithDecimal(5, 76)
+
+// MintPolicy - the limits on minting a token, which can not be removed once adopted.
+// A zero HardCap, MaxMintPerPeriod or InflationAmount disables the corresponding rule.
+// Its progress is kept in the MintState of the token by the keeper.
+type MintPolicy struct {
+	HardCap            sdk.Int        `json:"hard_cap" yaml:"hard_cap"`                       // the total supply can never exceed it
+	MintPeriod         int64          `json:"mint_period" yaml:"mint_period"`                 // seconds
+	MaxMintPerPeriod   sdk.Int        `json:"max_mint_per_period" yaml:"max_mint_per_period"` // of the mints by the owner or the minters
+	InflationAmount    sdk.Int        `json:"inflation_amount" yaml:"inflation_amount"`       // minted automatically every InflationPeriod
+	InflationPeriod    int64          `json:"inflation_period" yaml:"inflation_period"`       // seconds
+	InflationRecipient sdk.AccAddress `json:"inflation_recipient" yaml:"inflation_recipient"` // who receives the inflation
+}
+
+func NewMintPolicy(hardCap sdk.Int, mintPeriod int64, maxMintPerPeriod sdk.Int,
+	inflationAmount sdk.Int, inflationPeriod int64, inflationRecipient sdk.AccAddress) MintPolicy {
+
+	return MintPolicy{
+		HardCap:            hardCap,
+		MintPeriod:         mintPeriod,
+		MaxMintPerPeriod:   maxMintPerPeriod,
+		InflationAmount:    inflationAmount,
+		InflationPeriod:    inflationPeriod,
+		InflationRecipient: inflationRecipient,
+	}
+}
+
+func (p MintPolicy) HasHardCap() bool {
+	return p.HardCap.IsPositive()
+}
+
+func (p MintPolicy) HasPeriodLimit() bool {
+	return p.MaxMintPerPeriod.IsPositive()
+}
+
+func (p MintPolicy) HasInflation() bool {
+	return p.InflationAmount.IsPositive()
+}
+
+// ValidateBasic checks the rules of the policy
+func (p MintPolicy) ValidateBasic() sdk.Error {
+	if p.HardCap == (sdk.Int{}) || p.MaxMintPerPeriod == (sdk.Int{}) || p.InflationAmount == (sdk.Int{}) {
+		return ErrInvalidMintPolicy("missing amount")
+	}
+	if p.HardCap.IsNegative() || p.MaxMintPerPeriod.IsNegative() || p.InflationAmount.IsNegative() {
+		return ErrInvalidMintPolicy("negative amount")
+	}
+	if p.HardCap.GT(MaxMintAmount) || p.MaxMintPerPeriod.GT(MaxMintAmount) || p.InflationAmount.GT(MaxMintAmount) {
+		return ErrInvalidMintPolicy("amount exceeds " + MaxMintAmount.String())
+	}
+	if !p.HasHardCap() && !p.HasPeriodLimit() && !p.HasInflation() {
+		return ErrInvalidMintPolicy("no rule is set")
+	}
+	if p.HasPeriodLimit() != (p.MintPeriod > 0) {
+		return ErrInvalidMintPolicy("mint period and max mint per period must be set together")
+	}
+	if p.HasInflation() && (p.InflationPeriod <= 0 || p.InflationRecipient.Empty()) {
+		return ErrInvalidMintPolicy("inflation needs a positive period and a recipient")
+	}
+	if p.MintPeriod < 0 || p.InflationPeriod < 0 {
+		return ErrInvalidMintPolicy("negative period")
+	}
+	return nil
+}
+
+// Validate checks the policy against the total supply of the token
+func (p MintPolicy) Validate(totalSupply sdk.Int) sdk.Error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.HasHardCap() && p.HardCap.LT(totalSupply) {
+		return ErrInvalidMintPolicy("the hard cap is below the total supply")
+	}
+	return nil
+}
+
+// MintState - the progress of the mint policy of a token, which is maintained by the keeper
+type MintState struct {
+	PeriodStart       int64   `json:"period_start" yaml:"period_start"`               // unix time when the current mint period starts
+	PeriodMinted      sdk.Int `json:"period_minted" yaml:"period_minted"`             // minted in the current mint period
+	NextInflationTime int64   `json:"next_inflation_time" yaml:"next_inflation_time"` // unix time of the next inflation
+}
+
+// NewMintState returns the state of a policy adopted at 'now', whose first inflation is one period later
+func NewMintState(policy MintPolicy, now int64) MintState {
+	state := MintState{
+		PeriodStart:  now,
+		PeriodMinted: sdk.ZeroInt(),
+	}
+	if policy.HasInflation() {
+		state.NextInflationTime = now + policy.InflationPeriod
+	}
+	return state
+}
+
+func (s MintState) Validate() sdk.Error {
+	if s.PeriodMinted == (sdk.Int{}) || s.PeriodMinted.IsNegative() {
+		return ErrInvalidMintPolicy("the amount minted in the period must not be negative")
+	}
+	if s.PeriodStart < 0 || s.NextInflationTime < 0 {
+		return ErrInvalidMintPolicy("negative time")
+	}
+	return nil
+}
+
+// InflationInfo is sent to the msg queue when the inflation of a token is minted
+type InflationInfo struct {
+	Symbol    string         `json:"symbol"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Int        `json:"amount"`
+	Height    int64          `json:"height"`
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMintPolicy_Validate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	zero := sdk.ZeroInt()
+	require.Nil(t, NewMintPolicy(sdk.NewInt(100), 0, zero, zero, 0, nil).Validate(sdk.NewInt(100)))
+	require.NotNil(t, NewMintPolicy(sdk.NewInt(100), 0, zero, zero, 0, nil).Validate(sdk.NewInt(101)))
+	require.Nil(t, NewMintPolicy(zero, 10, sdk.NewInt(5), zero, 0, nil).ValidateBasic())
+	require.Nil(t, NewMintPolicy(zero, 0, zero, sdk.NewInt(5), 10, addr).ValidateBasic())
+
+	require.NotNil(t, NewMintPolicy(zero, 0, zero, zero, 0, nil).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(sdk.NewInt(-1), 0, zero, zero, 0, nil).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(zero, 10, zero, zero, 0, nil).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(zero, 0, sdk.NewInt(5), zero, 0, nil).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(zero, 0, zero, sdk.NewInt(5), 10, nil).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(zero, 0, zero, sdk.NewInt(5), 0, addr).ValidateBasic())
+	require.NotNil(t, MintPolicy{HardCap: sdk.NewInt(1)}.ValidateBasic())
+
+	// the amounts are bounded by the max token amount
+	require.Nil(t, NewMintPolicy(MaxMintAmount, 0, zero, MaxMintAmount, 10, addr).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(MaxMintAmount.AddRaw(1), 0, zero, zero, 0, nil).ValidateBasic())
+	require.NotNil(t, NewMintPolicy(zero, 0, zero, MaxMintAmount.AddRaw(1), 10, addr).ValidateBasic())
+}
+
+func TestMintState_Validate(t *testing.T) {
+	policy := NewMintPolicy(sdk.ZeroInt(), 0, sdk.ZeroInt(), sdk.NewInt(5), 10, sdk.AccAddress([]byte("addr")))
+	state := NewMintState(policy, 100)
+	require.Nil(t, state.Validate())
+	require.Equal(t, int64(110), state.NextInflationTime)
+	require.Equal(t, int64(0), NewMintState(NewMintPolicy(sdk.NewInt(1), 0, sdk.ZeroInt(), sdk.ZeroInt(), 0, nil), 100).NextInflationTime)
+
+	require.NotNil(t, MintState{PeriodStart: 100}.Validate())
+	require.NotNil(t, MintState{PeriodMinted: sdk.NewInt(-1)}.Validate())
+	require.NotNil(t, MintState{PeriodMinted: sdk.ZeroInt(), NextInflationTime: -1}.Validate())
+
+	// the token must have the state of its policy
+	token, err := NewToken("ABC Token", "abc", sdk.NewInt(2100), sdk.AccAddress([]byte("owner")),
+		true, false, false, false, "", "", TestIdentityString)
+	require.Nil(t, err)
+	require.Nil(t, token.SetMintPolicy(&policy))
+	require.NotNil(t, token.Validate())
+	require.Nil(t, token.SetMintState(&state))
+	require.Nil(t, token.Validate())
+	require.Nil(t, token.SetMintPolicy(nil))
+	require.Nil(t, token.GetMintState())
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,8 +21,29 @@ var (
 	_ sdk.Msg = &MsgForbidAddr{}
 	_ sdk.Msg = &MsgUnForbidAddr{}
 	_ sdk.Msg = &MsgModifyTokenInfo{}
+	_ sdk.Msg = &MsgCreateVestingSchedule{}
+	_ sdk.Msg = &MsgRevokeVestingSchedule{}
+	_ sdk.Msg = &MsgGrantTokenRole{}
+	_ sdk.Msg = &MsgRevokeTokenRole{}
+	_ sdk.Msg = &MsgSetApprovalPolicy{}
+	_ sdk.Msg = &MsgApproveTokenOperation{}
+	_ sdk.Msg = &MsgCancelTokenOperation{}
+	_ sdk.Msg = &MsgAcceptOwnership{}
+	_ sdk.Msg = &MsgCancelOwnershipTransfer{}
+	_ sdk.Msg = &MsgSetMintPolicy{}
+	_ sdk.Msg = &MsgCreateHolderSnapshot{}
+	_ sdk.Msg = &MsgCreateAirdrop{}
+	_ sdk.Msg = &MsgBidSymbol{}
+	_ sdk.Msg = &MsgDistributeDividend{}
+	_ sdk.Msg = &MsgClaimDividends{}
+	_ sdk.Msg = &MsgSetTransferFee{}
+	_ sdk.Msg = &MsgIssueTokens{}
+	_ sdk.Msg = &MsgClawback{}
 )
 
+// MaxIssueTokensBatchSize limits the number of tokens issued by one MsgIssueTokens
+const MaxIssueTokensBatchSize = 50
+
 // MsgIssueToken
 type MsgIssueToken struct {
 	Name             string         `json:"name" yaml:"name"`                           // Name of the newly issued asset, limited to 32 unicode characters
@@ -35,6 +57,9 @@ type MsgIssueToken struct {
 	URL              string         `json:"url" yaml:"url"`                             //URL of token website
 	Description      string         `json:"description" yaml:"description"`             //Description of token info
 	Identity         string         `json:"identity" yaml:"identity"`                   //Identity of token
+	MintPolicy       *MintPolicy    `json:"mint_policy,omitempty" yaml:"mint_policy"`   // The optional limits on minting
+	Permissioned     bool           `json:"permissioned,omitempty" yaml:"permissioned"` // Whether only the owner and the whitelisted addresses could receive this token
+	Clawbackable     bool           `json:"clawbackable,omitempty" yaml:"clawbackable"` // Whether the owner could claw back this token from its holders
 }
 
 // NewMsgIssueToken
@@ -54,9 +79,30 @@ func NewMsgIssueToken(name string, symbol string, amt sdk.Int, owner sdk.AccAddr
 		url,
 		description,
 		identity,
+		nil,
+		false,
+		false,
 	}
 }
 
+// WithMintPolicy sets the mint policy adopted at issue time
+func (msg MsgIssueToken) WithMintPolicy(policy MintPolicy) MsgIssueToken {
+	msg.MintPolicy = &policy
+	return msg
+}
+
+// WithPermissioned issues the token in the permissioned mode, which could not be turned off later
+func (msg MsgIssueToken) WithPermissioned() MsgIssueToken {
+	msg.Permissioned = true
+	return msg
+}
+
+// WithClawbackable issues the token which could be clawed back from its holders, and it could not be changed later
+func (msg MsgIssueToken) WithClawbackable() MsgIssueToken {
+	msg.Clawbackable = true
+	return msg
+}
+
 func (msg *MsgIssueToken) SetAccAddress(addr sdk.AccAddress) {
 	msg.Owner = addr
 }
@@ -75,7 +121,16 @@ func (msg MsgIssueToken) Type() string {
 func (msg MsgIssueToken) ValidateBasic() sdk.Error {
 	_, err := NewToken(msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
 		msg.Mintable, msg.Burnable, msg.AddrForbiddable, msg.TokenForbiddable, msg.URL, msg.Description, msg.Identity)
-	return err
+	if err != nil {
+		return err
+	}
+	if msg.MintPolicy != nil {
+		if !msg.Mintable {
+			return ErrTokenMintNotSupported(msg.Symbol)
+		}
+		return msg.MintPolicy.Validate(msg.TotalSupply)
+	}
+	return nil
 }
 
 // GetSignBytes Implements Msg.
@@ -261,15 +316,23 @@ func (msg MsgBurnToken) GetSigners() []sdk.AccAddress {
 type MsgForbidToken struct {
 	Symbol       string         `json:"symbol" yaml:"symbol"`
 	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Reason       string         `json:"reason,omitempty" yaml:"reason"`
 }
 
 func NewMsgForbidToken(symbol string, owner sdk.AccAddress) MsgForbidToken {
 	return MsgForbidToken{
 		symbol,
 		owner,
+		"",
 	}
 }
 
+// WithReason records why the token is forbidden in the admin action log
+func (msg MsgForbidToken) WithReason(reason string) MsgForbidToken {
+	msg.Reason = reason
+	return msg
+}
+
 func (msg *MsgForbidToken) SetAccAddress(addr sdk.AccAddress) {
 	msg.OwnerAddress = addr
 }
@@ -292,6 +355,9 @@ func (msg MsgForbidToken) ValidateBasic() sdk.Error {
 	if msg.OwnerAddress.Empty() {
 		return ErrNilTokenOwner()
 	}
+	if err := ValidateAdminReason(msg.Reason); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -309,15 +375,23 @@ func (msg MsgForbidToken) GetSigners() []sdk.AccAddress {
 type MsgUnForbidToken struct {
 	Symbol       string         `json:"symbol" yaml:"symbol"`
 	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Reason       string         `json:"reason,omitempty" yaml:"reason"`
 }
 
 func NewMsgUnForbidToken(symbol string, owner sdk.AccAddress) MsgUnForbidToken {
 	return MsgUnForbidToken{
 		symbol,
 		owner,
+		"",
 	}
 }
 
+// WithReason records why the token is unforbidden in the admin action log
+func (msg MsgUnForbidToken) WithReason(reason string) MsgUnForbidToken {
+	msg.Reason = reason
+	return msg
+}
+
 func (msg *MsgUnForbidToken) SetAccAddress(addr sdk.AccAddress) {
 	msg.OwnerAddress = addr
 }
@@ -340,6 +414,9 @@ func (msg MsgUnForbidToken) ValidateBasic() sdk.Error {
 	if msg.OwnerAddress.Empty() {
 		return ErrNilTokenOwner()
 	}
+	if err := ValidateAdminReason(msg.Reason); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -475,6 +552,7 @@ type MsgForbidAddr struct {
 	Symbol    string           `json:"symbol" yaml:"symbol"`
 	OwnerAddr sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Reason    string           `json:"reason,omitempty" yaml:"reason"`
 }
 
 func NewMsgForbidAddr(symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) MsgForbidAddr {
@@ -482,9 +560,16 @@ func NewMsgForbidAddr(symbol string, owner sdk.AccAddress, addresses []sdk.AccAd
 		symbol,
 		owner,
 		addresses,
+		"",
 	}
 }
 
+// WithReason records why the addresses are forbidden in the admin action log
+func (msg MsgForbidAddr) WithReason(reason string) MsgForbidAddr {
+	msg.Reason = reason
+	return msg
+}
+
 func (msg *MsgForbidAddr) SetAccAddress(addr sdk.AccAddress) {
 	msg.OwnerAddr = addr
 }
@@ -507,6 +592,9 @@ func (msg MsgForbidAddr) ValidateBasic() sdk.Error {
 	if msg.OwnerAddr.Empty() {
 		return ErrNilTokenOwner()
 	}
+	if err := ValidateAdminReason(msg.Reason); err != nil {
+		return err
+	}
 	if len(msg.Addresses) == 0 {
 		return ErrNilForbiddenAddress()
 	}
@@ -539,6 +627,7 @@ type MsgUnForbidAddr struct {
 	Symbol    string           `json:"symbol" yaml:"symbol"`
 	OwnerAddr sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Reason    string           `json:"reason,omitempty" yaml:"reason"`
 }
 
 func NewMsgUnForbidAddr(symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) MsgUnForbidAddr {
@@ -546,9 +635,16 @@ func NewMsgUnForbidAddr(symbol string, owner sdk.AccAddress, addresses []sdk.Acc
 		symbol,
 		owner,
 		addresses,
+		"",
 	}
 }
 
+// WithReason records why the addresses are unforbidden in the admin action log
+func (msg MsgUnForbidAddr) WithReason(reason string) MsgUnForbidAddr {
+	msg.Reason = reason
+	return msg
+}
+
 func (msg *MsgUnForbidAddr) SetAccAddress(addr sdk.AccAddress) {
 	msg.OwnerAddr = addr
 }
@@ -571,6 +667,9 @@ func (msg MsgUnForbidAddr) ValidateBasic() sdk.Error {
 	if msg.OwnerAddr.Empty() {
 		return ErrNilTokenOwner()
 	}
+	if err := ValidateAdminReason(msg.Reason); err != nil {
+		return err
+	}
 	if len(msg.Addresses) == 0 {
 		return ErrNilForbiddenAddress()
 	}
@@ -606,6 +705,14 @@ type MsgModifyTokenInfo struct {
 	Burnable         string         `json:"burnable" yaml:"burnable"`
 	AddrForbiddable  string         `json:"addr_forbiddable" yaml:"addr_forbiddable"`
 	TokenForbiddable string         `json:"token_forbiddable" yaml:"token_forbiddable"`
+
+	// optional metadata fields, which are not modified when empty;
+	// an entry with empty value removes the key
+	Decimals    string          `json:"decimals,omitempty" yaml:"decimals,omitempty"`
+	LogoURI     string          `json:"logo_uri,omitempty" yaml:"logo_uri,omitempty"`
+	LogoHash    string          `json:"logo_hash,omitempty" yaml:"logo_hash,omitempty"`
+	SocialLinks []MetadataEntry `json:"social_links,omitempty" yaml:"social_links,omitempty"`
+	Attributes  []MetadataEntry `json:"attributes,omitempty" yaml:"attributes,omitempty"`
 }
 
 func NewMsgModifyTokenInfo(symbol, url, description, identity string, owner sdk.AccAddress,
@@ -625,6 +732,17 @@ func NewMsgModifyTokenInfo(symbol, url, description, identity string, owner sdk.
 	}
 }
 
+// WithMetadata returns a copy of the msg which also modifies the metadata of the token
+func (msg MsgModifyTokenInfo) WithMetadata(decimals, logoURI, logoHash string,
+	socialLinks, attributes []MetadataEntry) MsgModifyTokenInfo {
+	msg.Decimals = decimals
+	msg.LogoURI = logoURI
+	msg.LogoHash = logoHash
+	msg.SocialLinks = socialLinks
+	msg.Attributes = attributes
+	return msg
+}
+
 func (msg *MsgModifyTokenInfo) SetAccAddress(addr sdk.AccAddress) {
 	msg.OwnerAddress = addr
 }
@@ -687,6 +805,20 @@ func (msg MsgModifyTokenInfo) ValidateBasic() sdk.Error {
 	if err := validateBoolField("TokenForbiddable", msg.TokenForbiddable); err != nil {
 		return err
 	}
+	if msg.Decimals != "" {
+		if d, err := strconv.ParseUint(msg.Decimals, 10, 8); err != nil || d > MaxTokenDecimals {
+			return ErrInvalidTokenInfo("Decimals", msg.Decimals)
+		}
+	}
+	if err := ValidateLogoHash(msg.LogoHash); err != nil {
+		return err
+	}
+	if err := ValidateEntryUpdates(msg.SocialLinks); err != nil {
+		return err
+	}
+	if err := ValidateEntryUpdates(msg.Attributes); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -709,3 +841,974 @@ func (msg MsgModifyTokenInfo) GetSignBytes() []byte {
 func (msg MsgModifyTokenInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
+
+// MsgCreateVestingSchedule escrows Amount of Symbol from Creator, to be released to Beneficiary
+// by a linear schedule, or a cliff-plus-linear one when CliffTime is after StartTime
+type MsgCreateVestingSchedule struct {
+	Creator     sdk.AccAddress `json:"creator" yaml:"creator"`
+	Beneficiary sdk.AccAddress `json:"beneficiary" yaml:"beneficiary"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	Amount      sdk.Int        `json:"amount" yaml:"amount"`
+	StartTime   int64          `json:"start_time" yaml:"start_time"`
+	CliffTime   int64          `json:"cliff_time" yaml:"cliff_time"` // zero for StartTime
+	EndTime     int64          `json:"end_time" yaml:"end_time"`
+	Period      int64          `json:"period" yaml:"period"` // seconds between releases
+	Revocable   bool           `json:"revocable" yaml:"revocable"`
+}
+
+func NewMsgCreateVestingSchedule(creator, beneficiary sdk.AccAddress, symbol string, amount sdk.Int,
+	startTime, cliffTime, endTime, period int64, revocable bool) MsgCreateVestingSchedule {
+	return MsgCreateVestingSchedule{
+		Creator:     creator,
+		Beneficiary: beneficiary,
+		Symbol:      symbol,
+		Amount:      amount,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+		Period:      period,
+		Revocable:   revocable,
+	}
+}
+
+func (msg *MsgCreateVestingSchedule) SetAccAddress(addr sdk.AccAddress) {
+	msg.Creator = addr
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingSchedule) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgCreateVestingSchedule) Type() string {
+	return "create_vesting_schedule"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingSchedule) ValidateBasic() sdk.Error {
+	vs := msg.Schedule()
+	return vs.Validate()
+}
+
+// Schedule returns the schedule to be created, without its ID and next release time
+func (msg MsgCreateVestingSchedule) Schedule() VestingSchedule {
+	cliffTime := msg.CliffTime
+	if cliffTime == 0 {
+		cliffTime = msg.StartTime
+	}
+	return VestingSchedule{
+		Symbol:      msg.Symbol,
+		Creator:     msg.Creator,
+		Beneficiary: msg.Beneficiary,
+		Total:       msg.Amount,
+		Released:    sdk.ZeroInt(),
+		StartTime:   msg.StartTime,
+		CliffTime:   cliffTime,
+		EndTime:     msg.EndTime,
+		Period:      msg.Period,
+		Revocable:   msg.Revocable,
+	}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgRevokeVestingSchedule releases the vested coins of a revocable schedule to its beneficiary,
+// returns the rest to its creator, and deletes the schedule
+type MsgRevokeVestingSchedule struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	ID      uint64         `json:"id" yaml:"id"`
+}
+
+func NewMsgRevokeVestingSchedule(creator sdk.AccAddress, id uint64) MsgRevokeVestingSchedule {
+	return MsgRevokeVestingSchedule{
+		Creator: creator,
+		ID:      id,
+	}
+}
+
+func (msg *MsgRevokeVestingSchedule) SetAccAddress(addr sdk.AccAddress) {
+	msg.Creator = addr
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeVestingSchedule) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgRevokeVestingSchedule) Type() string {
+	return "revoke_vesting_schedule"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeVestingSchedule) ValidateBasic() sdk.Error {
+	if msg.Creator.Empty() {
+		return ErrInvalidVestingSchedule("missing creator")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgGrantTokenRole
+type MsgGrantTokenRole struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Role         string         `json:"role" yaml:"role"`
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewMsgGrantTokenRole(symbol string, owner sdk.AccAddress, role string, addr sdk.AccAddress) MsgGrantTokenRole {
+	return MsgGrantTokenRole{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Role:         role,
+		Address:      addr,
+	}
+}
+
+func (msg *MsgGrantTokenRole) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgGrantTokenRole) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgGrantTokenRole) Type() string {
+	return "grant_token_role"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantTokenRole) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Address.Empty() {
+		return ErrInvalidTokenRole("missing address")
+	}
+	return TokenRole{Symbol: msg.Symbol, Role: msg.Role, Address: msg.Address}.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantTokenRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantTokenRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgRevokeTokenRole
+type MsgRevokeTokenRole struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Role         string         `json:"role" yaml:"role"`
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewMsgRevokeTokenRole(symbol string, owner sdk.AccAddress, role string, addr sdk.AccAddress) MsgRevokeTokenRole {
+	return MsgRevokeTokenRole{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Role:         role,
+		Address:      addr,
+	}
+}
+
+func (msg *MsgRevokeTokenRole) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeTokenRole) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgRevokeTokenRole) Type() string {
+	return "revoke_token_role"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeTokenRole) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Address.Empty() {
+		return ErrInvalidTokenRole("missing address")
+	}
+	return TokenRole{Symbol: msg.Symbol, Role: msg.Role, Address: msg.Address}.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeTokenRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeTokenRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgSetApprovalPolicy
+type MsgSetApprovalPolicy struct {
+	Symbol       string           `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
+	Approvers    []sdk.AccAddress `json:"approvers" yaml:"approvers"`
+	Threshold    int64            `json:"threshold" yaml:"threshold"` // zero to remove the policy
+}
+
+func NewMsgSetApprovalPolicy(symbol string, owner sdk.AccAddress, approvers []sdk.AccAddress, threshold int64) MsgSetApprovalPolicy {
+	return MsgSetApprovalPolicy{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Approvers:    approvers,
+		Threshold:    threshold,
+	}
+}
+
+func (msg *MsgSetApprovalPolicy) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetApprovalPolicy) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetApprovalPolicy) Type() string {
+	return "set_approval_policy"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetApprovalPolicy) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Threshold == 0 && len(msg.Approvers) == 0 {
+		return ValidateTokenSymbol(msg.Symbol)
+	}
+	return msg.Policy().Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetApprovalPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetApprovalPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+func (msg MsgSetApprovalPolicy) Policy() ApprovalPolicy {
+	return ApprovalPolicy{
+		Symbol:    msg.Symbol,
+		Approvers: msg.Approvers,
+		Threshold: msg.Threshold,
+	}
+}
+
+// MsgApproveTokenOperation
+type MsgApproveTokenOperation struct {
+	Approver sdk.AccAddress `json:"approver" yaml:"approver"`
+	ID       uint64         `json:"id" yaml:"id"`
+}
+
+func NewMsgApproveTokenOperation(approver sdk.AccAddress, id uint64) MsgApproveTokenOperation {
+	return MsgApproveTokenOperation{
+		Approver: approver,
+		ID:       id,
+	}
+}
+
+func (msg *MsgApproveTokenOperation) SetAccAddress(addr sdk.AccAddress) {
+	msg.Approver = addr
+}
+
+// Route Implements Msg.
+func (msg MsgApproveTokenOperation) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgApproveTokenOperation) Type() string {
+	return "approve_token_operation"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgApproveTokenOperation) ValidateBasic() sdk.Error {
+	if msg.Approver.Empty() {
+		return ErrInvalidApproval("missing approver")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgApproveTokenOperation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgApproveTokenOperation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Approver}
+}
+
+// MsgCancelTokenOperation
+type MsgCancelTokenOperation struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	ID     uint64         `json:"id" yaml:"id"`
+}
+
+func NewMsgCancelTokenOperation(sender sdk.AccAddress, id uint64) MsgCancelTokenOperation {
+	return MsgCancelTokenOperation{
+		Sender: sender,
+		ID:     id,
+	}
+}
+
+func (msg *MsgCancelTokenOperation) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg.
+func (msg MsgCancelTokenOperation) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgCancelTokenOperation) Type() string {
+	return "cancel_token_operation"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelTokenOperation) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidApproval("missing sender")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelTokenOperation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelTokenOperation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgAcceptOwnership
+type MsgAcceptOwnership struct {
+	Symbol   string         `json:"symbol" yaml:"symbol"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+func NewMsgAcceptOwnership(symbol string, newOwner sdk.AccAddress) MsgAcceptOwnership {
+	return MsgAcceptOwnership{
+		Symbol:   symbol,
+		NewOwner: newOwner,
+	}
+}
+
+func (msg *MsgAcceptOwnership) SetAccAddress(addr sdk.AccAddress) {
+	msg.NewOwner = addr
+}
+
+// Route Implements Msg.
+func (msg MsgAcceptOwnership) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgAcceptOwnership) Type() string {
+	return "accept_ownership"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptOwnership) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.NewOwner.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAcceptOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewOwner}
+}
+
+// MsgCancelOwnershipTransfer
+type MsgCancelOwnershipTransfer struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+}
+
+func NewMsgCancelOwnershipTransfer(symbol string, owner sdk.AccAddress) MsgCancelOwnershipTransfer {
+	return MsgCancelOwnershipTransfer{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+	}
+}
+
+func (msg *MsgCancelOwnershipTransfer) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgCancelOwnershipTransfer) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgCancelOwnershipTransfer) Type() string {
+	return "cancel_ownership_transfer"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelOwnershipTransfer) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelOwnershipTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelOwnershipTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgSetMintPolicy
+type MsgSetMintPolicy struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Policy       MintPolicy     `json:"policy" yaml:"policy"`
+}
+
+func NewMsgSetMintPolicy(symbol string, owner sdk.AccAddress, policy MintPolicy) MsgSetMintPolicy {
+	return MsgSetMintPolicy{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Policy:       policy,
+	}
+}
+
+func (msg *MsgSetMintPolicy) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetMintPolicy) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetMintPolicy) Type() string {
+	return "set_mint_policy"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetMintPolicy) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return msg.Policy.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetMintPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetMintPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgCreateHolderSnapshot schedules a snapshot of the holders of a denom at a height not before the current one
+type MsgCreateHolderSnapshot struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	Height  int64          `json:"height" yaml:"height"`
+}
+
+func NewMsgCreateHolderSnapshot(creator sdk.AccAddress, denom string, height int64) MsgCreateHolderSnapshot {
+	return MsgCreateHolderSnapshot{
+		Creator: creator,
+		Denom:   denom,
+		Height:  height,
+	}
+}
+
+func (msg *MsgCreateHolderSnapshot) SetAccAddress(addr sdk.AccAddress) {
+	msg.Creator = addr
+}
+
+// Route Implements Msg.
+func (msg MsgCreateHolderSnapshot) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgCreateHolderSnapshot) Type() string {
+	return "create_holder_snapshot"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateHolderSnapshot) ValidateBasic() sdk.Error {
+	return HolderSnapshot{
+		Denom:       msg.Denom,
+		Creator:     msg.Creator,
+		Height:      msg.Height,
+		TotalAmount: sdk.ZeroInt(),
+	}.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateHolderSnapshot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateHolderSnapshot) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgCreateAirdrop escrows Amount and distributes it to the holders of a taken snapshot pro-rata
+type MsgCreateAirdrop struct {
+	Sender     sdk.AccAddress `json:"sender" yaml:"sender"`
+	SnapshotID uint64         `json:"snapshot_id" yaml:"snapshot_id"`
+	Amount     sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgCreateAirdrop(sender sdk.AccAddress, snapshotID uint64, amount sdk.Coin) MsgCreateAirdrop {
+	return MsgCreateAirdrop{
+		Sender:     sender,
+		SnapshotID: snapshotID,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgCreateAirdrop) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg.
+func (msg MsgCreateAirdrop) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgCreateAirdrop) Type() string {
+	return "create_airdrop"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateAirdrop) ValidateBasic() sdk.Error {
+	return Airdrop{
+		SnapshotID:  msg.SnapshotID,
+		Sender:      msg.Sender,
+		Amount:      msg.Amount,
+		Distributed: sdk.ZeroInt(),
+	}.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateAirdrop) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgBidSymbol bids CET for a short or reserved symbol, and starts the auction of the symbol with the first bid
+type MsgBidSymbol struct {
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Symbol string         `json:"symbol" yaml:"symbol"`
+	Amount sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+func NewMsgBidSymbol(bidder sdk.AccAddress, symbol string, amount sdk.Int) MsgBidSymbol {
+	return MsgBidSymbol{
+		Bidder: bidder,
+		Symbol: symbol,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBidSymbol) SetAccAddress(addr sdk.AccAddress) {
+	msg.Bidder = addr
+}
+
+// Route Implements Msg.
+func (msg MsgBidSymbol) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgBidSymbol) Type() string {
+	return "bid_symbol"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgBidSymbol) ValidateBasic() sdk.Error {
+	if msg.Bidder.Empty() {
+		return ErrInvalidSymbolAuction("missing bidder")
+	}
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionableSymbol(msg.Symbol) {
+		return ErrInvalidSymbolAuction("the symbol can not be auctioned")
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidSymbolAuction("the bid must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBidSymbol) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBidSymbol) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgDistributeDividend pays Amount as dividends to the holders of Symbol, in proportion to their balances
+type MsgDistributeDividend struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Symbol string         `json:"symbol" yaml:"symbol"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgDistributeDividend(sender sdk.AccAddress, symbol string, amount sdk.Coin) MsgDistributeDividend {
+	return MsgDistributeDividend{
+		Sender: sender,
+		Symbol: symbol,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgDistributeDividend) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+// Route Implements Msg.
+func (msg MsgDistributeDividend) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgDistributeDividend) Type() string {
+	return "distribute_dividend"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgDistributeDividend) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidDividend("missing sender")
+	}
+	if err := ValidateDividendSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if err := ValidateTokenSymbol(msg.Amount.Denom); err != nil {
+		return err
+	}
+	if msg.Amount.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidDividend("the amount must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDistributeDividend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDistributeDividend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgClaimDividends claims all the dividends accrued to a holder of Symbol
+type MsgClaimDividends struct {
+	Holder sdk.AccAddress `json:"holder" yaml:"holder"`
+	Symbol string         `json:"symbol" yaml:"symbol"`
+}
+
+func NewMsgClaimDividends(holder sdk.AccAddress, symbol string) MsgClaimDividends {
+	return MsgClaimDividends{
+		Holder: holder,
+		Symbol: symbol,
+	}
+}
+
+func (msg *MsgClaimDividends) SetAccAddress(addr sdk.AccAddress) {
+	msg.Holder = addr
+}
+
+// Route Implements Msg.
+func (msg MsgClaimDividends) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgClaimDividends) Type() string {
+	return "claim_dividends"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimDividends) ValidateBasic() sdk.Error {
+	if msg.Holder.Empty() {
+		return ErrInvalidDividend("missing holder")
+	}
+	return ValidateDividendSymbol(msg.Symbol)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimDividends) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimDividends) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Holder}
+}
+
+// MsgSetTransferFee sets the transfer fee policy of a token, and a nil Policy removes it
+type MsgSetTransferFee struct {
+	Symbol       string             `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress     `json:"owner_address" yaml:"owner_address"`
+	Policy       *TransferFeePolicy `json:"policy" yaml:"policy"`
+}
+
+func NewMsgSetTransferFee(symbol string, owner sdk.AccAddress, policy *TransferFeePolicy) MsgSetTransferFee {
+	return MsgSetTransferFee{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Policy:       policy,
+	}
+}
+
+func (msg *MsgSetTransferFee) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetTransferFee) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetTransferFee) Type() string {
+	return "set_transfer_fee"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetTransferFee) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Policy != nil {
+		return msg.Policy.ValidateBasic()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetTransferFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetTransferFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgIssueTokens issues a batch of tokens owned by the same address, all or none of them
+type MsgIssueTokens struct {
+	Owner  sdk.AccAddress  `json:"owner" yaml:"owner"`
+	Tokens []MsgIssueToken `json:"tokens" yaml:"tokens"`
+}
+
+func NewMsgIssueTokens(owner sdk.AccAddress, tokens []MsgIssueToken) MsgIssueTokens {
+	return MsgIssueTokens{
+		Owner:  owner,
+		Tokens: tokens,
+	}
+}
+
+func (msg *MsgIssueTokens) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+	for i := range msg.Tokens {
+		msg.Tokens[i].Owner = addr
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueTokens) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgIssueTokens) Type() string {
+	return "issue_tokens"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgIssueTokens) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if len(msg.Tokens) == 0 {
+		return ErrInvalidTokenBatch("no token to issue")
+	}
+	if len(msg.Tokens) > MaxIssueTokensBatchSize {
+		return ErrInvalidTokenBatch(fmt.Sprintf("at most %d tokens could be issued at a time", MaxIssueTokensBatchSize))
+	}
+	symbols := make(map[string]bool, len(msg.Tokens))
+	for i, token := range msg.Tokens {
+		if !token.Owner.Equals(msg.Owner) {
+			return ErrInTokenBatch(i, token.Symbol, ErrInvalidTokenOwner(token.Owner))
+		}
+		if err := token.ValidateBasic(); err != nil {
+			return ErrInTokenBatch(i, token.Symbol, err)
+		}
+		if symbols[token.Symbol] {
+			return ErrInTokenBatch(i, token.Symbol, ErrInvalidTokenBatch("duplicated symbol"))
+		}
+		symbols[token.Symbol] = true
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgClawback takes the Amount of a clawbackable token from the Holder, including the coins frozen
+// in its market orders, and moves them to the Recipient, or burns them if there is no Recipient
+type MsgClawback struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Holder       sdk.AccAddress `json:"holder" yaml:"holder"`
+	Amount       sdk.Int        `json:"amount" yaml:"amount"`
+	Recipient    sdk.AccAddress `json:"recipient,omitempty" yaml:"recipient"`
+	Reason       string         `json:"reason,omitempty" yaml:"reason"`
+}
+
+func NewMsgClawback(symbol string, owner, holder sdk.AccAddress, amount sdk.Int, recipient sdk.AccAddress) MsgClawback {
+	return MsgClawback{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Holder:       holder,
+		Amount:       amount,
+		Recipient:    recipient,
+	}
+}
+
+// WithReason records why the token is clawed back, e.g. the court order
+func (msg MsgClawback) WithReason(reason string) MsgClawback {
+	msg.Reason = reason
+	return msg
+}
+
+func (msg *MsgClawback) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgClawback) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgClawback) Type() string {
+	return "clawback"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Holder.Empty() {
+		return ErrInvalidClawback("missing holder")
+	}
+	if msg.Holder.Equals(msg.OwnerAddress) || msg.Holder.Equals(msg.Recipient) {
+		return ErrInvalidClawback("the holder must not be the owner or the recipient")
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrInvalidClawback("the amount must be positive")
+	}
+	return ValidateAdminReason(msg.Reason)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -242,6 +243,11 @@ func TestMsgForbidToken_ValidateBasic(t *testing.T) {
 			NewMsgForbidToken("abc", sdk.AccAddress{}),
 			ErrNilTokenOwner(),
 		},
+		{
+			"case-longReason",
+			NewMsgForbidToken("abc", testAddr).WithReason(strings.Repeat("x", MaxAdminReasonLength+1)),
+			ErrInvalidAdminAction("the reason is limited to 256 bytes"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -498,6 +504,24 @@ func TestMsgModifyTokenURL_ValidateBasic(t *testing.T) {
 			NewMsgModifyTokenInfo("abc", "www.abc.org", "abc example description", string(make([]byte, MaxTokenIdentityLength+1)), testAddr, "ABC token", "1000", "true", "true", "true", "true"),
 			ErrInvalidTokenIdentity(string(make([]byte, MaxTokenIdentityLength+1))),
 		},
+		{
+			"case-invalidDecimals",
+			NewMsgModifyTokenInfo("abc", "www.abc.org", "abc example description", TestIdentityString, testAddr, "ABC token", "1000", "true", "true", "true", "true").
+				WithMetadata("19", "", "", nil, nil),
+			ErrInvalidTokenInfo("Decimals", "19"),
+		},
+		{
+			"case-invalidLogoHash",
+			NewMsgModifyTokenInfo("abc", "www.abc.org", "abc example description", TestIdentityString, testAddr, "ABC token", "1000", "true", "true", "true", "true").
+				WithMetadata("", "", "abcd", nil, nil),
+			ErrInvalidTokenMetadata("logo hash must be a hex encoded sha256"),
+		},
+		{
+			"case-duplicatedAttributes",
+			NewMsgModifyTokenInfo("abc", "www.abc.org", "abc example description", TestIdentityString, testAddr, "ABC token", "1000", "true", "true", "true", "true").
+				WithMetadata("", "", "", nil, []MetadataEntry{{"k", "v"}, {"k", ""}}),
+			ErrInvalidTokenMetadata("duplicated key: k"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -509,6 +533,132 @@ func TestMsgModifyTokenURL_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgCreateVestingSchedule_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateVestingSchedule
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgCreateVestingSchedule(testAddr, testAddr, "abc", sdk.NewInt(1000), 100, 0, 200, 10, true),
+			nil,
+		},
+		{
+			"case-cliff",
+			NewMsgCreateVestingSchedule(testAddr, testAddr, "abc", sdk.NewInt(1000), 100, 150, 200, 10, false),
+			nil,
+		},
+		{
+			"case-invalidSymbol",
+			NewMsgCreateVestingSchedule(testAddr, testAddr, "a😃", sdk.NewInt(1000), 100, 0, 200, 10, true),
+			ErrInvalidTokenSymbol("a😃"),
+		},
+		{
+			"case-invalidAmount",
+			NewMsgCreateVestingSchedule(testAddr, testAddr, "abc", sdk.ZeroInt(), 100, 0, 200, 10, true),
+			ErrInvalidVestingSchedule("the amount must be positive"),
+		},
+		{
+			"case-invalidTime",
+			NewMsgCreateVestingSchedule(testAddr, testAddr, "abc", sdk.NewInt(1000), 100, 300, 200, 10, true),
+			ErrInvalidVestingSchedule("the times must be start <= cliff <= end and start < end"),
+		},
+		{
+			"case-invalidPeriod",
+			NewMsgCreateVestingSchedule(testAddr, testAddr, "abc", sdk.NewInt(1000), 100, 0, 200, 0, true),
+			ErrInvalidVestingSchedule("the period must be positive"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgCreateVestingSchedule.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetApprovalPolicy_ValidateBasic(t *testing.T) {
+	approver := sdk.AccAddress([]byte("approver"))
+	tests := []struct {
+		name string
+		msg  MsgSetApprovalPolicy
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgSetApprovalPolicy("abc", testAddr, []sdk.AccAddress{testAddr, approver}, 2),
+			nil,
+		},
+		{
+			"case-remove",
+			NewMsgSetApprovalPolicy("abc", testAddr, nil, 0),
+			nil,
+		},
+		{
+			"case-invalidOwner",
+			NewMsgSetApprovalPolicy("abc", nil, []sdk.AccAddress{approver}, 1),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-invalidThreshold",
+			NewMsgSetApprovalPolicy("abc", testAddr, []sdk.AccAddress{approver}, 2),
+			ErrInvalidApprovalPolicy("the threshold must be in [1, number of approvers]"),
+		},
+		{
+			"case-duplicatedApprover",
+			NewMsgSetApprovalPolicy("abc", testAddr, []sdk.AccAddress{approver, approver}, 1),
+			ErrInvalidApprovalPolicy("duplicated approver " + approver.String()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgSetApprovalPolicy.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgCreateAirdrop_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateAirdrop
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgCreateAirdrop(testAddr, 1, sdk.NewCoin("abc", sdk.NewInt(100))),
+			nil,
+		},
+		{
+			"case-invalidSender",
+			NewMsgCreateAirdrop(nil, 1, sdk.NewCoin("abc", sdk.NewInt(100))),
+			ErrInvalidAirdrop("missing sender"),
+		},
+		{
+			"case-zeroAmount",
+			NewMsgCreateAirdrop(testAddr, 1, sdk.NewCoin("abc", sdk.ZeroInt())),
+			ErrInvalidAirdrop("the amount must be positive"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgCreateAirdrop.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	require.Nil(t, NewMsgCreateHolderSnapshot(testAddr, "abc", 1).ValidateBasic())
+	require.NotNil(t, NewMsgCreateHolderSnapshot(testAddr, "abc", 0).ValidateBasic())
+	require.NotNil(t, NewMsgCreateHolderSnapshot(nil, "abc", 1).ValidateBasic())
+}
+
 func TestMsg_Route(t *testing.T) {
 	want := RouterKey
 	tests := []struct {
@@ -794,3 +944,250 @@ func TestMsg_GetSignBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgBidSymbol_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBidSymbol
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgBidSymbol(testAddr, "btc", sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"case-invalidBidder",
+			NewMsgBidSymbol(nil, "btc", sdk.NewInt(100)),
+			ErrInvalidSymbolAuction("missing bidder"),
+		},
+		{
+			"case-longSymbol",
+			NewMsgBidSymbol(testAddr, "abcdefg", sdk.NewInt(100)),
+			ErrInvalidSymbolAuction("the symbol can not be auctioned"),
+		},
+		{
+			"case-zeroAmount",
+			NewMsgBidSymbol(testAddr, "btc", sdk.ZeroInt()),
+			ErrInvalidSymbolAuction("the bid must be positive"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgBidSymbol.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgDistributeDividend_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDistributeDividend
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("cet", sdk.NewInt(100))),
+			nil,
+		},
+		{
+			"case-invalidSender",
+			NewMsgDistributeDividend(nil, "abc", sdk.NewCoin("cet", sdk.NewInt(100))),
+			ErrInvalidDividend("missing sender"),
+		},
+		{
+			"case-cet",
+			NewMsgDistributeDividend(testAddr, "cet", sdk.NewCoin("abc", sdk.NewInt(100))),
+			ErrInvalidDividend("CET holders can not be paid dividends"),
+		},
+		{
+			"case-zeroAmount",
+			NewMsgDistributeDividend(testAddr, "abc", sdk.NewCoin("cet", sdk.ZeroInt())),
+			ErrInvalidDividend("the amount must be positive"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgDistributeDividend.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferFee_ValidateBasic(t *testing.T) {
+	policy := NewTransferFeePolicy(100, sdk.NewInt(1000), nil)
+	highRate := NewTransferFeePolicy(MaxTransferFeeRate+1, sdk.ZeroInt(), testAddr)
+	negativeCap := NewTransferFeePolicy(100, sdk.NewInt(-1), testAddr)
+	tests := []struct {
+		name string
+		msg  MsgSetTransferFee
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgSetTransferFee("abc", testAddr, &policy),
+			nil,
+		},
+		{
+			"case-remove",
+			NewMsgSetTransferFee("abc", testAddr, nil),
+			nil,
+		},
+		{
+			"case-invalidOwner",
+			NewMsgSetTransferFee("abc", nil, &policy),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-highRate",
+			NewMsgSetTransferFee("abc", testAddr, &highRate),
+			ErrInvalidTransferFee("the rate must be between 1 and 1000 basis points"),
+		},
+		{
+			"case-negativeCap",
+			NewMsgSetTransferFee("abc", testAddr, &negativeCap),
+			ErrInvalidTransferFee("the cap must not be negative"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgSetTransferFee.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgIssueTokens_ValidateBasic(t *testing.T) {
+	abc := NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", TestIdentityString)
+	xyz := NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(1000), testAddr,
+		false, false, false, false, "", "", TestIdentityString)
+	invalid := NewMsgIssueToken("XYZ Token", "1xyz", sdk.NewInt(1000), testAddr,
+		false, false, false, false, "", "", TestIdentityString)
+	other := NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(1000), sdk.AccAddress("other"),
+		false, false, false, false, "", "", TestIdentityString)
+	tooMany := make([]MsgIssueToken, MaxIssueTokensBatchSize+1)
+
+	tests := []struct {
+		name string
+		msg  MsgIssueTokens
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgIssueTokens(testAddr, []MsgIssueToken{abc, xyz}),
+			nil,
+		},
+		{
+			"case-invalidOwner",
+			NewMsgIssueTokens(nil, []MsgIssueToken{abc, xyz}),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-empty",
+			NewMsgIssueTokens(testAddr, nil),
+			ErrInvalidTokenBatch("no token to issue"),
+		},
+		{
+			"case-tooMany",
+			NewMsgIssueTokens(testAddr, tooMany),
+			ErrInvalidTokenBatch("at most 50 tokens could be issued at a time"),
+		},
+		{
+			"case-invalidToken",
+			NewMsgIssueTokens(testAddr, []MsgIssueToken{abc, invalid}),
+			ErrInTokenBatch(1, "1xyz", ErrInvalidTokenSymbol("1xyz")),
+		},
+		{
+			"case-otherOwner",
+			NewMsgIssueTokens(testAddr, []MsgIssueToken{other}),
+			ErrInTokenBatch(0, "xyz", ErrInvalidTokenOwner(other.Owner)),
+		},
+		{
+			"case-duplicatedSymbol",
+			NewMsgIssueTokens(testAddr, []MsgIssueToken{abc, xyz, abc}),
+			ErrInTokenBatch(2, "abc", ErrInvalidTokenBatch("duplicated symbol")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgIssueTokens.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	require.Contains(t, ErrInTokenBatch(1, "1xyz", ErrInvalidTokenSymbol("1xyz")).ABCILog(),
+		`"message":"token #2 (1xyz): invalid symbol 1xyz`)
+}
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	addrs := mockAddrList()
+	holder, recipient := addrs[0], addrs[1]
+	tests := []struct {
+		name string
+		msg  MsgClawback
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), recipient).WithReason("court order"),
+			nil,
+		},
+		{
+			"case-burn",
+			NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), nil),
+			nil,
+		},
+		{
+			"case-invalidSymbol",
+			NewMsgClawback("*90", testAddr, holder, sdk.NewInt(100), recipient),
+			ErrInvalidTokenSymbol("*90"),
+		},
+		{
+			"case-invalidOwner",
+			NewMsgClawback("abc", sdk.AccAddress{}, holder, sdk.NewInt(100), recipient),
+			ErrNilTokenOwner(),
+		},
+		{
+			"case-missingHolder",
+			NewMsgClawback("abc", testAddr, sdk.AccAddress{}, sdk.NewInt(100), recipient),
+			ErrInvalidClawback("missing holder"),
+		},
+		{
+			"case-holderIsRecipient",
+			NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), holder),
+			ErrInvalidClawback("the holder must not be the owner or the recipient"),
+		},
+		{
+			"case-holderIsOwner",
+			NewMsgClawback("abc", testAddr, testAddr, sdk.NewInt(100), recipient),
+			ErrInvalidClawback("the holder must not be the owner or the recipient"),
+		},
+		{
+			"case-zeroAmount",
+			NewMsgClawback("abc", testAddr, holder, sdk.ZeroInt(), recipient),
+			ErrInvalidClawback("the amount must be positive"),
+		},
+		{
+			"case-longReason",
+			NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), recipient).WithReason(strings.Repeat("x", MaxAdminReasonLength+1)),
+			ErrInvalidAdminAction("the reason is limited to 256 bytes"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgClawback.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
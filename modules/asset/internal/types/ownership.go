@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PendingOwnership - an ownership transfer proposed by the token owner,
+// which takes effect only after NewOwner accepts it before Deadline
+type PendingOwnership struct {
+	Symbol        string         `json:"symbol" yaml:"symbol"`
+	OriginalOwner sdk.AccAddress `json:"original_owner" yaml:"original_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	Deadline      int64          `json:"deadline" yaml:"deadline"` // unix time
+}
+
+func (po PendingOwnership) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(po.Symbol); err != nil {
+		return err
+	}
+	if po.OriginalOwner.Empty() || po.NewOwner.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if po.OriginalOwner.Equals(po.NewOwner) {
+		return ErrTransferSelfTokenOwner()
+	}
+	if po.Deadline <= 0 {
+		return ErrInvalidOwnershipTransfer("invalid deadline")
+	}
+	return nil
+}
+
+func (po PendingOwnership) IsExpired(now int64) bool {
+	return now > po.Deadline
+}
+
+// OwnershipExpireInfo is sent to the msg queue when a pending ownership transfer expires
+type OwnershipExpireInfo struct {
+	Symbol        string         `json:"symbol"`
+	OriginalOwner sdk.AccAddress `json:"original_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner"`
+	Deadline      int64          `json:"deadline"`
+	Height        int64          `json:"height"`
+}
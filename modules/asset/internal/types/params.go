@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 // DefaultParamspace defines the default asset module parameter subspace
@@ -17,6 +19,19 @@ const (
 	DefaultIssue5CharTokenFee = 200e8   //   200 * 10^8
 	DefaultIssue6CharTokenFee = 100e8   //   100 * 10^8
 	DefaultIssueLongTokenFee  = 50e8    //    50 * 10^8
+
+	DefaultMaxMetadataEntries     = 16
+	DefaultMaxMetadataKeyLength   = 32
+	DefaultMaxMetadataValueLength = 256
+
+	DefaultOwnershipTransferTimeout = 7 * 24 * 3600 // one week
+
+	DefaultHolderSnapshotFee = 100e8 // 100 * 10^8
+	DefaultAirdropBatchSize  = 1000
+
+	DefaultSymbolAuctionDuration = 3 * 24 * 3600  // three days
+	DefaultMinBidIncreaseRate    = 5              // percent
+	DefaultSymbolIssuePeriod     = 30 * 24 * 3600 // thirty days
 )
 
 // Parameter keys
@@ -27,6 +42,21 @@ var (
 	KeyIssue4CharTokenFee = []byte("Issue4CharTokenFee") // DEX2
 	KeyIssue5CharTokenFee = []byte("Issue5CharTokenFee") // DEX2
 	KeyIssue6CharTokenFee = []byte("Issue6CharTokenFee") // DEX2
+
+	KeyMaxMetadataEntries     = []byte("MaxMetadataEntries")
+	KeyMaxMetadataKeyLength   = []byte("MaxMetadataKeyLength")
+	KeyMaxMetadataValueLength = []byte("MaxMetadataValueLength")
+
+	KeyOwnershipTransferTimeout = []byte("OwnershipTransferTimeout")
+
+	KeyHolderSnapshotFee = []byte("HolderSnapshotFee")
+	KeyAirdropBatchSize  = []byte("AirdropBatchSize")
+
+	KeySymbolAuctionDuration = []byte("SymbolAuctionDuration")
+	KeyMinBidIncreaseRate    = []byte("MinBidIncreaseRate")
+	KeySymbolIssuePeriod     = []byte("SymbolIssuePeriod")
+
+	KeyFeePricing = []byte("FeePricing")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -40,6 +70,27 @@ type Params struct {
 	Issue4CharTokenFee int64 `json:"issue_4char_token_fee" yaml:"issue_4char_token_fee"` // 4 char
 	Issue5CharTokenFee int64 `json:"issue_5char_token_fee" yaml:"issue_5char_token_fee"` // 5 char
 	Issue6CharTokenFee int64 `json:"issue_6char_token_fee" yaml:"issue_6char_token_fee"` // 6 char
+
+	// the limits of token metadata
+	MaxMetadataEntries     int64 `json:"max_metadata_entries" yaml:"max_metadata_entries"`           // of social links or attributes
+	MaxMetadataKeyLength   int64 `json:"max_metadata_key_length" yaml:"max_metadata_key_length"`     // in unicode characters
+	MaxMetadataValueLength int64 `json:"max_metadata_value_length" yaml:"max_metadata_value_length"` // in unicode characters
+
+	// the seconds for the new owner to accept an ownership transfer
+	OwnershipTransferTimeout int64 `json:"ownership_transfer_timeout" yaml:"ownership_transfer_timeout"`
+
+	// the fee of taking a holder snapshot, and how many holders are paid by the airdrops in a block
+	HolderSnapshotFee int64 `json:"holder_snapshot_fee" yaml:"holder_snapshot_fee"`
+	AirdropBatchSize  int64 `json:"airdrop_batch_size" yaml:"airdrop_batch_size"`
+
+	// the seconds a symbol auction lasts since its first bid, the percent a bid must raise by,
+	// and the seconds the winner has to issue the symbol after the auction ends
+	SymbolAuctionDuration int64 `json:"symbol_auction_duration" yaml:"symbol_auction_duration"`
+	MinBidIncreaseRate    int64 `json:"min_bid_increase_rate" yaml:"min_bid_increase_rate"`
+	SymbolIssuePeriod     int64 `json:"symbol_issue_period" yaml:"symbol_issue_period"`
+
+	// the optional mode in which the issue token fees are priced in a reference token
+	FeePricing dex.FeePricing `json:"fee_pricing" yaml:"fee_pricing"`
 }
 
 // DefaultParams returns a default set of parameters.
@@ -51,6 +102,21 @@ func DefaultParams() Params {
 		Issue4CharTokenFee: DefaultIssue4CharTokenFee,
 		Issue5CharTokenFee: DefaultIssue5CharTokenFee,
 		Issue6CharTokenFee: DefaultIssue6CharTokenFee,
+
+		MaxMetadataEntries:     DefaultMaxMetadataEntries,
+		MaxMetadataKeyLength:   DefaultMaxMetadataKeyLength,
+		MaxMetadataValueLength: DefaultMaxMetadataValueLength,
+
+		OwnershipTransferTimeout: DefaultOwnershipTransferTimeout,
+
+		HolderSnapshotFee: DefaultHolderSnapshotFee,
+		AirdropBatchSize:  DefaultAirdropBatchSize,
+
+		SymbolAuctionDuration: DefaultSymbolAuctionDuration,
+		MinBidIncreaseRate:    DefaultMinBidIncreaseRate,
+		SymbolIssuePeriod:     DefaultSymbolIssuePeriod,
+
+		FeePricing: dex.DefaultFeePricing(),
 	}
 }
 
@@ -64,16 +130,29 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyIssue4CharTokenFee, Value: &p.Issue4CharTokenFee},
 		{Key: KeyIssue5CharTokenFee, Value: &p.Issue5CharTokenFee},
 		{Key: KeyIssue6CharTokenFee, Value: &p.Issue6CharTokenFee},
+		{Key: KeyMaxMetadataEntries, Value: &p.MaxMetadataEntries},
+		{Key: KeyMaxMetadataKeyLength, Value: &p.MaxMetadataKeyLength},
+		{Key: KeyMaxMetadataValueLength, Value: &p.MaxMetadataValueLength},
+		{Key: KeyOwnershipTransferTimeout, Value: &p.OwnershipTransferTimeout},
+		{Key: KeyHolderSnapshotFee, Value: &p.HolderSnapshotFee},
+		{Key: KeyAirdropBatchSize, Value: &p.AirdropBatchSize},
+		{Key: KeySymbolAuctionDuration, Value: &p.SymbolAuctionDuration},
+		{Key: KeyMinBidIncreaseRate, Value: &p.MinBidIncreaseRate},
+		{Key: KeySymbolIssuePeriod, Value: &p.SymbolIssuePeriod},
+		{Key: KeyFeePricing, Value: &p.FeePricing},
 	}
 }
 
 func (p *Params) ValidateGenesis() error {
 	for _, pair := range p.ParamSetPairs() {
-		fee := *(pair.Value.(*int64))
-		if fee <= 0 {
-			return fmt.Errorf("%s is invalid: %d", pair.Key, fee)
+		val, ok := pair.Value.(*int64)
+		if ok && *val <= 0 {
+			return fmt.Errorf("%s is invalid: %d", pair.Key, *val)
 		}
 	}
+	if err := p.FeePricing.Validate(); err != nil {
+		return fmt.Errorf("%s is invalid: %s", KeyFeePricing, err)
+	}
 	return nil
 }
 
@@ -94,6 +173,14 @@ func (p Params) GetIssueTokenFee(symbol string) int64 {
 	}
 }
 
+// GetSymbolAuctionMinBid returns the lowest first bid of a symbol auction
+func (p Params) GetSymbolAuctionMinBid(symbol string) int64 {
+	if IsReservedSymbol(symbol) {
+		return p.IssueRareTokenFee
+	}
+	return p.GetIssueTokenFee(symbol)
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
@@ -108,12 +195,32 @@ func (p Params) String() string {
   Issue3CharTokenFee: %d
   Issue4CharTokenFee: %d
   Issue5CharTokenFee: %d
-  Issue6CharTokenFee: %d`,
+  Issue6CharTokenFee: %d
+  MaxMetadataEntries:     %d
+  MaxMetadataKeyLength:   %d
+  MaxMetadataValueLength: %d
+  OwnershipTransferTimeout: %d
+  HolderSnapshotFee: %d
+  AirdropBatchSize:  %d
+  SymbolAuctionDuration: %d
+  MinBidIncreaseRate:    %d
+  SymbolIssuePeriod:     %d
+  FeePricing: %s`,
 		p.IssueTokenFee,
 		p.IssueRareTokenFee,
 		p.Issue3CharTokenFee,
 		p.Issue4CharTokenFee,
 		p.Issue5CharTokenFee,
 		p.Issue6CharTokenFee,
+		p.MaxMetadataEntries,
+		p.MaxMetadataKeyLength,
+		p.MaxMetadataValueLength,
+		p.OwnershipTransferTimeout,
+		p.HolderSnapshotFee,
+		p.AirdropBatchSize,
+		p.SymbolAuctionDuration,
+		p.MinBidIncreaseRate,
+		p.SymbolIssuePeriod,
+		p.FeePricing,
 	)
 }
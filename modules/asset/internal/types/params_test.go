@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -56,6 +58,24 @@ func TestParams_ValidateGenesis(t *testing.T) {
 			Params{}, // all zeros
 			true,
 		},
+		{
+			"case-feePricing",
+			func() Params {
+				p := DefaultParams()
+				p.FeePricing = dex.NewFeePricing("usdt", sdk.NewDecWithPrec(2, 2))
+				return p
+			}(),
+			false,
+		},
+		{
+			"case-invalidFeePricing",
+			func() Params {
+				p := DefaultParams()
+				p.FeePricing = dex.NewFeePricing("usdt", sdk.ZeroDec())
+				return p
+			}(),
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
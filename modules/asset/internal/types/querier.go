@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // query endpoints supported by the asset Querier
 const (
 	QueryToken           = "token-info"
@@ -8,9 +12,24 @@ const (
 	QueryForbiddenAddr   = "addr-forbidden"
 	QueryReservedSymbols = "reserved-symbols"
 	QueryParameters      = "parameters"
+	QueryVesting         = "vesting-schedule"
+	QueryVestings        = "vesting-schedules"
+	QueryTokenRoles      = "token-roles"
+	QueryApprovalPolicy  = "approval-policy"
+	QueryOperations      = "token-operations"
+	QueryPendingOwner    = "pending-ownership"
+	QueryHolderSnapshot  = "holder-snapshot"
+	QueryAirdrop         = "airdrop"
+	QuerySymbolAuction   = "symbol-auction"
+	QuerySymbolAuctions  = "symbol-auctions"
+	QueryDividendPools   = "dividend-pools"
+	QueryClaimable       = "claimable-dividends"
+	QueryAdminActions    = "admin-actions"
+	QueryTokenSearch     = "token-search"
 )
 
-// QueryTokenParams defines the params for query: "custom/asset/token-info"
+// QueryTokenParams defines the params for query: "custom/asset/token-info",
+// and also for the queries of token roles, approval policy and token operations
 type QueryTokenParams struct {
 	Symbol string
 }
@@ -42,3 +61,92 @@ func NewQueryForbiddenAddrParams(s string) QueryForbiddenAddrParams {
 		Symbol: s,
 	}
 }
+
+// QueryVestingParams defines the params for query: "custom/asset/vesting-schedule"
+type QueryVestingParams struct {
+	ID uint64
+}
+
+func NewQueryVestingParams(id uint64) QueryVestingParams {
+	return QueryVestingParams{
+		ID: id,
+	}
+}
+
+// QueryVestingsParams defines the params for query: "custom/asset/vesting-schedules"
+type QueryVestingsParams struct {
+	Beneficiary sdk.AccAddress
+}
+
+func NewQueryVestingsParams(beneficiary sdk.AccAddress) QueryVestingsParams {
+	return QueryVestingsParams{
+		Beneficiary: beneficiary,
+	}
+}
+
+// QueryHolderSnapshotParams defines the params for query: "custom/asset/holder-snapshot"
+type QueryHolderSnapshotParams struct {
+	ID uint64
+}
+
+func NewQueryHolderSnapshotParams(id uint64) QueryHolderSnapshotParams {
+	return QueryHolderSnapshotParams{
+		ID: id,
+	}
+}
+
+// QueryAirdropParams defines the params for query: "custom/asset/airdrop"
+type QueryAirdropParams struct {
+	ID uint64
+}
+
+func NewQueryAirdropParams(id uint64) QueryAirdropParams {
+	return QueryAirdropParams{
+		ID: id,
+	}
+}
+
+// QueryClaimableParams defines the params for query: "custom/asset/claimable-dividends"
+type QueryClaimableParams struct {
+	Symbol string
+	Holder sdk.AccAddress
+}
+
+func NewQueryClaimableParams(symbol string, holder sdk.AccAddress) QueryClaimableParams {
+	return QueryClaimableParams{
+		Symbol: symbol,
+		Holder: holder,
+	}
+}
+
+// QueryAdminActionsParams defines the params for query: "custom/asset/admin-actions",
+// in which Page starts from 1 and Limit defaults to DefaultAdminActionsLimit if it is 0
+type QueryAdminActionsParams struct {
+	Symbol string
+	Page   int
+	Limit  int
+}
+
+func NewQueryAdminActionsParams(symbol string, page, limit int) QueryAdminActionsParams {
+	return QueryAdminActionsParams{
+		Symbol: symbol,
+		Page:   page,
+		Limit:  limit,
+	}
+}
+
+// QueryTokenSearchParams defines the params for query: "custom/asset/token-search",
+// in which Page starts from 1 and Limit defaults to DefaultTokenSearchLimit if it is 0
+type QueryTokenSearchParams struct {
+	Filter TokenFilter
+	Page   int
+	Limit  int
+}
+
+func NewQueryTokenSearchParams(filter TokenFilter, page, limit int) QueryTokenSearchParams {
+	return QueryTokenSearchParams{
+		Filter: filter,
+		Page:   page,
+		Limit:  limit,
+	}
+}
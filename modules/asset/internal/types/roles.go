@@ -0,0 +1,193 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The roles which can be granted by the token owner, who always has all of them
+const (
+	RoleMinter        = "minter"         // mints the token
+	RoleBurner        = "burner"         // burns the token
+	RoleFreezer       = "freezer"        // forbids the token or addresses and manages the whitelist
+	RoleMetadataAdmin = "metadata_admin" // modifies the url, description, identity and metadata
+
+	MaxApprovers = 16
+)
+
+// The operations which need approvals when the token has an approval policy
+const (
+	OperationMint              = "mint"
+	OperationTransferOwnership = "transfer_ownership"
+	OperationSetApprovalPolicy = "set_approval_policy"
+)
+
+func ValidateTokenRole(role string) sdk.Error {
+	switch role {
+	case RoleMinter, RoleBurner, RoleFreezer, RoleMetadataAdmin:
+		return nil
+	default:
+		return ErrInvalidTokenRole("unknown role " + role)
+	}
+}
+
+// TokenRole - Address has Role on the token of Symbol
+type TokenRole struct {
+	Symbol  string         `json:"symbol" yaml:"symbol"`
+	Role    string         `json:"role" yaml:"role"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func (r TokenRole) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(r.Symbol); err != nil {
+		return err
+	}
+	if r.Address.Empty() {
+		return ErrInvalidTokenRole("missing address")
+	}
+	return ValidateTokenRole(r.Role)
+}
+
+// ApprovalPolicy - the mints and ownership transfers of the token need Threshold approvals from Approvers
+type ApprovalPolicy struct {
+	Symbol    string           `json:"symbol" yaml:"symbol"`
+	Approvers []sdk.AccAddress `json:"approvers" yaml:"approvers"`
+	Threshold int64            `json:"threshold" yaml:"threshold"`
+}
+
+func (p ApprovalPolicy) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(p.Symbol); err != nil {
+		return err
+	}
+	if len(p.Approvers) > MaxApprovers {
+		return ErrInvalidApprovalPolicy("too many approvers")
+	}
+	if p.Threshold <= 0 || p.Threshold > int64(len(p.Approvers)) {
+		return ErrInvalidApprovalPolicy("the threshold must be in [1, number of approvers]")
+	}
+	for i, addr := range p.Approvers {
+		if addr.Empty() {
+			return ErrInvalidApprovalPolicy("empty approver")
+		}
+		for _, other := range p.Approvers[:i] {
+			if addr.Equals(other) {
+				return ErrInvalidApprovalPolicy("duplicated approver " + addr.String())
+			}
+		}
+	}
+	return nil
+}
+
+func (p ApprovalPolicy) IsApprover(addr sdk.AccAddress) bool {
+	for _, approver := range p.Approvers {
+		if approver.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns how many of the approvals are from the approvers of the policy
+func (p ApprovalPolicy) CountApprovals(approvals []sdk.AccAddress) int64 {
+	count := int64(0)
+	for _, addr := range approvals {
+		if p.IsApprover(addr) {
+			count++
+		}
+	}
+	return count
+}
+
+// TokenOperation - a mint, an ownership transfer or a change of the approval policy waiting for approvals
+type TokenOperation struct {
+	ID           uint64           `json:"id" yaml:"id"`
+	Symbol       string           `json:"symbol" yaml:"symbol"`
+	Type         string           `json:"type" yaml:"type"`
+	Proposer     sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+	Amount       sdk.Int          `json:"amount" yaml:"amount"`                           // of OperationMint
+	NewOwner     sdk.AccAddress   `json:"new_owner" yaml:"new_owner"`                     // of OperationTransferOwnership
+	Approvers    []sdk.AccAddress `json:"approvers,omitempty" yaml:"approvers,omitempty"` // of OperationSetApprovalPolicy
+	Threshold    int64            `json:"threshold,omitempty" yaml:"threshold,omitempty"` // of OperationSetApprovalPolicy
+	Approvals    []sdk.AccAddress `json:"approvals" yaml:"approvals"`
+	CreateHeight int64            `json:"create_height" yaml:"create_height"`
+}
+
+func NewMintOperation(symbol string, proposer sdk.AccAddress, amount sdk.Int) TokenOperation {
+	return TokenOperation{
+		Symbol:   symbol,
+		Type:     OperationMint,
+		Proposer: proposer,
+		Amount:   amount,
+	}
+}
+
+func NewTransferOwnershipOperation(symbol string, proposer, newOwner sdk.AccAddress) TokenOperation {
+	return TokenOperation{
+		Symbol:   symbol,
+		Type:     OperationTransferOwnership,
+		Proposer: proposer,
+		Amount:   sdk.ZeroInt(),
+		NewOwner: newOwner,
+	}
+}
+
+// NewSetApprovalPolicyOperation changes the approval policy, and a zero threshold without approvers removes it
+func NewSetApprovalPolicyOperation(symbol string, proposer sdk.AccAddress, approvers []sdk.AccAddress, threshold int64) TokenOperation {
+	return TokenOperation{
+		Symbol:    symbol,
+		Type:      OperationSetApprovalPolicy,
+		Proposer:  proposer,
+		Amount:    sdk.ZeroInt(),
+		Approvers: approvers,
+		Threshold: threshold,
+	}
+}
+
+func (op TokenOperation) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(op.Symbol); err != nil {
+		return err
+	}
+	if op.Proposer.Empty() {
+		return ErrInvalidApproval("missing proposer")
+	}
+	switch op.Type {
+	case OperationMint:
+		if !op.Amount.IsPositive() {
+			return ErrInvalidTokenMintAmt(op.Amount.String())
+		}
+	case OperationTransferOwnership:
+		if op.NewOwner.Empty() {
+			return ErrNilTokenOwner()
+		}
+	case OperationSetApprovalPolicy:
+		if op.RemovesPolicy() {
+			return nil
+		}
+		return op.Policy().Validate()
+	default:
+		return ErrInvalidApproval("unknown operation " + op.Type)
+	}
+	return nil
+}
+
+// Policy returns the approval policy set by OperationSetApprovalPolicy
+func (op TokenOperation) Policy() ApprovalPolicy {
+	return ApprovalPolicy{
+		Symbol:    op.Symbol,
+		Approvers: op.Approvers,
+		Threshold: op.Threshold,
+	}
+}
+
+// RemovesPolicy returns whether OperationSetApprovalPolicy removes the approval policy
+func (op TokenOperation) RemovesPolicy() bool {
+	return op.Threshold == 0 && len(op.Approvers) == 0
+}
+
+func (op TokenOperation) HasApproved(addr sdk.AccAddress) bool {
+	for _, approver := range op.Approvals {
+		if approver.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
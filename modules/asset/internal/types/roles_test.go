@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRole_Validate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	require.Nil(t, TokenRole{Symbol: "abc", Role: RoleMinter, Address: addr}.Validate())
+	require.Nil(t, TokenRole{Symbol: "abc", Role: RoleMetadataAdmin, Address: addr}.Validate())
+	require.NotNil(t, TokenRole{Symbol: "abc", Role: "owner", Address: addr}.Validate())
+	require.NotNil(t, TokenRole{Symbol: "abc", Role: RoleBurner}.Validate())
+	require.NotNil(t, TokenRole{Symbol: "A😃", Role: RoleBurner, Address: addr}.Validate())
+}
+
+func TestApprovalPolicy(t *testing.T) {
+	a, b, c := sdk.AccAddress([]byte("a")), sdk.AccAddress([]byte("b")), sdk.AccAddress([]byte("c"))
+	policy := ApprovalPolicy{Symbol: "abc", Approvers: []sdk.AccAddress{a, b}, Threshold: 2}
+	require.Nil(t, policy.Validate())
+	require.True(t, policy.IsApprover(a))
+	require.False(t, policy.IsApprover(c))
+	require.Equal(t, int64(1), policy.CountApprovals([]sdk.AccAddress{a, c}))
+	require.Equal(t, int64(2), policy.CountApprovals([]sdk.AccAddress{b, c, a}))
+
+	invalid := policy
+	invalid.Threshold = 3
+	require.NotNil(t, invalid.Validate())
+	invalid.Threshold = 0
+	require.NotNil(t, invalid.Validate())
+	invalid = policy
+	invalid.Approvers = []sdk.AccAddress{a, a}
+	require.NotNil(t, invalid.Validate())
+	invalid.Approvers = make([]sdk.AccAddress, MaxApprovers+1)
+	for i := range invalid.Approvers {
+		invalid.Approvers[i] = sdk.AccAddress([]byte{byte(i + 1)})
+	}
+	require.NotNil(t, invalid.Validate())
+}
+
+func TestTokenOperation_Validate(t *testing.T) {
+	a, b := sdk.AccAddress([]byte("a")), sdk.AccAddress([]byte("b"))
+	require.Nil(t, NewMintOperation("abc", a, sdk.NewInt(1)).Validate())
+	require.NotNil(t, NewMintOperation("abc", a, sdk.ZeroInt()).Validate())
+	require.Nil(t, NewTransferOwnershipOperation("abc", a, b).Validate())
+	require.NotNil(t, NewTransferOwnershipOperation("abc", a, nil).Validate())
+	require.NotNil(t, NewTransferOwnershipOperation("abc", nil, b).Validate())
+
+	op := NewMintOperation("abc", a, sdk.NewInt(1))
+	op.Approvals = []sdk.AccAddress{a}
+	require.True(t, op.HasApproved(a))
+	require.False(t, op.HasApproved(b))
+}
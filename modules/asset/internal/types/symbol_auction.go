@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// MaxAuctionSymbolLength - the symbols not longer than it can be auctioned, besides the reserved ones
+const MaxAuctionSymbolLength = 3
+
+// SymbolAuction is an English auction of a short or reserved symbol, in which the bids are in CET
+// and the highest one is frozen in the account of its bidder. After EndTime the auction is settled:
+// the highest bid is donated to the community pool and only the winner can issue the symbol,
+// until IssueDeadline, after which the auction is removed and the symbol is freed.
+type SymbolAuction struct {
+	Symbol        string         `json:"symbol"`
+	HighestBidder sdk.AccAddress `json:"highest_bidder"`
+	HighestBid    sdk.Int        `json:"highest_bid"`
+	BidCount      int64          `json:"bid_count"`
+	StartTime     int64          `json:"start_time"`
+	EndTime       int64          `json:"end_time"`
+	Settled       bool           `json:"settled"`
+	IssueDeadline int64          `json:"issue_deadline"`
+}
+
+func (a SymbolAuction) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(a.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionableSymbol(a.Symbol) {
+		return ErrInvalidSymbolAuction("the symbol can not be auctioned")
+	}
+	if a.HighestBidder.Empty() || !a.HighestBid.IsPositive() || a.BidCount <= 0 {
+		return ErrInvalidSymbolAuction("missing the highest bid")
+	}
+	if a.EndTime < a.StartTime {
+		return ErrInvalidSymbolAuction("the auction ends before it starts")
+	}
+	if a.Settled && a.IssueDeadline < a.EndTime {
+		return ErrInvalidSymbolAuction("the issue deadline is before the auction ends")
+	}
+	return nil
+}
+
+// MinNextBid returns the lowest bid which can outbid the highest one
+func (a SymbolAuction) MinNextBid(increaseRate int64) sdk.Int {
+	increase := a.HighestBid.MulRaw(increaseRate).QuoRaw(100)
+	if !increase.IsPositive() {
+		increase = sdk.OneInt()
+	}
+	return a.HighestBid.Add(increase)
+}
+
+// QueueTime returns the time of the auction in the queue, which is when it is settled,
+// or when it is removed if the symbol is not issued after it is settled
+func (a SymbolAuction) QueueTime() int64 {
+	if a.Settled {
+		return a.IssueDeadline
+	}
+	return a.EndTime
+}
+
+// HighestBidCoins returns the highest bid as CET coins
+func (a SymbolAuction) HighestBidCoins() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(dex.CET, a.HighestBid))
+}
+
+// IsAuctionableSymbol returns whether a symbol is short or reserved, and can be auctioned
+func IsAuctionableSymbol(symbol string) bool {
+	if symbol == dex.CET || IsSuffixSymbol(symbol) {
+		return false
+	}
+	return len(symbol) <= MaxAuctionSymbolLength || IsReservedSymbol(symbol)
+}
+
+// SymbolAuctionSettleInfo is sent to the msg queue when a symbol auction is settled
+type SymbolAuctionSettleInfo struct {
+	Symbol   string         `json:"symbol"`
+	Winner   sdk.AccAddress `json:"winner"`
+	Price    sdk.Int        `json:"price"`
+	BidCount int64          `json:"bid_count"`
+	Height   int64          `json:"height"`
+}
@@ -67,6 +67,22 @@ type Token interface {
 	GetIdentity() string
 	SetIdentity(string) sdk.Error
 
+	GetMetadata() TokenMetadata
+	SetMetadata(TokenMetadata) sdk.Error
+
+	GetMintPolicy() *MintPolicy
+	SetMintPolicy(*MintPolicy) sdk.Error
+	GetMintState() *MintState
+	SetMintState(*MintState) sdk.Error
+
+	GetTransferFee() *TransferFeePolicy
+	SetTransferFee(*TransferFeePolicy) sdk.Error
+
+	GetPermissioned() bool
+	SetPermissioned(bool)
+	GetClawbackable() bool
+	SetClawbackable(bool)
+
 	Validate() sdk.Error
 	// Ensure that token implements stringer
 	String() string
@@ -92,6 +108,12 @@ type BaseToken struct {
 	URL              string         `json:"url" yaml:"url"`                             //URL of token website
 	Description      string         `json:"description" yaml:"description"`             //Description of token info
 	Identity         string         `json:"identity" yaml:"identity"`                   //Identity of token
+	Metadata         TokenMetadata  `json:"metadata" yaml:"metadata"`                   // Decimals, logo and other display info
+	MintPolicy       *MintPolicy    `json:"mint_policy,omitempty" yaml:"mint_policy"`   // The limits on minting, nil for no limit
+	MintState        *MintState     `json:"mint_state,omitempty" yaml:"mint_state"`     // The progress of the mint policy, maintained by the keeper
+	TransferFee      *TransferFeePolicy `json:"transfer_fee,omitempty" yaml:"transfer_fee"` // The fee charged from transfers, nil for no fee
+	Permissioned     bool           `json:"permissioned,omitempty" yaml:"permissioned"` // Whether only the owner and the whitelisted addresses could receive this token
+	Clawbackable     bool           `json:"clawbackable,omitempty" yaml:"clawbackable"` // Whether the owner could claw back the token from its holders
 }
 
 //nolint
@@ -180,7 +202,28 @@ func (t *BaseToken) Validate() sdk.Error {
 		return ErrInvalidSendLockAmt(t.SendLock.String())
 	}
 
-	return nil
+	if t.MintPolicy != nil {
+		if !t.Mintable {
+			return ErrTokenMintNotSupported(t.Symbol)
+		}
+		if err := t.MintPolicy.Validate(t.TotalSupply); err != nil {
+			return err
+		}
+		if t.MintState == nil {
+			return ErrInvalidMintPolicy("missing mint state")
+		}
+		if err := t.MintState.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if t.TransferFee != nil {
+		if err := t.TransferFee.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return t.Metadata.ValidateBasic()
 }
 
 func (t *BaseToken) GetName() string {
@@ -328,6 +371,79 @@ func (t *BaseToken) SetIdentity(identity string) sdk.Error {
 	return nil
 }
 
+func (t BaseToken) GetMetadata() TokenMetadata {
+	return t.Metadata
+}
+
+func (t *BaseToken) SetMetadata(metadata TokenMetadata) sdk.Error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+	t.Metadata = metadata
+	return nil
+}
+
+func (t BaseToken) GetMintPolicy() *MintPolicy {
+	return t.MintPolicy
+}
+
+func (t *BaseToken) SetMintPolicy(policy *MintPolicy) sdk.Error {
+	if policy != nil {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	t.MintPolicy = policy
+	if policy == nil {
+		t.MintState = nil
+	}
+	return nil
+}
+
+func (t BaseToken) GetMintState() *MintState {
+	return t.MintState
+}
+
+func (t *BaseToken) SetMintState(state *MintState) sdk.Error {
+	if state != nil {
+		if err := state.Validate(); err != nil {
+			return err
+		}
+	}
+	t.MintState = state
+	return nil
+}
+
+func (t BaseToken) GetTransferFee() *TransferFeePolicy {
+	return t.TransferFee
+}
+
+func (t *BaseToken) SetTransferFee(policy *TransferFeePolicy) sdk.Error {
+	if policy != nil {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	t.TransferFee = policy
+	return nil
+}
+
+func (t BaseToken) GetPermissioned() bool {
+	return t.Permissioned
+}
+
+func (t *BaseToken) SetPermissioned(enable bool) {
+	t.Permissioned = enable
+}
+
+func (t BaseToken) GetClawbackable() bool {
+	return t.Clawbackable
+}
+
+func (t *BaseToken) SetClawbackable(enable bool) {
+	t.Clawbackable = enable
+}
+
 func (t BaseToken) GetTotalBurn() sdk.Int {
 	return t.TotalBurn
 }
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultTokenSearchLimit is the page size of the token searches if no limit is given,
+	// and MaxTokenSearchLimit is the largest one allowed
+	DefaultTokenSearchLimit = 30
+	MaxTokenSearchLimit     = 100
+
+	MaxTokenSearchQueryLength = 64
+)
+
+// the flags of the tokens indexed for searches
+const (
+	TokenFlagMintable  byte = 0x01
+	TokenFlagForbidden byte = 0x02
+)
+
+// TokenFilter selects the tokens of a search, which must match all the fields set. The symbol of a
+// token must start with Symbol, and each word of Query must start a word of its name or description,
+// ignoring case.
+type TokenFilter struct {
+	Symbol    string         `json:"symbol,omitempty"`
+	Query     string         `json:"query,omitempty"`
+	Owner     sdk.AccAddress `json:"owner,omitempty"`
+	Mintable  *bool          `json:"mintable,omitempty"`
+	Forbidden *bool          `json:"forbidden,omitempty"`
+}
+
+func (f TokenFilter) Validate() sdk.Error {
+	if len(f.Query) > MaxTokenSearchQueryLength || len(f.Symbol) > MaxTokenSearchQueryLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("the query is limited to %d bytes", MaxTokenSearchQueryLength))
+	}
+	if f.Query != "" && len(TokenSearchWords(f.Query)) == 0 {
+		return sdk.ErrUnknownRequest("the query has no words to search")
+	}
+	return nil
+}
+
+// TokenSearchWords splits the text into the distinct words of letters and digits, in lower case,
+// which are the keys of the token searches by name and description
+func TokenSearchWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	words := make([]string, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	for _, w := range fields {
+		if !seen[w] {
+			seen[w] = true
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// LongestQueryWord returns the longest word of the query, which selects the fewest tokens in the word index
+func (f TokenFilter) LongestQueryWord() string {
+	longest := ""
+	for _, w := range TokenSearchWords(f.Query) {
+		if len(w) > len(longest) {
+			longest = w
+		}
+	}
+	return longest
+}
+
+// SymbolPrefix returns the prefix of the symbols selected by the filter, in lower case as the symbols
+func (f TokenFilter) SymbolPrefix() string {
+	return strings.ToLower(f.Symbol)
+}
+
+// Match returns whether the token is selected by the filter
+func (f TokenFilter) Match(token Token) bool {
+	if !f.Owner.Empty() && !f.Owner.Equals(token.GetOwner()) {
+		return false
+	}
+	if f.Mintable != nil && *f.Mintable != token.GetMintable() {
+		return false
+	}
+	if f.Forbidden != nil && *f.Forbidden != token.GetIsForbidden() {
+		return false
+	}
+	if !strings.HasPrefix(token.GetSymbol(), f.SymbolPrefix()) {
+		return false
+	}
+	words := TokenSearchWords(token.GetName() + " " + token.GetDescription())
+	for _, q := range TokenSearchWords(f.Query) {
+		if !startsAnyWord(words, q) {
+			return false
+		}
+	}
+	return true
+}
+
+func startsAnyWord(words []string, prefix string) bool {
+	for _, w := range words {
+		if strings.HasPrefix(w, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -38,6 +38,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				TokenMetadata{},
+				nil,
+				nil,
+				nil,
+				false,
+				false,
 			},
 			nil,
 		},
@@ -59,6 +65,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				TokenMetadata{},
+				nil,
+				nil,
+				nil,
+				false,
+				false,
 			},
 			ErrTokenMintNotSupported("abc"),
 		},
@@ -80,6 +92,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				TokenMetadata{},
+				nil,
+				nil,
+				nil,
+				false,
+				false,
 			},
 			ErrTokenBurnNotSupported("abc"),
 		},
@@ -101,6 +119,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				TokenMetadata{},
+				nil,
+				nil,
+				nil,
+				false,
+				false,
 			},
 			ErrTokenForbiddenNotSupported("abc"),
 		},
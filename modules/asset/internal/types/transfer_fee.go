@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TransferFeeRateBase is the denominator of TransferFeePolicy.Rate, which is in basis points
+	TransferFeeRateBase = 10000
+	// MaxTransferFeeRate limits the transfer fee to 10% of the amount
+	MaxTransferFeeRate = 1000
+)
+
+// TransferFeePolicy - a percentage of every transfer of the token, which is sent to Receiver,
+// or burnt if Receiver is empty. A zero Cap means the fee of a transfer is not capped.
+// The fee is always deducted from the amount sent, so the recipient gets the amount less the fee,
+// on every send path: MsgSend, MsgMultiSend, MsgSendLocked and MsgSupervisedSend.
+type TransferFeePolicy struct {
+	Rate     int64          `json:"rate" yaml:"rate"` // basis points
+	Cap      sdk.Int        `json:"cap" yaml:"cap"`
+	Receiver sdk.AccAddress `json:"receiver,omitempty" yaml:"receiver"`
+}
+
+func NewTransferFeePolicy(rate int64, cap sdk.Int, receiver sdk.AccAddress) TransferFeePolicy {
+	return TransferFeePolicy{
+		Rate:     rate,
+		Cap:      cap,
+		Receiver: receiver,
+	}
+}
+
+// ValidateBasic checks the rate and the cap of the policy
+func (p TransferFeePolicy) ValidateBasic() sdk.Error {
+	if p.Rate <= 0 || p.Rate > MaxTransferFeeRate {
+		return ErrInvalidTransferFee("the rate must be between 1 and 1000 basis points")
+	}
+	if p.Cap == (sdk.Int{}) || p.Cap.IsNegative() {
+		return ErrInvalidTransferFee("the cap must not be negative")
+	}
+	return nil
+}
+
+// IsBurning returns whether the fees are burnt
+func (p TransferFeePolicy) IsBurning() bool {
+	return p.Receiver.Empty()
+}
+
+// FeeOf returns the fee charged from a transfer of amount, which is rounded down
+func (p TransferFeePolicy) FeeOf(amount sdk.Int) sdk.Int {
+	fee := amount.MulRaw(p.Rate).QuoRaw(TransferFeeRateBase)
+	if p.Cap.IsPositive() && fee.GT(p.Cap) {
+		return p.Cap
+	}
+	return fee
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingRetryInterval is the seconds after which the vested coins held for a beneficiary, who is not
+// allowed to receive the permissioned token, are retried once the last release time has passed
+const VestingRetryInterval int64 = 24 * 60 * 60
+
+// VestingSchedule releases Total of Symbol, escrowed in the asset module account, to Beneficiary.
+// Nothing is released before CliffTime, after which the vested amount grows linearly from StartTime
+// to EndTime, and is released every Period seconds. A linear schedule has its CliffTime at StartTime.
+type VestingSchedule struct {
+	ID          uint64         `json:"id"`
+	Symbol      string         `json:"symbol"`
+	Creator     sdk.AccAddress `json:"creator"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Total       sdk.Int        `json:"total"`
+	Released    sdk.Int        `json:"released"`
+	StartTime   int64          `json:"start_time"`
+	CliffTime   int64          `json:"cliff_time"`
+	EndTime     int64          `json:"end_time"`
+	Period      int64          `json:"period"`
+	// whether the creator can take back the coins which are not vested yet
+	Revocable bool `json:"revocable"`
+	// the time of the next release, at which the schedule is in the release queue
+	NextReleaseTime int64 `json:"next_release_time"`
+}
+
+func (vs VestingSchedule) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(vs.Symbol); err != nil {
+		return err
+	}
+	if vs.Creator.Empty() || vs.Beneficiary.Empty() {
+		return ErrInvalidVestingSchedule("missing creator or beneficiary")
+	}
+	if !vs.Total.IsPositive() {
+		return ErrInvalidVestingSchedule("the amount must be positive")
+	}
+	if vs.StartTime < 0 || vs.CliffTime < vs.StartTime || vs.EndTime <= vs.StartTime || vs.EndTime < vs.CliffTime {
+		return ErrInvalidVestingSchedule("the times must be start <= cliff <= end and start < end")
+	}
+	if vs.Period <= 0 {
+		return ErrInvalidVestingSchedule("the period must be positive")
+	}
+	if vs.Released.IsNegative() || vs.Released.GT(vs.Total) {
+		return ErrInvalidVestingSchedule("the released amount is out of range")
+	}
+	return nil
+}
+
+// lastReleaseTime returns the latest release time not after 'now', and false if there is none yet
+func (vs VestingSchedule) lastReleaseTime(now int64) (int64, bool) {
+	if now < vs.CliffTime {
+		return 0, false
+	}
+	if now >= vs.EndTime {
+		return vs.EndTime, true
+	}
+	return vs.CliffTime + (now-vs.CliffTime)/vs.Period*vs.Period, true
+}
+
+// VestedAmount returns the amount which can be released at 'now'
+func (vs VestingSchedule) VestedAmount(now int64) sdk.Int {
+	t, ok := vs.lastReleaseTime(now)
+	if !ok {
+		return sdk.ZeroInt()
+	}
+	return vs.Total.MulRaw(t - vs.StartTime).QuoRaw(vs.EndTime - vs.StartTime)
+}
+
+// FirstReleaseTime returns the time of the first release with a positive amount
+func (vs VestingSchedule) FirstReleaseTime() int64 {
+	if vs.CliffTime > vs.StartTime {
+		return vs.CliffTime
+	}
+	t, _ := vs.ReleaseTimeAfter(vs.StartTime)
+	return t
+}
+
+// ReleaseTimeAfter returns the first release time after 'now', and false if all has been vested
+func (vs VestingSchedule) ReleaseTimeAfter(now int64) (int64, bool) {
+	if now < vs.CliffTime {
+		return vs.CliffTime, true
+	}
+	t, _ := vs.lastReleaseTime(now)
+	if t >= vs.EndTime {
+		return 0, false
+	}
+	if t+vs.Period > vs.EndTime {
+		return vs.EndTime, true
+	}
+	return t + vs.Period, true
+}
+
+// VestingReleaseInfo is sent to the msg queue when coins are released from a schedule
+type VestingReleaseInfo struct {
+	ID          uint64         `json:"id"`
+	Symbol      string         `json:"symbol"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Amount      sdk.Int        `json:"amount"`
+	Released    sdk.Int        `json:"released"`
+	Total       sdk.Int        `json:"total"`
+	Height      int64          `json:"height"`
+}
+
+// VestingRevokeInfo is sent to the msg queue when a schedule is revoked
+type VestingRevokeInfo struct {
+	ID          uint64         `json:"id"`
+	Symbol      string         `json:"symbol"`
+	Creator     sdk.AccAddress `json:"creator"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Released    sdk.Int        `json:"released"`
+	Returned    sdk.Int        `json:"returned"`
+	Height      int64          `json:"height"`
+}
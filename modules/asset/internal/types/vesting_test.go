@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingSchedule_Validate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	vs := VestingSchedule{Symbol: "abc", Creator: addr, Beneficiary: addr, Total: sdk.NewInt(100),
+		Released: sdk.ZeroInt(), StartTime: 10, CliffTime: 10, EndTime: 20, Period: 1}
+	require.Nil(t, vs.Validate())
+
+	invalid := vs
+	invalid.Total = sdk.ZeroInt()
+	require.NotNil(t, invalid.Validate())
+	invalid = vs
+	invalid.CliffTime = 5
+	require.NotNil(t, invalid.Validate())
+	invalid = vs
+	invalid.CliffTime = 25
+	require.NotNil(t, invalid.Validate())
+	invalid = vs
+	invalid.EndTime = 10
+	require.NotNil(t, invalid.Validate())
+	invalid = vs
+	invalid.Period = 0
+	require.NotNil(t, invalid.Validate())
+	invalid = vs
+	invalid.Released = sdk.NewInt(101)
+	require.NotNil(t, invalid.Validate())
+	invalid = vs
+	invalid.Beneficiary = nil
+	require.NotNil(t, invalid.Validate())
+}
+
+func TestVestingSchedule_Linear(t *testing.T) {
+	vs := VestingSchedule{Total: sdk.NewInt(1000), StartTime: 100, CliffTime: 100, EndTime: 200, Period: 30}
+
+	require.Equal(t, int64(130), vs.FirstReleaseTime())
+	require.Equal(t, sdk.ZeroInt(), vs.VestedAmount(99))
+	require.Equal(t, sdk.ZeroInt(), vs.VestedAmount(129))
+	require.Equal(t, sdk.NewInt(300), vs.VestedAmount(130))
+	require.Equal(t, sdk.NewInt(600), vs.VestedAmount(189))
+	require.Equal(t, sdk.NewInt(900), vs.VestedAmount(199))
+	require.Equal(t, sdk.NewInt(1000), vs.VestedAmount(200))
+
+	next, ok := vs.ReleaseTimeAfter(160)
+	require.True(t, ok)
+	require.Equal(t, int64(190), next)
+	next, ok = vs.ReleaseTimeAfter(190)
+	require.True(t, ok)
+	require.Equal(t, int64(200), next)
+	_, ok = vs.ReleaseTimeAfter(200)
+	require.False(t, ok)
+}
+
+func TestVestingSchedule_Cliff(t *testing.T) {
+	vs := VestingSchedule{Total: sdk.NewInt(1000), StartTime: 100, CliffTime: 150, EndTime: 200, Period: 20}
+
+	require.Equal(t, int64(150), vs.FirstReleaseTime())
+	require.Equal(t, sdk.ZeroInt(), vs.VestedAmount(149))
+	require.Equal(t, sdk.NewInt(500), vs.VestedAmount(150))
+	require.Equal(t, sdk.NewInt(500), vs.VestedAmount(169))
+	require.Equal(t, sdk.NewInt(700), vs.VestedAmount(170))
+
+	next, ok := vs.ReleaseTimeAfter(120)
+	require.True(t, ok)
+	require.Equal(t, int64(150), next)
+	next, ok = vs.ReleaseTimeAfter(150)
+	require.True(t, ok)
+	require.Equal(t, int64(170), next)
+}
@@ -0,0 +1,112 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+)
+
+func Test_MintPolicy_HardCapAndPeriodLimit(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// the hard cap can not be below the total supply
+	policy := asset.NewMintPolicy(sdk.NewInt(2000), 0, sdk.ZeroInt(), sdk.ZeroInt(), 0, nil)
+	res := h(ctx, asset.NewMsgSetMintPolicy("abc", testAddr, policy))
+	require.False(t, res.IsOK())
+
+	// at most 300 in 100 seconds, and 3000 in total
+	policy = asset.NewMintPolicy(sdk.NewInt(3000), 100, sdk.NewInt(300), sdk.ZeroInt(), 0, nil)
+	res = h(ctx, asset.NewMsgSetMintPolicy("abc", mockAddrList()[0], policy))
+	require.False(t, res.IsOK())
+	res = h(ctx, asset.NewMsgSetMintPolicy("abc", testAddr, policy))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the policy can not be changed once set
+	res = h(ctx, asset.NewMsgSetMintPolicy("abc", testAddr, policy))
+	require.False(t, res.IsOK())
+
+	res = h(ctx, asset.NewMsgMintToken("abc", sdk.NewInt(200), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, asset.NewMsgMintToken("abc", sdk.NewInt(101), testAddr))
+	require.False(t, res.IsOK())
+	res = h(ctx.WithBlockTime(time.Unix(1099, 0)), asset.NewMsgMintToken("abc", sdk.NewInt(100), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+
+	// a new period starts
+	ctx = ctx.WithBlockTime(time.Unix(1250, 0))
+	res = h(ctx, asset.NewMsgMintToken("abc", sdk.NewInt(300), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1200), input.tk.GetToken(ctx, "abc").GetMintState().PeriodStart)
+
+	// the hard cap is reached at 3000
+	ctx = ctx.WithBlockTime(time.Unix(1300, 0))
+	require.Equal(t, sdk.NewInt(2700), input.tk.GetToken(ctx, "abc").GetTotalSupply())
+	res = h(ctx, asset.NewMsgMintToken("abc", sdk.NewInt(300), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	ctx = ctx.WithBlockTime(time.Unix(1400, 0))
+	res = h(ctx, asset.NewMsgMintToken("abc", sdk.NewInt(1), testAddr))
+	require.False(t, res.IsOK())
+}
+
+func Test_MintPolicy_Inflation(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	recipient := mockAddrList()[0]
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// 100 every 50 seconds, capped at 2500
+	policy := asset.NewMintPolicy(sdk.NewInt(2500), 0, sdk.ZeroInt(), sdk.NewInt(100), 50, recipient)
+	res := h(ctx, asset.NewMsgSetMintPolicy("abc", testAddr, policy))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1050), input.tk.GetToken(ctx, "abc").GetMintState().NextInflationTime)
+
+	asset.BeginBlocker(ctx.WithBlockTime(time.Unix(1049, 0)), input.tk)
+	require.True(t, input.tk.GetAccTotalToken(ctx, recipient).AmountOf("abc").IsZero())
+
+	asset.BeginBlocker(ctx.WithBlockTime(time.Unix(1050, 0)), input.tk)
+	require.Equal(t, sdk.NewInt(100), input.tk.GetAccTotalToken(ctx, recipient).AmountOf("abc"))
+	require.Equal(t, sdk.NewInt(2200), input.tk.GetToken(ctx, "abc").GetTotalSupply())
+
+	// the skipped periods are caught up at once
+	asset.BeginBlocker(ctx.WithBlockTime(time.Unix(1160, 0)), input.tk)
+	require.Equal(t, sdk.NewInt(300), input.tk.GetAccTotalToken(ctx, recipient).AmountOf("abc"))
+	require.Equal(t, int64(1200), input.tk.GetToken(ctx, "abc").GetMintState().NextInflationTime)
+
+	// the inflation stops at the hard cap
+	asset.BeginBlocker(ctx.WithBlockTime(time.Unix(1500, 0)), input.tk)
+	require.Equal(t, sdk.NewInt(2500), input.tk.GetToken(ctx, "abc").GetTotalSupply())
+	require.Equal(t, sdk.NewInt(400), input.tk.GetAccTotalToken(ctx, recipient).AmountOf("abc"))
+	asset.BeginBlocker(ctx.WithBlockTime(time.Unix(2000, 0)), input.tk)
+	require.Equal(t, sdk.NewInt(2500), input.tk.GetToken(ctx, "abc").GetTotalSupply())
+}
+
+func Test_MintPolicy_InflationBoundedByMaxAmount(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	recipient := mockAddrList()[0]
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	policy := asset.NewMintPolicy(sdk.ZeroInt(), 0, sdk.ZeroInt(), asset.MaxMintAmount.SubRaw(1000), 50, recipient)
+	res := h(ctx, asset.NewMsgSetMintPolicy("abc", testAddr, policy))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the periods caught up would overflow, so the total supply stops at the max amount
+	require.NotPanics(t, func() {
+		asset.BeginBlocker(ctx.WithBlockTime(time.Unix(1e9, 0)), input.tk)
+	})
+	require.Equal(t, asset.MaxMintAmount, input.tk.GetToken(ctx, "abc").GetTotalSupply())
+	require.Equal(t, asset.MaxMintAmount.SubRaw(2100), input.tk.GetAccTotalToken(ctx, recipient).AmountOf("abc"))
+	require.NotPanics(t, func() {
+		asset.BeginBlocker(ctx.WithBlockTime(time.Unix(2e9, 0)), input.tk)
+	})
+	require.Equal(t, asset.MaxMintAmount, input.tk.GetToken(ctx, "abc").GetTotalSupply())
+}
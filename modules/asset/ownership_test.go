@@ -0,0 +1,78 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+)
+
+func Test_OwnershipTransfer_Accept(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	res := h(ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[0]))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, testAddr, input.tk.GetToken(ctx, "abc").GetOwner())
+	po, ok := input.tk.GetPendingOwnership(ctx, "abc")
+	require.True(t, ok)
+	require.Equal(t, 1000+asset.DefaultParams().OwnershipTransferTimeout, po.Deadline)
+
+	// a later proposal replaces the earlier one
+	res = h(ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[1]))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, asset.NewMsgAcceptOwnership("abc", addrs[0]))
+	require.False(t, res.IsOK())
+
+	res = h(ctx, asset.NewMsgAcceptOwnership("abc", addrs[1]))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, addrs[1], input.tk.GetToken(ctx, "abc").GetOwner())
+	_, ok = input.tk.GetPendingOwnership(ctx, "abc")
+	require.False(t, ok)
+
+	// the former owner can not transfer it any more
+	res = h(ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[0]))
+	require.False(t, res.IsOK())
+}
+
+func Test_OwnershipTransfer_CancelAndExpire(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// only the owner can cancel it
+	res := h(ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[0]))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, asset.NewMsgCancelOwnershipTransfer("abc", addrs[0]))
+	require.False(t, res.IsOK())
+	res = h(ctx, asset.NewMsgCancelOwnershipTransfer("abc", testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, asset.NewMsgAcceptOwnership("abc", addrs[0]))
+	require.False(t, res.IsOK())
+
+	res = h(ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[0]))
+	require.True(t, res.IsOK(), res.Log)
+	po, _ := input.tk.GetPendingOwnership(ctx, "abc")
+
+	// it is kept until the deadline
+	ctx = ctx.WithBlockTime(time.Unix(po.Deadline, 0))
+	asset.EndBlocker(ctx, input.tk)
+	_, ok := input.tk.GetPendingOwnership(ctx, "abc")
+	require.True(t, ok)
+
+	// and can not be accepted after the deadline
+	ctx = ctx.WithBlockTime(time.Unix(po.Deadline+1, 0))
+	res = h(ctx, asset.NewMsgAcceptOwnership("abc", addrs[0]))
+	require.False(t, res.IsOK())
+	asset.EndBlocker(ctx, input.tk)
+	_, ok = input.tk.GetPendingOwnership(ctx, "abc")
+	require.False(t, ok)
+	require.Equal(t, testAddr, input.tk.GetToken(ctx, "abc").GetOwner())
+}
@@ -0,0 +1,230 @@
+package asset_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func issueRoleToken(t *testing.T, input testInput, h sdk.Handler) {
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	msgIssue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, true, true, true, "", "", types.TestIdentityString)
+	res := h(input.ctx, msgIssue)
+	require.True(t, res.IsOK())
+}
+
+func Test_TokenRoles(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	minter, freezer := addrs[0], addrs[1]
+
+	// only the owner can grant roles
+	res := h(input.ctx, asset.NewMsgGrantTokenRole("abc", minter, asset.RoleMinter, minter))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgGrantTokenRole("abc", testAddr, asset.RoleMinter, minter))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgGrantTokenRole("abc", testAddr, asset.RoleFreezer, freezer))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, len(input.tk.GetTokenRoles(input.ctx, "abc")))
+
+	// the minter mints to itself, but can not forbid the token
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), minter))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(100), input.tk.GetAccTotalToken(input.ctx, minter).AmountOf("abc"))
+	res = h(input.ctx, asset.NewMsgForbidToken("abc", minter))
+	require.False(t, res.IsOK())
+
+	// the freezer forbids the token, but can not mint or burn
+	res = h(input.ctx, asset.NewMsgForbidToken("abc", freezer))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), freezer))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgBurnToken("abc", sdk.NewInt(100), freezer))
+	require.False(t, res.IsOK())
+
+	// the role is gone after revoked
+	res = h(input.ctx, asset.NewMsgRevokeTokenRole("abc", testAddr, asset.RoleMinter, minter))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), minter))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgRevokeTokenRole("abc", testAddr, asset.RoleMinter, minter))
+	require.False(t, res.IsOK())
+}
+
+func Test_TokenRoles_MetadataAdmin(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	admin := mockAddrList()[0]
+
+	res := h(input.ctx, asset.NewMsgGrantTokenRole("abc", testAddr, asset.RoleMetadataAdmin, admin))
+	require.True(t, res.IsOK(), res.Log)
+
+	msg := newMsgModifyTokenInfo()
+	msg.Symbol = "abc"
+	msg.OwnerAddress = admin
+	msg.URL = "www.abc.com"
+	res = h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, "www.abc.com", input.tk.GetToken(input.ctx, "abc").GetURL())
+
+	// the fields other than url, description and identity are for the owner only
+	msg.URL = types.DoNotModifyTokenInfo
+	msg.Name = "New Name"
+	res = h(input.ctx, msg)
+	require.False(t, res.IsOK())
+}
+
+func Test_ApprovalPolicy_Mint(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	approvers := []sdk.AccAddress{testAddr, addrs[0], addrs[1]}
+
+	res := h(input.ctx, asset.NewMsgSetApprovalPolicy("abc", testAddr, approvers, 2))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the mint waits for another approval
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(2100), input.tk.GetToken(input.ctx, "abc").GetTotalSupply())
+	ops := input.tk.GetTokenOperations(input.ctx, "abc")
+	require.Equal(t, 1, len(ops))
+	require.Equal(t, uint64(1), ops[0].ID)
+
+	// only approvers can approve, and only once
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[2], 1))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(testAddr, 1))
+	require.False(t, res.IsOK())
+
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[1], 1))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(2200), input.tk.GetToken(input.ctx, "abc").GetTotalSupply())
+	require.Equal(t, sdk.NewInt(2200), input.tk.GetAccTotalToken(input.ctx, testAddr).AmountOf("abc"))
+	require.Equal(t, 0, len(input.tk.GetTokenOperations(input.ctx, "abc")))
+
+	// the policy is removed with a zero threshold, which also needs the approvals
+	res = h(input.ctx, asset.NewMsgSetApprovalPolicy("abc", testAddr, nil, 0))
+	require.True(t, res.IsOK(), res.Log)
+	_, ok := input.tk.GetApprovalPolicy(input.ctx, "abc")
+	require.True(t, ok)
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[0], 2))
+	require.True(t, res.IsOK(), res.Log)
+	_, ok = input.tk.GetApprovalPolicy(input.ctx, "abc")
+	require.False(t, ok)
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(2300), input.tk.GetToken(input.ctx, "abc").GetTotalSupply())
+}
+
+func Test_ApprovalPolicy_TransferOwnership(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+
+	res := h(input.ctx, asset.NewMsgSetApprovalPolicy("abc", testAddr, []sdk.AccAddress{addrs[0], addrs[1]}, 1))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = h(input.ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[2]))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, testAddr, input.tk.GetToken(input.ctx, "abc").GetOwner())
+
+	// only the proposer or the owner can cancel it
+	res = h(input.ctx, asset.NewMsgCancelTokenOperation(addrs[0], 1))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgCancelTokenOperation(testAddr, 1))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[0], 1))
+	require.False(t, res.IsOK())
+
+	res = h(input.ctx, asset.NewMsgTransferOwnership("abc", testAddr, addrs[2]))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[0], 2))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the approved transfer still needs the acceptance of the new owner
+	require.Equal(t, testAddr, input.tk.GetToken(input.ctx, "abc").GetOwner())
+	res = h(input.ctx, asset.NewMsgAcceptOwnership("abc", addrs[2]))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, addrs[2], input.tk.GetToken(input.ctx, "abc").GetOwner())
+}
+
+func Test_ApprovalPolicy_Change(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	approvers := []sdk.AccAddress{addrs[0], addrs[1], addrs[2]}
+
+	res := h(input.ctx, asset.NewMsgSetApprovalPolicy("abc", testAddr, approvers, 2))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), testAddr))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the owner alone can not swap the policy for a 1-of-1 one
+	res = h(input.ctx, asset.NewMsgSetApprovalPolicy("abc", testAddr, []sdk.AccAddress{testAddr}, 1))
+	require.True(t, res.IsOK(), res.Log)
+	policy, _ := input.tk.GetApprovalPolicy(input.ctx, "abc")
+	require.Equal(t, approvers, policy.Approvers)
+	require.Equal(t, 2, len(input.tk.GetTokenOperations(input.ctx, "abc")))
+
+	// nor set it on the keeper directly
+	err := input.tk.SetApprovalPolicy(input.ctx, "abc", testAddr, nil, 0)
+	require.Error(t, err)
+
+	// only the owner can propose a new policy
+	res = h(input.ctx, asset.NewMsgSetApprovalPolicy("abc", addrs[0], []sdk.AccAddress{addrs[0]}, 1))
+	require.False(t, res.IsOK())
+
+	// the new policy is set with the approvals of the current one, and the pending operations are dropped
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[0], 2))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[1], 2))
+	require.True(t, res.IsOK(), res.Log)
+	policy, _ = input.tk.GetApprovalPolicy(input.ctx, "abc")
+	require.Equal(t, []sdk.AccAddress{testAddr}, policy.Approvers)
+	require.Equal(t, int64(1), policy.Threshold)
+	require.Equal(t, 0, len(input.tk.GetTokenOperations(input.ctx, "abc")))
+	res = h(input.ctx, asset.NewMsgApproveTokenOperation(addrs[0], 1))
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(2100), input.tk.GetToken(input.ctx, "abc").GetTotalSupply())
+}
+
+func Test_TokenRoles_TransferOwnership(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueRoleToken(t, input, h)
+	addrs := mockAddrList()
+	minter, newOwner := addrs[0], addrs[1]
+
+	res := h(input.ctx, asset.NewMsgGrantTokenRole("abc", testAddr, asset.RoleMinter, minter))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgGrantTokenRole("abc", testAddr, asset.RoleFreezer, testAddr))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = h(input.ctx, asset.NewMsgTransferOwnership("abc", testAddr, newOwner))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(input.ctx, asset.NewMsgAcceptOwnership("abc", newOwner))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the roles granted by the old owner, including to itself, are cleared
+	require.Equal(t, 0, len(input.tk.GetTokenRoles(input.ctx, "abc")))
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), minter))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgForbidToken("abc", testAddr))
+	require.False(t, res.IsOK())
+	res = h(input.ctx, asset.NewMsgMintToken("abc", sdk.NewInt(100), newOwner))
+	require.True(t, res.IsOK(), res.Log)
+}
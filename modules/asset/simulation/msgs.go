@@ -79,6 +79,11 @@ func SimulateMsgTransferOwnership(k asset.Keeper) simulation.Operation {
 		if !ok {
 			return simulation.NewOperationMsg(msg, ok, ""), nil, nil
 		}
+		// the new owner accepts the ownership at once
+		ok = simulation2.SimulateHandleMsg(asset.NewMsgAcceptOwnership(symbol, newOwner), handler, ctx)
+		if !ok {
+			return simulation.NewOperationMsg(msg, ok, ""), nil, nil
+		}
 
 		ok = verifyTokenOwnerTransfer(ctx, k, msg)
 		if !ok {
@@ -0,0 +1,119 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func Test_SymbolAuction(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	addrs := mockAddrList()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	require.NoError(t, input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18)))
+	require.NoError(t, input.tk.AddToken(ctx, addrs[0], dex.NewCetCoins(1e18)))
+	// bids are frozen in CET, so the token must exist
+	res := h(ctx, asset.NewMsgIssueToken("CoinEx Chain Native Token", dex.CET, sdk.NewInt(1e18), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+	cetStart := input.tk.GetAccTotalToken(ctx, testAddr).AmountOf(dex.CET)
+	params := input.tk.GetParams(ctx)
+	minBid := sdk.NewInt(params.IssueRareTokenFee)
+
+	// long symbols can not be auctioned, and the first bid must cover the issue fee
+	res = h(ctx, asset.NewMsgBidSymbol(testAddr, "abcdefg", minBid))
+	require.False(t, res.IsOK())
+	res = h(ctx, asset.NewMsgBidSymbol(testAddr, "btc", minBid.SubRaw(1)))
+	require.False(t, res.IsOK())
+	res = h(ctx, asset.NewMsgBidSymbol(testAddr, "btc", minBid))
+	require.True(t, res.IsOK(), res.Log)
+	auction, ok := input.tk.GetSymbolAuction(ctx, "btc")
+	require.True(t, ok)
+	require.Equal(t, int64(1000+params.SymbolAuctionDuration), auction.EndTime)
+
+	// the outbid one gets its bid back
+	res = h(ctx, asset.NewMsgBidSymbol(addrs[0], "btc", minBid.AddRaw(1)))
+	require.False(t, res.IsOK())
+	res = h(ctx, asset.NewMsgBidSymbol(addrs[0], "btc", auction.MinNextBid(params.MinBidIncreaseRate)))
+	require.True(t, res.IsOK(), res.Log)
+	res = h(ctx, asset.NewMsgBidSymbol(testAddr, "btc", minBid.MulRaw(2)))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(1e18), input.tk.GetAccTotalToken(ctx, addrs[0]).AmountOf(dex.CET))
+
+	// only the highest bidder can issue the symbol
+	msgIssue := asset.NewMsgIssueToken("Bitcoin", "btc", sdk.NewInt(2100), addrs[0],
+		false, false, false, false, "", "", types.TestIdentityString)
+	res = h(ctx, msgIssue)
+	require.False(t, res.IsOK())
+
+	ctx = ctx.WithBlockTime(time.Unix(auction.EndTime, 0))
+	res = h(ctx, asset.NewMsgBidSymbol(addrs[0], "btc", minBid.MulRaw(3)))
+	require.False(t, res.IsOK())
+	asset.EndBlocker(ctx, input.tk)
+	auction, ok = input.tk.GetSymbolAuction(ctx, "btc")
+	require.True(t, ok)
+	require.True(t, auction.Settled)
+	require.Equal(t, 3, int(auction.BidCount))
+	cetBefore := input.tk.GetAccTotalToken(ctx, testAddr).AmountOf(dex.CET)
+	require.Equal(t, cetStart.Sub(minBid.MulRaw(2)), cetBefore)
+
+	// the winner issues the symbol without the issue fee
+	res = h(ctx, msgIssue)
+	require.False(t, res.IsOK())
+	msgIssue.Owner = testAddr
+	res = h(ctx, msgIssue)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, cetBefore, input.tk.GetAccTotalToken(ctx, testAddr).AmountOf(dex.CET))
+	_, ok = input.tk.GetSymbolAuction(ctx, "btc")
+	require.False(t, ok)
+	res = h(ctx, asset.NewMsgBidSymbol(addrs[0], "btc", minBid.MulRaw(3)))
+	require.False(t, res.IsOK())
+}
+
+func Test_SymbolAuction_IssueDeadline(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	addrs := mockAddrList()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	require.NoError(t, input.tk.AddToken(ctx, testAddr, dex.NewCetCoins(1e18)))
+	require.NoError(t, input.tk.AddToken(ctx, addrs[0], dex.NewCetCoins(1e18)))
+	res := h(ctx, asset.NewMsgIssueToken("CoinEx Chain Native Token", dex.CET, sdk.NewInt(1e18), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString))
+	require.True(t, res.IsOK(), res.Log)
+	params := input.tk.GetParams(ctx)
+	res = h(ctx, asset.NewMsgBidSymbol(addrs[0], "btc", sdk.NewInt(params.GetSymbolAuctionMinBid("btc"))))
+	require.True(t, res.IsOK(), res.Log)
+	auction, _ := input.tk.GetSymbolAuction(ctx, "btc")
+
+	ctx = ctx.WithBlockTime(time.Unix(auction.EndTime, 0))
+	asset.EndBlocker(ctx, input.tk)
+	auction, ok := input.tk.GetSymbolAuction(ctx, "btc")
+	require.True(t, ok)
+	require.Equal(t, auction.EndTime+params.SymbolIssuePeriod, auction.IssueDeadline)
+
+	// the symbol is kept for the winner until the issue deadline
+	msgIssue := asset.NewMsgIssueToken("Bitcoin", "btc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	ctx = ctx.WithBlockTime(time.Unix(auction.IssueDeadline-1, 0))
+	asset.EndBlocker(ctx, input.tk)
+	res = h(ctx, msgIssue)
+	require.False(t, res.IsOK())
+	require.True(t, input.tk.IsSymbolAuctionWinner(ctx, "btc", addrs[0]))
+
+	// then it is freed, and anyone could bid on it or issue it
+	ctx = ctx.WithBlockTime(time.Unix(auction.IssueDeadline, 0))
+	require.False(t, input.tk.IsSymbolAuctionWinner(ctx, "btc", addrs[0]))
+	asset.EndBlocker(ctx, input.tk)
+	_, ok = input.tk.GetSymbolAuction(ctx, "btc")
+	require.False(t, ok)
+	require.Empty(t, input.tk.GetAllSymbolAuctions(ctx))
+	res = h(ctx, msgIssue)
+	require.True(t, res.IsOK(), res.Log)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -27,6 +28,7 @@ type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
 	tk  asset.Keeper
+	bxk bankx.Keeper
 }
 
 func createTestInput() testInput {
@@ -41,7 +43,7 @@ func createTestInput() testInput {
 	_ = notBondedPool.SetCoins(initSupply)
 	app.SupplyKeeper.SetModuleAccount(ctx, notBondedPool)
 
-	return testInput{app.Cdc, ctx, app.AssetKeeper}
+	return testInput{app.Cdc, ctx, app.AssetKeeper, app.BankxKeeper}
 }
 
 var _, _, testAddr = keyPubAddr()
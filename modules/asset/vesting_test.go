@@ -0,0 +1,121 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func issueVestingToken(t *testing.T, input testInput, h sdk.Handler) {
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	msgIssue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", types.TestIdentityString)
+	res := h(input.ctx, msgIssue)
+	require.True(t, res.IsOK())
+}
+
+func Test_VestingSchedule_Release(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueVestingToken(t, input, h)
+	beneficiary := mockAddrList()[0]
+
+	// 1000 abc vested linearly in [1000, 2000), cliff at 1300, released every 100 seconds
+	msg := asset.NewMsgCreateVestingSchedule(testAddr, beneficiary, "abc", sdk.NewInt(1000),
+		1000, 1300, 2000, 100, false)
+	ctx := input.ctx.WithBlockTime(time.Unix(500, 0))
+	res := h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(1100), input.tk.GetAccTotalToken(ctx, testAddr).AmountOf("abc"))
+
+	vs, ok := input.tk.GetVestingSchedule(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, int64(1300), vs.NextReleaseTime)
+	require.Equal(t, 1, len(input.tk.GetVestingSchedulesOf(ctx, beneficiary)))
+
+	// nothing before the cliff
+	ctx = ctx.WithBlockTime(time.Unix(1299, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.True(t, input.tk.GetAccTotalToken(ctx, beneficiary).AmountOf("abc").IsZero())
+
+	// the vested coins are released at the cliff
+	ctx = ctx.WithBlockTime(time.Unix(1350, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(300), input.tk.GetAccTotalToken(ctx, beneficiary).AmountOf("abc"))
+	vs, _ = input.tk.GetVestingSchedule(ctx, 1)
+	require.Equal(t, int64(1400), vs.NextReleaseTime)
+
+	// skipped periods are caught up at once
+	ctx = ctx.WithBlockTime(time.Unix(1650, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(600), input.tk.GetAccTotalToken(ctx, beneficiary).AmountOf("abc"))
+
+	// a non-revocable schedule can not be revoked
+	res = h(ctx, asset.NewMsgRevokeVestingSchedule(testAddr, 1))
+	require.False(t, res.IsOK())
+
+	// all is released at the end, and the schedule is deleted
+	ctx = ctx.WithBlockTime(time.Unix(2100, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(1000), input.tk.GetAccTotalToken(ctx, beneficiary).AmountOf("abc"))
+	_, ok = input.tk.GetVestingSchedule(ctx, 1)
+	require.False(t, ok)
+	require.Equal(t, 0, len(input.tk.GetVestingSchedulesOf(ctx, beneficiary)))
+}
+
+func Test_VestingSchedule_Revoke(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueVestingToken(t, input, h)
+	beneficiary := mockAddrList()[0]
+
+	msg := asset.NewMsgCreateVestingSchedule(testAddr, beneficiary, "abc", sdk.NewInt(1000),
+		1000, 0, 2000, 100, true)
+	ctx := input.ctx.WithBlockTime(time.Unix(500, 0))
+	res := h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	// only the creator can revoke it
+	ctx = ctx.WithBlockTime(time.Unix(1450, 0))
+	res = h(ctx, asset.NewMsgRevokeVestingSchedule(beneficiary, 1))
+	require.False(t, res.IsOK())
+
+	res = h(ctx, asset.NewMsgRevokeVestingSchedule(testAddr, 1))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(400), input.tk.GetAccTotalToken(ctx, beneficiary).AmountOf("abc"))
+	require.Equal(t, sdk.NewInt(1700), input.tk.GetAccTotalToken(ctx, testAddr).AmountOf("abc"))
+	_, ok := input.tk.GetVestingSchedule(ctx, 1)
+	require.False(t, ok)
+
+	// nothing is left in the queue
+	ctx = ctx.WithBlockTime(time.Unix(2100, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, sdk.NewInt(400), input.tk.GetAccTotalToken(ctx, beneficiary).AmountOf("abc"))
+
+	res = h(ctx, asset.NewMsgRevokeVestingSchedule(testAddr, 1))
+	require.False(t, res.IsOK())
+}
+
+func Test_VestingSchedule_Invalid(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	issueVestingToken(t, input, h)
+	beneficiary := mockAddrList()[0]
+
+	// unknown token
+	res := h(input.ctx, asset.NewMsgCreateVestingSchedule(testAddr, beneficiary, "xyz", sdk.NewInt(1000),
+		1000, 0, 2000, 100, true))
+	require.False(t, res.IsOK())
+
+	// insufficient coins
+	res = h(input.ctx, asset.NewMsgCreateVestingSchedule(testAddr, beneficiary, "abc", sdk.NewInt(3000),
+		1000, 0, 2000, 100, true))
+	require.False(t, res.IsOK())
+}
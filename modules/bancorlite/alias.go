@@ -25,4 +25,11 @@ type (
 	MsgBancorInit              = types.MsgBancorInit
 	MsgBancorTrade             = types.MsgBancorTrade
 	MsgBancorCancel            = types.MsgBancorCancel
+	MsgBancorUpdate            = types.MsgBancorUpdate
+	MsgBancorRouteTrade        = types.MsgBancorRouteTrade
+	LiquidityPool              = keepers.LiquidityPool
+	LiquidityPoolDisplay       = keepers.LiquidityPoolDisplay
+	MsgLiquidityPoolInit       = types.MsgLiquidityPoolInit
+	MsgLiquidityPoolAdd        = types.MsgLiquidityPoolAdd
+	MsgLiquidityPoolWithdraw   = types.MsgLiquidityPoolWithdraw
 )
@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
@@ -58,3 +61,84 @@ Example :
 		},
 	}
 }
+
+func QueryBancorQuoteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [stock] [money]",
+		Short: "query the money amount, commission and pool state of a prospective trade",
+		Long: `query the money amount, commission, rebate and the pool state after a prospective trade.
+When --money-amount is specified, the maximum stock amount whose money amount does not exceed it is quoted.
+
+Example : 
+	cetcli query bancorlite quote stock money --side buy --amount=100 --trust-node=true --chain-id=coinexdex
+	cetcli query bancorlite quote stock money --side buy --money-amount=1000 --trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var isBuy bool
+			switch viper.GetString(FlagSide) {
+			case "buy":
+				isBuy = true
+			case "sell":
+				isBuy = false
+			default:
+				return errors.New("unknown Side. Please specify 'buy' or 'sell'")
+			}
+			var sender sdk.AccAddress
+			if s := viper.GetString(FlagSender); s != "" {
+				addr, err := sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+				sender = addr
+			}
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryBancorQuote)
+			param := &keepers.QueryBancorQuoteParam{
+				Symbol:      dex.GetSymbol(args[0], args[1]),
+				IsBuy:       isBuy,
+				Amount:      viper.GetInt64(FlagAmount),
+				MoneyAmount: viper.GetInt64(FlagMoneyAmount),
+				Sender:      sender,
+			}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+	cmd.Flags().Int64(FlagAmount, 0, "The amount of stock to be traded.")
+	cmd.Flags().Int64(FlagMoneyAmount, 0, "The money budget, quote the maximum stock amount within it instead of --amount.")
+	cmd.Flags().String(FlagSide, "", "the side of the trade, 'buy' or 'sell'.")
+	cmd.Flags().String(FlagSender, "", "the trader's address, used to calculate the referral rebate.")
+	cmd.MarkFlagRequired(FlagSide)
+	return cmd
+}
+
+func QueryLiquidityPoolCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lp-info [stock] [money]",
+		Short: "query the liquidity pool of a symbol pair",
+		Long: `query the reserves, shares and price of the liquidity pool of a symbol pair.
+
+Example : 
+	cetcli query bancorlite lp-info stock money --trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryLiquidityPool)
+			param := &keepers.QueryBancorInfoParam{Symbol: dex.GetSymbol(args[0], args[1])}
+			return cliutil.CliQuery(cdc, query, param)
+		},
+	}
+}
+
+func QueryLiquidityPoolListCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lp-infos",
+		Short: "query all liquidity pools in blockchain",
+		Long: `query all liquidity pools in blockchain.
+
+Example :
+	cetcli query bancorlite lp-infos \
+	--trust-node=true --chain-id=coinexdex`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryLiquidityPools)
+			return cliutil.CliQuery(cdc, query, nil)
+		},
+	}
+}
@@ -19,6 +19,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		QueryParamsCmd(cdc),
 		QueryBancorInfoCmd(cdc),
 		QueryBancorListCmd(cdc),
+		QueryBancorQuoteCmd(cdc),
+		QueryLiquidityPoolCmd(cdc),
+		QueryLiquidityPoolListCmd(cdc),
 	)...)
 	return bancorliteQueryCmd
 }
@@ -33,7 +36,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	bancorliteTxCmd.AddCommand(client.PostCommands(
 		BancorInitCmd(cdc),
 		BancorTradeCmd(cdc),
+		BancorRouteTradeCmd(cdc),
 		BancorCancelCmd(cdc),
+		BancorUpdateCmd(cdc),
+		LiquidityPoolInitCmd(cdc),
+		LiquidityPoolAddCmd(cdc),
+		LiquidityPoolWithdrawCmd(cdc),
 	)...)
 
 	return bancorliteTxCmd
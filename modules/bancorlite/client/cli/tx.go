@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -24,6 +25,21 @@ const (
 	FlagMoneyLimit         = "money-limit"
 	FlagInitPrice          = "init-price"
 	FlagEarliestCancelTime = "earliest-cancel-time"
+	FlagMoneyAmount        = "money-amount"
+	FlagSender             = "sender"
+	FlagCurveType          = "curve-type"
+	FlagSteepness          = "steepness"
+	FlagTranches           = "tranches"
+	FlagShareSymbol        = "share-symbol"
+	FlagStockAmount        = "stock-amount"
+	FlagFeeRate            = "fee-rate"
+	FlagShares             = "shares"
+	FlagWithdrawMoney      = "withdraw-money"
+	FlagMaxStockPerTrade   = "max-stock-per-trade"
+	FlagMaxStockPerBlock   = "max-stock-per-block"
+	FlagMaxPriceChange     = "max-price-change"
+	FlagAuctionStartPrice  = "auction-start-price"
+	FlagAuctionDuration    = "auction-duration"
 )
 
 var bancorInitFlags = []string{
@@ -37,7 +53,6 @@ var bancorInitFlags = []string{
 
 var bancorTradeFlags = []string{
 	FlagSide,
-	FlagAmount,
 	FlagMoneyLimit,
 }
 
@@ -49,6 +64,17 @@ func BancorInitCmd(cdc *codec.Codec) *cobra.Command {
 
 Example: 
 	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=100000 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165
+
+The curve is linear when max-money is zero, or a power curve otherwise. Other shapes can be chosen with --curve-type, and then max-money must be zero:
+	 cetcli tx bancorlite init stock money --max-supply=3000 --max-money=0 --stock-precision=0 --max-price=3 --init-price=1 --earliest-cancel-time=1563954165 --curve-type=tranches --tranches=1000:1,1000:2,1000:3
+	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=0 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165 --curve-type=sigmoid --steepness=10
+	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=0 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165 --curve-type=exponential
+
+The trades can be limited by the stock of each trade, the stock traded by each account in a block, and the price change in a block:
+	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=100000 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165 --max-stock-per-trade=1000000 --max-stock-per-block=2000000 --max-price-change=0.1
+
+A Dutch auction can be started with the pool, in which buyers pay a premium over the curve price, decaying from the start price to the curve price in the duration (seconds):
+	 cetcli tx bancorlite init stock money --max-supply=10000000000000 --max-money=100000 --stock-precision=3 --max-price=5 --init-price=1 --earliest-cancel-time=1563954165 --auction-start-price=3 --auction-duration=86400
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -76,6 +102,10 @@ Example:
 			if err != nil {
 				return errors.New("bancor earliest-cancel-time is invalid")
 			}
+			tranches, err := parseTranches(viper.GetString(FlagTranches))
+			if err != nil {
+				return err
+			}
 			msg := &types.MsgBancorInit{
 				Stock:              args[0],
 				Money:              args[1],
@@ -85,6 +115,14 @@ Example:
 				MaxPrice:           viper.GetString(FlagMaxPrice),
 				MaxMoney:           maxMoney,
 				EarliestCancelTime: time,
+				CurveType:          viper.GetString(FlagCurveType),
+				Steepness:          viper.GetInt64(FlagSteepness),
+				Tranches:           tranches,
+				MaxStockPerTrade:   viper.GetInt64(FlagMaxStockPerTrade),
+				MaxStockPerBlock:   viper.GetInt64(FlagMaxStockPerBlock),
+				MaxPriceChange:     viper.GetString(FlagMaxPriceChange),
+				AuctionStartPrice:  viper.GetString(FlagAuctionStartPrice),
+				AuctionDuration:    viper.GetInt64(FlagAuctionDuration),
 			}
 			return cliutil.CliRunCommand(cdc, msg)
 		},
@@ -96,6 +134,14 @@ Example:
 	cmd.Flags().String(FlagMaxPrice, "0", "The maximum reachable price when all the supply are sold out")
 	cmd.Flags().String(FlagEarliestCancelTime, "0", "The time that bancor can be canceled")
 	cmd.Flags().String(FlagInitPrice, "0", "The init price of this bancor")
+	cmd.Flags().String(FlagCurveType, "", "The shape of the curve, 'tranches', 'sigmoid' or 'exponential'. Leave it empty for the linear or power curve")
+	cmd.Flags().Int64(FlagSteepness, 0, "The steepness of the sigmoid curve")
+	cmd.Flags().String(FlagTranches, "", "The tranches of the tranches curve, as comma separated 'supply:price' pairs")
+	cmd.Flags().Int64(FlagMaxStockPerTrade, 0, "The max stock of a trade, zero for no limit")
+	cmd.Flags().Int64(FlagMaxStockPerBlock, 0, "The max stock traded by an account in a block, zero for no limit")
+	cmd.Flags().String(FlagMaxPriceChange, "", "The max price change in a block, empty for no limit")
+	cmd.Flags().String(FlagAuctionStartPrice, "", "The start price of the Dutch auction, empty for no auction")
+	cmd.Flags().Int64(FlagAuctionDuration, 0, "The seconds in which the auction price decays to the curve price")
 	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
 	for _, flag := range bancorInitFlags {
 		cmd.MarkFlagRequired(flag)
@@ -103,6 +149,30 @@ Example:
 	return cmd
 }
 
+func parseTranches(str string) ([]types.Tranche, error) {
+	if len(str) == 0 {
+		return nil, nil
+	}
+	pairs := strings.Split(str, ",")
+	tranches := make([]types.Tranche, 0, len(pairs))
+	for _, pair := range pairs {
+		fields := strings.Split(pair, ":")
+		if len(fields) != 2 {
+			return nil, errors.New("tranche should be in 'supply:price' format")
+		}
+		supply, ok := sdk.NewIntFromString(fields[0])
+		if !ok {
+			return nil, errors.New("supply of tranche is invalid")
+		}
+		price, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, errors.New("price of tranche is invalid")
+		}
+		tranches = append(tranches, types.Tranche{Supply: supply, Price: price})
+	}
+	return tranches, nil
+}
+
 func BancorTradeCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "trade [stock] [money]",
@@ -112,6 +182,7 @@ func BancorTradeCmd(cdc *codec.Codec) *cobra.Command {
 Example: 
 	 cetcli tx bancorlite trade stock money --side buy --amount=100 --money-limit=120
 	 cetcli tx bancorlite trade stock money --side sell --amount=100 --money-limit=80
+	 cetcli tx bancorlite trade stock money --side buy --money-amount=100 --money-limit=100
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -125,6 +196,53 @@ Example:
 				return errors.New("unknown Side. Please specify 'buy' or 'sell'")
 			}
 			msg := &types.MsgBancorTrade{
+				Stock:       args[0],
+				Money:       args[1],
+				Amount:      viper.GetInt64(FlagAmount),
+				IsBuy:       isBuy,
+				MoneyLimit:  viper.GetInt64(FlagMoneyLimit),
+				MoneyAmount: viper.GetInt64(FlagMoneyAmount),
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().Int(FlagAmount, 0, "The amount of tokens to be traded.")
+	cmd.Flags().Int(FlagMoneyAmount, 0, "The amount of money to be traded, used instead of --amount to trade the maximum stock within it.")
+	cmd.Flags().Int(FlagMoneyLimit, 0, "The upper bound of money you want to pay when buying, or the lower bound of money you want to get when selling. Specify zero or negative value if you do not want a such a limit.")
+	cmd.Flags().String(FlagSide, "", "the side of the trade, 'buy' or 'sell'.")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	for _, flag := range bancorTradeFlags {
+		cmd.MarkFlagRequired(flag)
+	}
+	return cmd
+}
+
+func BancorRouteTradeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route-trade [stock] [money]",
+		Short: "Trade with a bancor pool and the orders of the market at the best combined price",
+		Long: `Buy or sell Stocks at the best combined price of a bancor pool and the resting orders of
+the stock/money market. The part for the orders is an IOC order, which is dealt with them at
+once, and the pool takes the stock left by it. The money limit applies to the money of both parts.
+
+Example: 
+	 cetcli tx bancorlite route-trade stock money --side buy --amount=100 --money-limit=120
+	 cetcli tx bancorlite route-trade stock money --side sell --amount=100 --money-limit=80
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var isBuy bool
+			switch viper.GetString(FlagSide) {
+			case "buy":
+				isBuy = true
+			case "sell":
+				isBuy = false
+			default:
+				return errors.New("unknown Side. Please specify 'buy' or 'sell'")
+			}
+			msg := &types.MsgBancorRouteTrade{
 				Stock:      args[0],
 				Money:      args[1],
 				Amount:     viper.GetInt64(FlagAmount),
@@ -136,7 +254,7 @@ Example:
 	}
 
 	cmd.Flags().Int(FlagAmount, 0, "The amount of tokens to be traded.")
-	cmd.Flags().Int(FlagMoneyLimit, 0, "The upper bound of money you want to pay when buying, or the lower bound of money you want to get when selling. Specify zero or negative value if you do not want a such a limit.")
+	cmd.Flags().Int(FlagMoneyLimit, 0, "The upper bound of the total money you want to pay when buying, or the lower bound of the total money you want to get when selling. Specify zero if you do not want a such a limit.")
 	cmd.Flags().String(FlagSide, "", "the side of the trade, 'buy' or 'sell'.")
 	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
 
@@ -168,3 +286,155 @@ Example:
 
 	return cmd
 }
+
+func BancorUpdateCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [stock] [money]",
+		Short: "Update a bancor pool for a stock/money pair",
+		Long: `Update a bancor pool for a stock/money pair, sender must be this stock owner.
+The max supply can only be raised, and the extra stock is frozen into the pool. The earliest cancel time can only be extended.
+To withdraw money from the pool, the owner puts back stock as if selling it to the pool. The unspecified fields are left unchanged.
+
+Example: 
+	 cetcli tx bancorlite update stock money --max-supply=20000000000000 --earliest-cancel-time=1593954165
+	 cetcli tx bancorlite update stock money --withdraw-money=1000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maxSupply, ok := sdk.NewIntFromString(viper.GetString(FlagMaxSupply))
+			if !ok {
+				return errors.New("max supply is invalid")
+			}
+			withdrawMoney, ok := sdk.NewIntFromString(viper.GetString(FlagWithdrawMoney))
+			if !ok {
+				return errors.New("withdraw money is invalid")
+			}
+			msg := &types.MsgBancorUpdate{
+				Stock:              args[0],
+				Money:              args[1],
+				MaxSupply:          maxSupply,
+				EarliestCancelTime: viper.GetInt64(FlagEarliestCancelTime),
+				WithdrawMoney:      withdrawMoney,
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().String(FlagMaxSupply, "0", "The new maximum supply of this pool")
+	cmd.Flags().Int64(FlagEarliestCancelTime, 0, "The new time that bancor can be canceled")
+	cmd.Flags().String(FlagWithdrawMoney, "0", "The amount of money to be withdrawn")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func LiquidityPoolInitCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-init [stock] [money]",
+		Short: "Create a liquidity pool for a stock/money pair",
+		Long: `Create a liquidity pool for a stock/money pair with the first liquidity, whose shares are the tokens of the share symbol.
+The fee rate is in 1/10000 of the money amount of a trade, and is kept in the pool.
+Besides the fee of creating a bancor, the sender pays the asset module's fee of issuing the share symbol.
+
+Example: 
+	 cetcli tx bancorlite lp-init stock money --share-symbol=stocklp --stock-amount=1000000 --money-amount=2000000 --fee-rate=30
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stockAmount, moneyAmount, err := parseLiquidityAmounts()
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgLiquidityPoolInit{
+				Stock:       args[0],
+				Money:       args[1],
+				ShareSymbol: viper.GetString(FlagShareSymbol),
+				StockAmount: stockAmount,
+				MoneyAmount: moneyAmount,
+				FeeRate:     viper.GetInt64(FlagFeeRate),
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().String(FlagShareSymbol, "", "The symbol of the share token, which is issued by the pool")
+	cmd.Flags().String(FlagStockAmount, "0", "The amount of stock to be added")
+	cmd.Flags().String(FlagMoneyAmount, "0", "The amount of money to be added")
+	cmd.Flags().Int64(FlagFeeRate, 0, "The fee rate of trades in 1/10000")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	for _, flag := range []string{FlagShareSymbol, FlagStockAmount, FlagMoneyAmount} {
+		cmd.MarkFlagRequired(flag)
+	}
+	return cmd
+}
+
+func LiquidityPoolAddCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-add [stock] [money]",
+		Short: "Add liquidity to a liquidity pool",
+		Long: `Add at most the specified stock and money to a liquidity pool for its shares. Only the amounts in the ratio of the pool are added.
+
+Example: 
+	 cetcli tx bancorlite lp-add stock money --stock-amount=1000 --money-amount=2000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stockAmount, moneyAmount, err := parseLiquidityAmounts()
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgLiquidityPoolAdd{
+				Stock:       args[0],
+				Money:       args[1],
+				StockAmount: stockAmount,
+				MoneyAmount: moneyAmount,
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().String(FlagStockAmount, "0", "The maximum amount of stock to be added")
+	cmd.Flags().String(FlagMoneyAmount, "0", "The maximum amount of money to be added")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	for _, flag := range []string{FlagStockAmount, FlagMoneyAmount} {
+		cmd.MarkFlagRequired(flag)
+	}
+	return cmd
+}
+
+func parseLiquidityAmounts() (stockAmount, moneyAmount sdk.Int, err error) {
+	stockAmount, ok := sdk.NewIntFromString(viper.GetString(FlagStockAmount))
+	if !ok {
+		return stockAmount, moneyAmount, errors.New("stock amount is invalid")
+	}
+	moneyAmount, ok = sdk.NewIntFromString(viper.GetString(FlagMoneyAmount))
+	if !ok {
+		return stockAmount, moneyAmount, errors.New("money amount is invalid")
+	}
+	return stockAmount, moneyAmount, nil
+}
+
+func LiquidityPoolWithdrawCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-withdraw [stock] [money]",
+		Short: "Withdraw liquidity from a liquidity pool",
+		Long: `Burn shares of a liquidity pool, and withdraw the stock and money they own.
+
+Example: 
+	 cetcli tx bancorlite lp-withdraw stock money --shares=1000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shares, ok := sdk.NewIntFromString(viper.GetString(FlagShares))
+			if !ok {
+				return errors.New("shares is invalid")
+			}
+			msg := &types.MsgLiquidityPoolWithdraw{
+				Stock:  args[0],
+				Money:  args[1],
+				Shares: shares,
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().String(FlagShares, "0", "The amount of shares to be burnt")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	cmd.MarkFlagRequired(FlagShares)
+	return cmd
+}
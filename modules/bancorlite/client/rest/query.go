@@ -3,12 +3,14 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
@@ -21,6 +23,9 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc("/bancorlite/pools/{symbol}", queryBancorInfoHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/bancorlite/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bancorlite/infos", queryBancorsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/quote/{symbol}", queryBancorQuoteHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/liquidity-pools/{symbol}", queryLiquidityPoolHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bancorlite/liquidity-pools", queryLiquidityPoolsHandlerFn(cdc, cliCtx)).Methods("GET")
 }
 
 // format: barcorlite/pools/btc-cet
@@ -51,3 +56,68 @@ func queryBancorsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 		restutil.RestQuery(cdc, cliCtx, w, r, query, nil, nil)
 	}
 }
+
+// format: barcorlite/quote/btc-cet?side=buy&amount=100, or with money_amount=1000 instead of amount
+func queryBancorQuoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryBancorQuote)
+		symbol := strings.Replace(vars["symbol"], "-", "/", 1)
+		if !market.IsValidTradingPair(strings.Split(symbol, "/")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid Trading pair")
+			return
+		}
+		param := &keepers.QueryBancorQuoteParam{Symbol: symbol}
+		switch r.FormValue("side") {
+		case "buy":
+			param.IsBuy = true
+		case "sell":
+			param.IsBuy = false
+		default:
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid side, please specify 'buy' or 'sell'")
+			return
+		}
+		var err error
+		if s := r.FormValue("amount"); s != "" {
+			if param.Amount, err = strconv.ParseInt(s, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid amount")
+				return
+			}
+		}
+		if s := r.FormValue("money_amount"); s != "" {
+			if param.MoneyAmount, err = strconv.ParseInt(s, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid money amount")
+				return
+			}
+		}
+		if s := r.FormValue("sender"); s != "" {
+			if param.Sender, err = sdk.AccAddressFromBech32(s); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
+
+// format: barcorlite/liquidity-pools/btc-cet
+func queryLiquidityPoolHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryLiquidityPool)
+		symbol := strings.Replace(vars["symbol"], "-", "/", 1)
+		if !market.IsValidTradingPair(strings.Split(symbol, "/")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid Trading pair")
+			return
+		}
+		param := &keepers.QueryBancorInfoParam{Symbol: symbol}
+		restutil.RestQuery(cdc, cliCtx, w, r, query, param, nil)
+	}
+}
+
+func queryLiquidityPoolsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryLiquidityPools)
+		restutil.RestQuery(cdc, cliCtx, w, r, query, nil, nil)
+	}
+}
@@ -15,5 +15,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec)
 func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/bancorlite/bancor-init", bancorInitHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/bancorlite/bancor-trade", bancorTradeHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/bancor-route-trade", bancorRouteTradeHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/bancorlite/bancor-cancel", bancorCancelHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/bancor-update", bancorUpdateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/liquidity-pool-init", liquidityPoolInitHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/liquidity-pool-add", liquidityPoolAddHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bancorlite/liquidity-pool-withdraw", liquidityPoolWithdrawHandlerFn(cdc, cliCtx)).Methods("POST")
 }
@@ -24,6 +24,17 @@ type BancorInitReq struct {
 	StockPrecision     string       `json:"stock_precision"`
 	MaxPrice           string       `json:"max_price"`
 	EarliestCancelTime string       `json:"earliest_cancel_time"`
+	// optional, the shape of the curve and its parameters
+	CurveType string          `json:"curve_type"`
+	Steepness string          `json:"steepness"`
+	Tranches  []types.Tranche `json:"tranches"`
+	// optional, the limits of trades
+	MaxStockPerTrade string `json:"max_stock_per_trade"`
+	MaxStockPerBlock string `json:"max_stock_per_block"`
+	MaxPriceChange   string `json:"max_price_change"`
+	// optional, the Dutch auction after the pool is created
+	AuctionStartPrice string `json:"auction_start_price"`
+	AuctionDuration   string `json:"auction_duration"`
 }
 
 var _ restutil.RestReq = (*BancorInitReq)(nil)
@@ -65,6 +76,33 @@ func (req *BancorInitReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Ms
 			return nil, errors.New("Invalid stock precision")
 		}
 	}
+	var steepness int64
+	if req.Steepness != "" {
+		steepness, convertErr = strconv.ParseInt(req.Steepness, 10, 64)
+		if convertErr != nil {
+			return nil, errors.New("Invalid steepness")
+		}
+	}
+	var maxStockPerTrade, maxStockPerBlock int64
+	if req.MaxStockPerTrade != "" {
+		maxStockPerTrade, convertErr = strconv.ParseInt(req.MaxStockPerTrade, 10, 64)
+		if convertErr != nil {
+			return nil, errors.New("Invalid max stock per trade")
+		}
+	}
+	if req.MaxStockPerBlock != "" {
+		maxStockPerBlock, convertErr = strconv.ParseInt(req.MaxStockPerBlock, 10, 64)
+		if convertErr != nil {
+			return nil, errors.New("Invalid max stock per block")
+		}
+	}
+	var auctionDuration int64
+	if req.AuctionDuration != "" {
+		auctionDuration, convertErr = strconv.ParseInt(req.AuctionDuration, 10, 64)
+		if convertErr != nil {
+			return nil, errors.New("Invalid auction duration")
+		}
+	}
 
 	return &types.MsgBancorInit{
 		Owner:              sender,
@@ -76,6 +114,14 @@ func (req *BancorInitReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Ms
 		StockPrecision:     byte(precision),
 		MaxPrice:           req.MaxPrice,
 		EarliestCancelTime: time,
+		CurveType:          req.CurveType,
+		Steepness:          steepness,
+		Tranches:           req.Tranches,
+		MaxStockPerTrade:   maxStockPerTrade,
+		MaxStockPerBlock:   maxStockPerBlock,
+		MaxPriceChange:     req.MaxPriceChange,
+		AuctionStartPrice:  req.AuctionStartPrice,
+		AuctionDuration:    auctionDuration,
 	}, nil
 }
 
@@ -90,6 +136,8 @@ type BancorTradeReq struct {
 	Amount     string       `json:"amount"`
 	IsBuy      bool         `json:"is_buy"`
 	MoneyLimit string       `json:"money_limit"`
+	// optional, used instead of Amount to trade the maximum stock within it
+	MoneyAmount string `json:"money_amount"`
 }
 
 var _ restutil.RestReq = (*BancorTradeReq)(nil)
@@ -102,17 +150,70 @@ func (req *BancorTradeReq) GetBaseReq() *rest.BaseReq {
 }
 
 func (req *BancorTradeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	var amount, moneyAmount int64
+	var err error
+	if req.MoneyAmount != "" {
+		moneyAmount, err = strconv.ParseInt(req.MoneyAmount, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid money amount")
+		}
+	}
+	if req.Amount != "" || moneyAmount == 0 {
+		amount, err = strconv.ParseInt(req.Amount, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid amount")
+		}
+	}
+
+	moneyLimit, err := strconv.ParseInt(req.MoneyLimit, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid money limit")
+	}
+
+	return &types.MsgBancorTrade{
+		Sender:      sender,
+		Stock:       req.Stock,
+		Money:       req.Money,
+		Amount:      amount,
+		IsBuy:       req.IsBuy,
+		MoneyLimit:  moneyLimit,
+		MoneyAmount: moneyAmount,
+	}, nil
+}
+
+func bancorTradeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorTradeReq))
+}
+
+type BancorRouteTradeReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Stock      string       `json:"stock"`
+	Money      string       `json:"money"`
+	Amount     string       `json:"amount"`
+	IsBuy      bool         `json:"is_buy"`
+	MoneyLimit string       `json:"money_limit"`
+}
+
+var _ restutil.RestReq = (*BancorRouteTradeReq)(nil)
+
+func (req *BancorRouteTradeReq) New() restutil.RestReq {
+	return new(BancorRouteTradeReq)
+}
+func (req *BancorRouteTradeReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *BancorRouteTradeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
 	amount, err := strconv.ParseInt(req.Amount, 10, 64)
 	if err != nil {
 		return nil, errors.New("invalid amount")
 	}
-
 	moneyLimit, err := strconv.ParseInt(req.MoneyLimit, 10, 64)
 	if err != nil {
 		return nil, errors.New("invalid money limit")
 	}
 
-	return &types.MsgBancorTrade{
+	return &types.MsgBancorRouteTrade{
 		Sender:     sender,
 		Stock:      req.Stock,
 		Money:      req.Money,
@@ -122,8 +223,8 @@ func (req *BancorTradeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.M
 	}, nil
 }
 
-func bancorTradeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
-	return restutil.NewRestHandler(cdc, cliCtx, new(BancorTradeReq))
+func bancorRouteTradeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorRouteTradeReq))
 }
 
 type BancorCancelReq struct {
@@ -152,3 +253,181 @@ func (req *BancorCancelReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.
 func bancorCancelHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(BancorCancelReq))
 }
+
+type BancorUpdateReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Stock   string       `json:"stock"`
+	Money   string       `json:"money"`
+	// optional, the fields not specified are left unchanged
+	MaxSupply          string `json:"max_supply"`
+	EarliestCancelTime string `json:"earliest_cancel_time"`
+	WithdrawMoney      string `json:"withdraw_money"`
+}
+
+var _ restutil.RestReq = (*BancorUpdateReq)(nil)
+
+func (req *BancorUpdateReq) New() restutil.RestReq {
+	return new(BancorUpdateReq)
+}
+func (req *BancorUpdateReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *BancorUpdateReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	maxSupply, withdrawMoney := sdk.ZeroInt(), sdk.ZeroInt()
+	var ok bool
+	if req.MaxSupply != "" {
+		if maxSupply, ok = sdk.NewIntFromString(req.MaxSupply); !ok {
+			return nil, errors.New("invalid max supply")
+		}
+	}
+	if req.WithdrawMoney != "" {
+		if withdrawMoney, ok = sdk.NewIntFromString(req.WithdrawMoney); !ok {
+			return nil, errors.New("invalid withdraw money")
+		}
+	}
+	var time int64
+	if req.EarliestCancelTime != "" {
+		var err error
+		if time, err = strconv.ParseInt(req.EarliestCancelTime, 10, 64); err != nil {
+			return nil, errors.New("invalid earliest cancel time")
+		}
+	}
+	return &types.MsgBancorUpdate{
+		Owner:              sender,
+		Stock:              req.Stock,
+		Money:              req.Money,
+		MaxSupply:          maxSupply,
+		EarliestCancelTime: time,
+		WithdrawMoney:      withdrawMoney,
+	}, nil
+}
+
+func bancorUpdateHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(BancorUpdateReq))
+}
+
+type LiquidityPoolInitReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Stock       string       `json:"stock"`
+	Money       string       `json:"money"`
+	ShareSymbol string       `json:"share_symbol"`
+	StockAmount string       `json:"stock_amount"`
+	MoneyAmount string       `json:"money_amount"`
+	FeeRate     string       `json:"fee_rate"`
+}
+
+var _ restutil.RestReq = (*LiquidityPoolInitReq)(nil)
+
+func (req *LiquidityPoolInitReq) New() restutil.RestReq {
+	return new(LiquidityPoolInitReq)
+}
+func (req *LiquidityPoolInitReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *LiquidityPoolInitReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	stockAmount, moneyAmount, err := parseLiquidityAmounts(req.StockAmount, req.MoneyAmount)
+	if err != nil {
+		return nil, err
+	}
+	var feeRate int64
+	if req.FeeRate != "" {
+		if feeRate, err = strconv.ParseInt(req.FeeRate, 10, 64); err != nil {
+			return nil, errors.New("invalid fee rate")
+		}
+	}
+	return &types.MsgLiquidityPoolInit{
+		Sender:      sender,
+		Stock:       req.Stock,
+		Money:       req.Money,
+		ShareSymbol: req.ShareSymbol,
+		StockAmount: stockAmount,
+		MoneyAmount: moneyAmount,
+		FeeRate:     feeRate,
+	}, nil
+}
+
+func liquidityPoolInitHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(LiquidityPoolInitReq))
+}
+
+type LiquidityPoolAddReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Stock       string       `json:"stock"`
+	Money       string       `json:"money"`
+	StockAmount string       `json:"stock_amount"`
+	MoneyAmount string       `json:"money_amount"`
+}
+
+var _ restutil.RestReq = (*LiquidityPoolAddReq)(nil)
+
+func (req *LiquidityPoolAddReq) New() restutil.RestReq {
+	return new(LiquidityPoolAddReq)
+}
+func (req *LiquidityPoolAddReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *LiquidityPoolAddReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	stockAmount, moneyAmount, err := parseLiquidityAmounts(req.StockAmount, req.MoneyAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgLiquidityPoolAdd{
+		Sender:      sender,
+		Stock:       req.Stock,
+		Money:       req.Money,
+		StockAmount: stockAmount,
+		MoneyAmount: moneyAmount,
+	}, nil
+}
+
+func liquidityPoolAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(LiquidityPoolAddReq))
+}
+
+func parseLiquidityAmounts(stock, money string) (stockAmount, moneyAmount sdk.Int, err error) {
+	stockAmount, ok := sdk.NewIntFromString(stock)
+	if !ok {
+		return stockAmount, moneyAmount, errors.New("invalid stock amount")
+	}
+	moneyAmount, ok = sdk.NewIntFromString(money)
+	if !ok {
+		return stockAmount, moneyAmount, errors.New("invalid money amount")
+	}
+	return stockAmount, moneyAmount, nil
+}
+
+type LiquidityPoolWithdrawReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Stock   string       `json:"stock"`
+	Money   string       `json:"money"`
+	Shares  string       `json:"shares"`
+}
+
+var _ restutil.RestReq = (*LiquidityPoolWithdrawReq)(nil)
+
+func (req *LiquidityPoolWithdrawReq) New() restutil.RestReq {
+	return new(LiquidityPoolWithdrawReq)
+}
+func (req *LiquidityPoolWithdrawReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+
+func (req *LiquidityPoolWithdrawReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	shares, ok := sdk.NewIntFromString(req.Shares)
+	if !ok {
+		return nil, errors.New("invalid shares")
+	}
+	return &types.MsgLiquidityPoolWithdraw{
+		Sender: sender,
+		Stock:  req.Stock,
+		Money:  req.Money,
+		Shares: shares,
+	}, nil
+}
+
+func liquidityPoolWithdrawHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(LiquidityPoolWithdrawReq))
+}
@@ -7,6 +7,14 @@ var (
 	EventTypeKeyBancorInit   = "bancor_init"
 	EventTypeKeyBancorTrade  = "bancor_trade"
 	EventTypeKeyBancorCancel = "bancor_cancel"
+	EventTypeKeyBancorUpdate = "bancor_update"
+
+	EventTypeKeyBancorRouteTrade = "bancor_route_trade"
+
+	EventTypeKeyLiquidityPoolInit     = "liquidity_pool_init"
+	EventTypeKeyLiquidityPoolAdd      = "liquidity_pool_add"
+	EventTypeKeyLiquidityPoolWithdraw = "liquidity_pool_withdraw"
+	EventTypeKeyLiquidityPoolTrade    = "liquidity_pool_trade"
 
 	AttributeSymbol         = "symbol"
 	AttributeOwner          = "bancor_owner"
@@ -19,9 +27,29 @@ var (
 	AttributeTradeSide      = "bancor_trade_side"
 	AttributeRebateReferee  = "rebate_referee"
 	AttributeRebateAmount   = "rebate_amount"
+	AttributeStockFrozen    = "bancor_stock_frozen"
+	AttributeMoneyWithdrawn = "bancor_money_withdrawn"
+	AttributeAuctionPremium = "bancor_auction_premium"
+
+	AttributeBancorAmount = "bancor_amount"
+	AttributeMarketAmount = "market_amount"
+	AttributeMarketMoney  = "market_money"
+	AttributeMarketPrice  = "market_price"
+	AttributeMarketOrder  = "market_order"
+
+	AttributeShareSymbol      = "share_symbol"
+	AttributeShares           = "shares"
+	AttributeLiquidityPoolFee = "liquidity_pool_fee"
 
 	KafkaBancorTrade  = "bancor_trade"
 	KafkaBancorCreate = "bancor_create"
 	KafkaBancorCancel = "bancor_cancel"
+	KafkaBancorUpdate = "bancor_update"
 	KafkaBancorInfo   = "bancor_info"
+
+	KafkaBancorRouteTrade = "bancor_route_trade"
+
+	KafkaLiquidityPoolChange = "liquidity_pool_change"
+	KafkaLiquidityPoolTrade  = "liquidity_pool_trade"
+	KafkaLiquidityPoolInfo   = "liquidity_pool_info"
 )
@@ -14,6 +14,8 @@ import (
 type GenesisState struct {
 	Params        types.Params                  `json:"params"`
 	BancorInfoMap map[string]keepers.BancorInfo `json:"bancor_info_map"`
+	// the liquidity pools, omitted by the genesis files exported before they are introduced
+	LiquidityPoolMap map[string]keepers.LiquidityPool `json:"liquidity_pool_map,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -34,6 +36,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, bi := range data.BancorInfoMap {
 		keeper.Save(ctx, &bi)
 	}
+	for _, lp := range data.LiquidityPoolMap {
+		keeper.SaveLiquidityPool(ctx, &lp)
+	}
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -43,7 +48,14 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	k.Iterate(ctx, func(bi *keepers.BancorInfo) {
 		m[bi.GetSymbol()] = *bi
 	})
-	return NewGenesisState(k.GetParams(ctx), m)
+	gs := NewGenesisState(k.GetParams(ctx), m)
+	if pools := k.GetAllLiquidityPools(ctx); len(pools) != 0 {
+		gs.LiquidityPoolMap = make(map[string]keepers.LiquidityPool, len(pools))
+		for _, lp := range pools {
+			gs.LiquidityPoolMap[lp.GetSymbol()] = *lp
+		}
+	}
+	return gs
 }
 
 func (data GenesisState) Validate() error {
@@ -95,5 +107,19 @@ func (data GenesisState) Validate() error {
 			return errors.New("BancorInfo is not consistent")
 		}
 	}
+	for symbol, lp := range data.LiquidityPoolMap {
+		if symbol != lp.GetSymbol() {
+			return errors.New("invalid symbol of liquidity pool")
+		}
+		if len(lp.Stock) == 0 || len(lp.Money) == 0 || lp.Stock == dex.CET {
+			return errors.New("invalid stock or money of liquidity pool")
+		}
+		if _, ok := data.BancorInfoMap[symbol]; ok {
+			return errors.New("liquidity pool and bancor pool of the same symbol")
+		}
+		if !lp.IsConsistent() {
+			return errors.New("LiquidityPool is not consistent")
+		}
+	}
 	return data.Params.ValidateGenesis()
 }
@@ -1,8 +1,11 @@
 package bancorlite_test
 
 import (
+	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/coinexchain/cet-sdk/modules/bancorlite"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
 
@@ -34,6 +37,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						TradeFeeRate:    0,
 					},
 					make(map[string]bancorlite.BancorInfo),
+					nil,
 				},
 			},
 			false,
@@ -47,6 +51,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						TradeFeeRate:    100,
 					},
 					make(map[string]bancorlite.BancorInfo),
+					nil,
 				},
 			},
 			true,
@@ -71,3 +76,17 @@ func TestGenesis_ExportImport(t *testing.T) {
 	require.Equal(t, genesisState, exportState)
 
 }
+
+func TestGenesis_LegacyBancorInfo(t *testing.T) {
+	// pools exported before curve shapes were added have no curve fields
+	legacy := fmt.Sprintf(`{"params":{"create_bancor_fee":"1","cancel_bancor_fee":"10","trade_fee_rate":"100"},
+"bancor_info_map":{"abc/cet":{"sender":"%s","stock":"abc","money":"cet",
+"init_price":"0.000000000000000000","max_supply":"100","stock_precision":0,"max_price":"10.000000000000000000",
+"max_money":"0","ar":"0","price":"1.000000000000000000","stock_in_pool":"90","money_in_pool":"5","earliest_cancel_time":"100"}}}`, sdk.AccAddress(make([]byte, 20)))
+	var gs bancorlite.GenesisState
+	require.Nil(t, types.ModuleCdc.UnmarshalJSON([]byte(legacy), &gs))
+	require.Nil(t, gs.Validate())
+	bi := gs.BancorInfoMap["abc/cet"]
+	require.Equal(t, types.CurveDefault, bi.CurveType)
+	require.Equal(t, types.CurveLinear, bi.CurveName())
+}
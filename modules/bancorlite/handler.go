@@ -2,6 +2,8 @@ package bancorlite
 
 import (
 	"bytes"
+	"math"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -23,6 +25,16 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgBancorTrade(ctx, k, msg)
 		case types.MsgBancorCancel:
 			return handleMsgBancorCancel(ctx, k, msg)
+		case types.MsgBancorUpdate:
+			return handleMsgBancorUpdate(ctx, k, msg)
+		case types.MsgBancorRouteTrade:
+			return handleMsgBancorRouteTrade(ctx, k, msg)
+		case types.MsgLiquidityPoolInit:
+			return handleMsgLiquidityPoolInit(ctx, k, msg)
+		case types.MsgLiquidityPoolAdd:
+			return handleMsgLiquidityPoolAdd(ctx, k, msg)
+		case types.MsgLiquidityPoolWithdraw:
+			return handleMsgLiquidityPoolWithdraw(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -30,7 +42,7 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleMsgBancorInit(ctx sdk.Context, k Keeper, msg types.MsgBancorInit) sdk.Result {
-	if bi := k.Load(ctx, msg.GetSymbol()); bi != nil {
+	if bi := k.Load(ctx, msg.GetSymbol()); bi != nil || k.LoadLiquidityPool(ctx, msg.GetSymbol()) != nil {
 		return types.ErrBancorAlreadyExists().Result()
 	}
 	if !k.IsTokenExists(ctx, msg.Stock) || !k.IsTokenExists(ctx, msg.Money) {
@@ -43,7 +55,7 @@ func handleMsgBancorInit(ctx sdk.Context, k Keeper, msg types.MsgBancorInit) sdk
 	if err := k.FreezeCoins(ctx, msg.Owner, suppliedCoins); err != nil {
 		return err.Result()
 	}
-	fee := k.GetParams(ctx).CreateBancorFee
+	fee := k.GetCreateBancorFee(ctx)
 	if err := k.DeductInt64CetFee(ctx, msg.Owner, fee); err != nil {
 		return err.Result()
 	}
@@ -60,7 +72,23 @@ func handleMsgBancorInit(ctx sdk.Context, k Keeper, msg types.MsgBancorInit) sdk
 		return types.ErrPriceFmt().Result()
 	}
 
-	ar := types.CalculateAR(msg, initPrice, maxPrice)
+	maxPriceChange, err := msg.GetMaxPriceChange()
+	if err != nil {
+		return err.Result()
+	}
+	auctionStartPrice, err := msg.GetAuctionStartPrice()
+	if err != nil {
+		return err.Result()
+	}
+
+	var ar int64
+	maxMoney := msg.MaxMoney
+	if msg.CurveType == types.CurveDefault {
+		ar = types.CalculateAR(msg, initPrice, maxPrice)
+	} else {
+		curve := types.NewCurve(msg.CurveType, initPrice, maxPrice, msg.MaxSupply, msg.Steepness, msg.Tranches)
+		_, maxMoney, _ = curve.PriceAndMoney(msg.MaxSupply)
+	}
 
 	bi := &keepers.BancorInfo{
 		Owner:              msg.Owner,
@@ -70,12 +98,26 @@ func handleMsgBancorInit(ctx sdk.Context, k Keeper, msg types.MsgBancorInit) sdk
 		MaxSupply:          msg.MaxSupply,
 		StockPrecision:     precision,
 		MaxPrice:           maxPrice,
-		MaxMoney:           msg.MaxMoney,
+		MaxMoney:           maxMoney,
 		AR:                 ar,
 		Price:              initPrice,
 		StockInPool:        msg.MaxSupply,
 		MoneyInPool:        sdk.ZeroInt(),
 		EarliestCancelTime: msg.EarliestCancelTime,
+		CurveType:          msg.CurveType,
+		Steepness:          msg.Steepness,
+		Tranches:           msg.Tranches,
+		MaxStockPerTrade:   msg.MaxStockPerTrade,
+		MaxStockPerBlock:   msg.MaxStockPerBlock,
+		MaxPriceChange:     maxPriceChange,
+		// the pool starts at the init price in the block where it is created
+		LastTradeHeight: ctx.BlockHeight(),
+		BlockStartPrice: initPrice,
+	}
+	if auctionStartPrice.IsPositive() {
+		bi.AuctionStartPrice = auctionStartPrice
+		bi.AuctionStartTime = ctx.BlockHeader().Time.Unix()
+		bi.AuctionEndTime = bi.AuctionStartTime + msg.AuctionDuration
 	}
 	k.Save(ctx, bi)
 	info := keepers.NewBancorInfoDisplay(bi)
@@ -139,84 +181,524 @@ func handleMsgBancorCancel(ctx sdk.Context, k Keeper, msg types.MsgBancorCancel)
 	}
 }
 
+func handleMsgBancorUpdate(ctx sdk.Context, k Keeper, msg types.MsgBancorUpdate) sdk.Result {
+	bi := k.Load(ctx, msg.GetSymbol())
+	if bi == nil {
+		return types.ErrNoBancorExists().Result()
+	}
+	if !bytes.Equal(bi.Owner, msg.Owner) {
+		return types.ErrNotBancorOwner().Result()
+	}
+	biNew := *bi
+	if msg.EarliestCancelTime != 0 {
+		if msg.EarliestCancelTime < bi.EarliestCancelTime {
+			return types.ErrInvalidBancorUpdate("earliest cancel time can only be extended").Result()
+		}
+		biNew.EarliestCancelTime = msg.EarliestCancelTime
+	}
+	if msg.MaxSupply.IsPositive() && !msg.MaxSupply.Equal(bi.MaxSupply) {
+		if msg.MaxSupply.LT(bi.MaxSupply) {
+			return types.ErrInvalidBancorUpdate("max supply can only be raised").Result()
+		}
+		raised, ok := biNew.RaiseMaxSupply(msg.MaxSupply)
+		if !ok {
+			return types.ErrInvalidBancorUpdate("the curve can not be extended to the max supply without changing the money in pool").Result()
+		}
+		if !raised.MoneyInPool.Equal(bi.MoneyInPool) {
+			return types.ErrInvalidBancorUpdate("raising the max supply must not change the money in pool").Result()
+		}
+		biNew = raised
+	}
+	if msg.WithdrawMoney.IsPositive() {
+		withdrawn, _, _, ok := biNew.WithdrawMoney(msg.WithdrawMoney)
+		if !ok {
+			return types.ErrInvalidBancorUpdate("the money is too little to withdraw").Result()
+		}
+		biNew = withdrawn
+	}
+	if !biNew.IsConsistent() {
+		return types.ErrInvalidBancorUpdate("the pool is not consistent after update").Result()
+	}
+	// the stock goes into the pool and the money comes out of it
+	stockFrozen := biNew.StockInPool.Sub(bi.StockInPool)
+	moneyWithdrawn := bi.MoneyInPool.Sub(biNew.MoneyInPool)
+	if stockFrozen.IsNegative() || moneyWithdrawn.IsNegative() {
+		return types.ErrInvalidBancorUpdate("the pool needs more money after update").Result()
+	}
+	if stockFrozen.IsPositive() {
+		if err := k.FreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewCoin(bi.Stock, stockFrozen))); err != nil {
+			return err.Result()
+		}
+	}
+	if moneyWithdrawn.IsPositive() {
+		if err := k.UnFreezeCoins(ctx, bi.Owner, sdk.NewCoins(sdk.NewCoin(bi.Money, moneyWithdrawn))); err != nil {
+			return err.Result()
+		}
+	}
+	k.Save(ctx, &biNew)
+	fillMsgQueue(ctx, k, KafkaBancorUpdate, keepers.NewBancorInfoDisplay(&biNew))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyBancorUpdate,
+			sdk.NewAttribute(AttributeSymbol, bi.GetSymbol()),
+			sdk.NewAttribute(AttributeMaxSupply, biNew.MaxSupply.String()),
+			sdk.NewAttribute(AttributeNewStockInPool, biNew.StockInPool.String()),
+			sdk.NewAttribute(AttributeNewMoneyInPool, biNew.MoneyInPool.String()),
+			sdk.NewAttribute(AttributeStockFrozen, stockFrozen.String()),
+			sdk.NewAttribute(AttributeMoneyWithdrawn, moneyWithdrawn.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) sdk.Result {
 	bi := k.Load(ctx, msg.GetSymbol())
 	if bi == nil {
+		if lp := k.LoadLiquidityPool(ctx, msg.GetSymbol()); lp != nil {
+			return handleLiquidityPoolTrade(ctx, k, lp, msg)
+		}
 		return types.ErrNoBancorExists().Result()
 	}
-	if bytes.Equal(bi.Owner, msg.Sender) {
-		return types.ErrOwnerIsProhibited().Result()
+	if err := checkBancorTrader(ctx, k, bi, msg.Sender); err != nil {
+		return err.Result()
 	}
-	if k.IsForbiddenByTokenIssuer(ctx, bi.Stock, msg.Sender) ||
-		k.IsForbiddenByTokenIssuer(ctx, bi.Money, msg.Sender) ||
-		k.IsForbiddenByTokenIssuer(ctx, bi.Stock, bi.Owner) ||
-		k.IsForbiddenByTokenIssuer(ctx, bi.Money, bi.Owner) {
-		return types.ErrTokenForbiddenByOwner().Result()
+	if msg.IsMoneyDenominated() {
+		amount := bi.MaxStockForMoneyAt(sdk.NewInt(msg.MoneyAmount), msg.IsBuy, ctx.BlockHeader().Time.Unix())
+		if !amount.IsPositive() {
+			return types.ErrMoneyAmountTooSmall().Result()
+		}
+		msg.Amount = amount.Int64()
 	}
-	if !types.CheckStockPrecision(sdk.NewInt(msg.Amount), bi.StockPrecision) {
-		return types.ErrStockAmountPrecisionNotMatch().Result()
+	tr, err := calculateTrade(ctx, k, bi, msg)
+	if err != nil {
+		return err.Result()
 	}
-	stockInPool := bi.StockInPool.AddRaw(msg.Amount)
+	if err := tr.pay(ctx, k, msg.Sender); err != nil {
+		return err.Result()
+	}
+
+	if err := swapStockAndMoney(ctx, k, msg.Sender, bi.Owner, tr.coinsFromPool, tr.coinsToPool); err != nil {
+		return err.Result()
+	}
+	if err := tr.payPremium(ctx, k, msg.Sender, bi.Owner, bi.Money); err != nil {
+		return err.Result()
+	}
+
+	k.Save(ctx, &tr.biNew)
+	if bi.MaxStockPerBlock > 0 {
+		k.AddBlockVolume(ctx, bi.GetSymbol(), msg.Sender, msg.Amount)
+	}
+
+	sideStr := "sell"
+	side := market.SELL
 	if msg.IsBuy {
-		stockInPool = bi.StockInPool.SubRaw(msg.Amount)
+		sideStr = "buy"
+		side = market.BUY
 	}
-	biNew := *bi
-	if ok := biNew.UpdateStockInPool(stockInPool); !ok {
-		return types.ErrStockInPoolOutofBound().Result()
+
+	m := types.MsgBancorTradeInfoForKafka{
+		Sender:            msg.Sender,
+		Stock:             msg.Stock,
+		Money:             msg.Money,
+		Amount:            msg.Amount,
+		Side:              byte(side),
+		MoneyLimit:        msg.MoneyLimit,
+		MoneyAmount:       msg.MoneyAmount,
+		TxPrice:           sdk.NewDecFromInt(tr.money()).QuoInt64(msg.Amount),
+		UsedCommission:    tr.balance.Int64(),
+		RebateAmount:      tr.rebate.Int64(),
+		RebateRefereeAddr: tr.rebateAcc,
+		BlockHeight:       ctx.BlockHeight(),
+		AuctionPremium:    tr.premium.Int64(),
 	}
+	info := keepers.NewBancorInfoDisplayAt(&tr.biNew, ctx.BlockHeader().Time.Unix())
+	fillMsgQueue(ctx, k, KafkaBancorTrade, m)
+	fillMsgQueue(ctx, k, KafkaBancorInfo, info)
 
-	var (
-		diff            sdk.Int
-		coinsFromPool   sdk.Coins
-		coinsToPool     sdk.Coins
-		moneyCrossLimit bool
-		moneyErr        string
-	)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyBancorTrade,
+			sdk.NewAttribute(AttributeSymbol, bi.GetSymbol()),
+			sdk.NewAttribute(AttributeNewStockInPool, tr.biNew.StockInPool.String()),
+			sdk.NewAttribute(AttributeNewMoneyInPool, tr.biNew.MoneyInPool.String()),
+			sdk.NewAttribute(AttributeNewPrice, info.CurrentPrice),
+			sdk.NewAttribute(AttributeTradeSide, sideStr),
+			sdk.NewAttribute(AttributeCoinsFromPool, tr.coinsFromPool.String()),
+			sdk.NewAttribute(AttributeCoinsToPool, tr.coinsToPool.String()),
+			sdk.NewAttribute(AttributeRebateReferee, tr.rebateAcc.String()),
+			sdk.NewAttribute(AttributeRebateAmount, tr.rebate.String()),
+			sdk.NewAttribute(AttributeAuctionPremium, tr.premium.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
 
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func checkBancorTrader(ctx sdk.Context, k Keeper, bi *keepers.BancorInfo, trader sdk.AccAddress) sdk.Error {
+	if bytes.Equal(bi.Owner, trader) {
+		return types.ErrOwnerIsProhibited()
+	}
+	if k.IsForbiddenByTokenIssuer(ctx, bi.Stock, trader) ||
+		k.IsForbiddenByTokenIssuer(ctx, bi.Money, trader) ||
+		k.IsForbiddenByTokenIssuer(ctx, bi.Stock, bi.Owner) ||
+		k.IsForbiddenByTokenIssuer(ctx, bi.Money, bi.Owner) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	return nil
+}
+
+// handleMsgBancorRouteTrade deals with the resting orders first through an IOC order, which is settled
+// at once, and trades the stock it leaves with the pool. The money limit is checked against the money
+// actually paid or received in both parts.
+func handleMsgBancorRouteTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorRouteTrade) sdk.Result {
+	bi := k.Load(ctx, msg.GetSymbol())
+	if bi == nil {
+		return types.ErrNoBancorExists().Result()
+	}
+	if err := checkBancorTrader(ctx, k, bi, msg.Sender); err != nil {
+		return err.Result()
+	}
+	plan, pricePrecision := planRoute(ctx, k, bi, msg)
+
+	sideStr := "sell"
+	side := market.SELL
 	if msg.IsBuy {
-		diff = biNew.MoneyInPool.Sub(bi.MoneyInPool)
-		if !diff.IsPositive() {
-			return types.ErrTradeMoneyNotPositive().Result()
+		sideStr = "buy"
+		side = market.BUY
+	}
+	orderID := ""
+	marketAmount, marketMoney := int64(0), int64(0)
+	if plan.MarketAmount > 0 {
+		price := plan.MarketPrice.MulInt64(int64(math.Pow10(int(pricePrecision))))
+		if msg.IsBuy {
+			price = price.Ceil()
 		}
-		coinsToPool = sdk.Coins{sdk.NewCoin(msg.Money, diff)}
-		coinsFromPool = sdk.Coins{sdk.NewCoin(msg.Stock, sdk.NewInt(msg.Amount))}
-		moneyCrossLimit = msg.MoneyLimit > 0 && diff.GT(sdk.NewInt(msg.MoneyLimit))
-		moneyErr = "more than"
-	} else {
-		diff = bi.MoneyInPool.Sub(biNew.MoneyInPool)
-		if !diff.IsPositive() {
-			return types.ErrTradeMoneyNotPositive().Result()
+		order, err := k.CreateIOCOrder(ctx, market.MsgCreateOrder{
+			Sender:         msg.Sender,
+			Identify:       types.RouteOrderIdentify,
+			TradingPair:    msg.GetSymbol(),
+			OrderType:      market.LimitOrder,
+			PricePrecision: pricePrecision,
+			Price:          price.TruncateInt64(),
+			Quantity:       plan.MarketAmount,
+			Side:           byte(side),
+			TimeInForce:    market.IOC,
+		})
+		if err != nil {
+			return err.Result()
 		}
-		coinsFromPool = sdk.Coins{sdk.NewCoin(msg.Money, diff)}
-		coinsToPool = sdk.Coins{sdk.NewCoin(msg.Stock, sdk.NewInt(msg.Amount))}
-		moneyCrossLimit = msg.MoneyLimit > 0 && diff.LT(sdk.NewInt(msg.MoneyLimit))
-		moneyErr = "less than"
+		orderID = order.OrderID()
+		marketAmount, marketMoney = order.DealStock, order.DealMoney
 	}
 
-	if moneyCrossLimit {
-		return types.ErrMoneyCrossLimit(moneyErr).Result()
+	// the pool takes what the market leaves, in multiples of its stock unit
+	bancorAmount := msg.Amount - marketAmount
+	bancorAmount -= bancorAmount % bi.StockUnit().Int64()
+	tradeMsg := types.MsgBancorTrade{
+		Sender: msg.Sender,
+		Stock:  msg.Stock,
+		Money:  msg.Money,
+		Amount: bancorAmount,
+		IsBuy:  msg.IsBuy,
+	}
+	tr := tradeResult{biNew: *bi, diff: sdk.ZeroInt(), premium: sdk.ZeroInt()}
+	tr.commission, tr.rebate, tr.balance = sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+	if bancorAmount > 0 {
+		var err sdk.Error
+		if tr, err = calculateTrade(ctx, k, bi, tradeMsg); err != nil {
+			return err.Result()
+		}
+	}
+	tradeMsg.MoneyLimit = msg.MoneyLimit
+	if err := checkMoneyLimit(tradeMsg, tr.money().AddRaw(marketMoney)); err != nil {
+		return err.Result()
 	}
 
-	commission := getTradeFee(ctx, k, msg, diff)
-	rebateAcc, rebate, balance, exist := k.GetRebate(ctx, msg.Sender, commission)
-	if exist {
-		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, balance))); err != nil {
+	if bancorAmount > 0 {
+		if err := tr.pay(ctx, k, msg.Sender); err != nil {
 			return err.Result()
 		}
-		if err := k.SendCoins(ctx, msg.Sender, rebateAcc, sdk.NewCoins(sdk.NewCoin(dex.CET, rebate))); err != nil {
+		if err := swapStockAndMoney(ctx, k, msg.Sender, bi.Owner, tr.coinsFromPool, tr.coinsToPool); err != nil {
 			return err.Result()
 		}
-	} else {
-		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, commission))); err != nil {
+		if err := tr.payPremium(ctx, k, msg.Sender, bi.Owner, bi.Money); err != nil {
 			return err.Result()
 		}
+		k.Save(ctx, &tr.biNew)
+		if bi.MaxStockPerBlock > 0 {
+			k.AddBlockVolume(ctx, bi.GetSymbol(), msg.Sender, bancorAmount)
+		}
+	}
+
+	m := types.MsgBancorRouteTradeForKafka{
+		Sender:            msg.Sender,
+		Stock:             msg.Stock,
+		Money:             msg.Money,
+		Amount:            msg.Amount,
+		Side:              byte(side),
+		MoneyLimit:        msg.MoneyLimit,
+		BancorAmount:      bancorAmount,
+		BancorMoney:       tr.money().Int64(),
+		MarketAmount:      marketAmount,
+		MarketMoney:       marketMoney,
+		MarketPrice:       plan.MarketPrice,
+		MarketOrderID:     orderID,
+		UsedCommission:    tr.balance.Int64(),
+		RebateAmount:      tr.rebate.Int64(),
+		RebateRefereeAddr: tr.rebateAcc,
+		BlockHeight:       ctx.BlockHeight(),
+	}
+	info := keepers.NewBancorInfoDisplayAt(&tr.biNew, ctx.BlockHeader().Time.Unix())
+	fillMsgQueue(ctx, k, KafkaBancorRouteTrade, m)
+	if bancorAmount > 0 {
+		fillMsgQueue(ctx, k, KafkaBancorInfo, info)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyBancorRouteTrade,
+			sdk.NewAttribute(AttributeSymbol, bi.GetSymbol()),
+			sdk.NewAttribute(AttributeTradeSide, sideStr),
+			sdk.NewAttribute(AttributeBancorAmount, strconv.FormatInt(bancorAmount, 10)),
+			sdk.NewAttribute(AttributeCoinsFromPool, tr.coinsFromPool.String()),
+			sdk.NewAttribute(AttributeCoinsToPool, tr.coinsToPool.String()),
+			sdk.NewAttribute(AttributeNewPrice, info.CurrentPrice),
+			sdk.NewAttribute(AttributeMarketAmount, strconv.FormatInt(marketAmount, 10)),
+			sdk.NewAttribute(AttributeMarketMoney, strconv.FormatInt(marketMoney, 10)),
+			sdk.NewAttribute(AttributeMarketPrice, plan.MarketPrice.String()),
+			sdk.NewAttribute(AttributeMarketOrder, orderID),
+			sdk.NewAttribute(AttributeRebateReferee, tr.rebateAcc.String()),
+			sdk.NewAttribute(AttributeRebateAmount, tr.rebate.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// planRoute splits the trade between the pool and the market, and returns the price precision
+// of the market. All goes to the pool when there is no market to route to.
+func planRoute(ctx sdk.Context, k Keeper, bi *keepers.BancorInfo, msg types.MsgBancorRouteTrade) (keepers.RoutePlan, byte) {
+	allToPool := keepers.RoutePlan{BancorAmount: msg.Amount, MarketPrice: sdk.ZeroDec()}
+	if !k.CanRouteToMarket() {
+		return allToPool, 0
+	}
+	info, err := k.GetMarketInfo(ctx, msg.GetSymbol())
+	if err != nil {
+		return allToPool, 0
+	}
+	// no unit of stock can be bought for more than the money limit, or the max order price
+	side, limit := byte(market.ASK), sdk.ZeroDec()
+	if msg.IsBuy {
+		side, limit = market.BID, sdk.NewDec(math.MaxInt64)
+		if msg.MoneyLimit > 0 {
+			limit = sdk.NewDec(msg.MoneyLimit)
+		}
 	}
+	orders := k.GetOrdersCrossingPrice(ctx, msg.GetSymbol(), side, limit)
+	plan := keepers.PlanRoute(bi, orders, msg.Amount, msg.IsBuy, market.GetGranularityOfOrder(info.OrderPrecision),
+		ctx.BlockHeader().Time.Unix())
+	return plan, info.PricePrecision
+}
 
-	if err := swapStockAndMoney(ctx, k, msg.Sender, bi.Owner, coinsFromPool, coinsToPool); err != nil {
+func handleMsgLiquidityPoolInit(ctx sdk.Context, k Keeper, msg types.MsgLiquidityPoolInit) sdk.Result {
+	if k.Load(ctx, msg.GetSymbol()) != nil || k.LoadLiquidityPool(ctx, msg.GetSymbol()) != nil {
+		return types.ErrBancorAlreadyExists().Result()
+	}
+	if !k.IsTokenExists(ctx, msg.Stock) || !k.IsTokenExists(ctx, msg.Money) {
+		return types.ErrNoSuchToken().Result()
+	}
+	// the share token is charged like any other token of its symbol
+	fee := k.GetCreateBancorFee(ctx) + k.GetIssueShareTokenFee(ctx, msg.ShareSymbol)
+	if err := k.DeductInt64CetFee(ctx, msg.Sender, fee); err != nil {
 		return err.Result()
 	}
+	lp := &keepers.LiquidityPool{
+		Stock:       msg.Stock,
+		Money:       msg.Money,
+		ShareSymbol: msg.ShareSymbol,
+		FeeRate:     msg.FeeRate,
+		StockInPool: sdk.ZeroInt(),
+		MoneyInPool: sdk.ZeroInt(),
+		TotalShares: sdk.ZeroInt(),
+	}
+	shares, stock, money, err := provideLiquidity(ctx, k, lp, msg.Sender, msg.StockAmount, msg.MoneyAmount)
+	if err != nil {
+		return err.Result()
+	}
+	return liquidityChangedResult(ctx, k, lp, msg.Sender, shares, stock, money, EventTypeKeyLiquidityPoolInit, true)
+}
 
-	k.Save(ctx, &biNew)
+func handleMsgLiquidityPoolAdd(ctx sdk.Context, k Keeper, msg types.MsgLiquidityPoolAdd) sdk.Result {
+	lp := k.LoadLiquidityPool(ctx, msg.GetSymbol())
+	if lp == nil {
+		return types.ErrNoLiquidityPoolExists().Result()
+	}
+	shares, stock, money, err := provideLiquidity(ctx, k, lp, msg.Sender, msg.StockAmount, msg.MoneyAmount)
+	if err != nil {
+		return err.Result()
+	}
+	return liquidityChangedResult(ctx, k, lp, msg.Sender, shares, stock, money, EventTypeKeyLiquidityPoolAdd, true)
+}
+
+// provideLiquidity moves the stock and money of a provider into the pool, and gives
+// the provider share tokens in return. The share token is issued when the pool is created,
+// and MinimumLiquidity of the first shares are locked in the pool.
+func provideLiquidity(ctx sdk.Context, k Keeper, lp *keepers.LiquidityPool, provider sdk.AccAddress,
+	stockMax, moneyMax sdk.Int) (shares, stock, money sdk.Int, err sdk.Error) {
+	if k.IsForbiddenByTokenIssuer(ctx, lp.Stock, provider) || k.IsForbiddenByTokenIssuer(ctx, lp.Money, provider) {
+		return shares, stock, money, types.ErrTokenForbiddenByOwner()
+	}
+	shares, stock, money = lp.AddLiquidity(stockMax, moneyMax)
+	locked := sdk.ZeroInt()
+	if lp.IsEmpty() {
+		locked = sdk.NewInt(keepers.MinimumLiquidity)
+	}
+	if !shares.GT(locked) || !stock.IsPositive() || !money.IsPositive() {
+		return shares, stock, money, types.ErrLiquidityTooSmall()
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(lp.Stock, stock), sdk.NewCoin(lp.Money, money))
+	k.SetReserveAccount(ctx, lp)
+	if err = k.SendCoins(ctx, provider, lp.Address(), coins); err != nil {
+		return
+	}
+	if err = k.FreezeCoins(ctx, lp.Address(), coins); err != nil {
+		return
+	}
+	if locked.IsPositive() {
+		if err = k.IssueShareToken(ctx, lp, locked); err != nil {
+			return
+		}
+	}
+	if err = k.MintShareToken(ctx, lp, provider, shares.Sub(locked)); err != nil {
+		return
+	}
+	lp.StockInPool = lp.StockInPool.Add(stock)
+	lp.MoneyInPool = lp.MoneyInPool.Add(money)
+	lp.TotalShares = lp.TotalShares.Add(shares)
+	k.SaveLiquidityPool(ctx, lp)
+	return shares.Sub(locked), stock, money, nil
+}
+
+func handleMsgLiquidityPoolWithdraw(ctx sdk.Context, k Keeper, msg types.MsgLiquidityPoolWithdraw) sdk.Result {
+	lp := k.LoadLiquidityPool(ctx, msg.GetSymbol())
+	if lp == nil {
+		return types.ErrNoLiquidityPoolExists().Result()
+	}
+	if msg.Shares.GT(lp.TotalShares) {
+		return sdk.ErrInsufficientCoins("shares are more than the total shares of the pool").Result()
+	}
+	stock, money := lp.Withdraw(msg.Shares)
+	if !stock.IsPositive() && !money.IsPositive() {
+		return types.ErrLiquidityTooSmall().Result()
+	}
+	if err := k.BurnShareToken(ctx, lp, msg.Sender, msg.Shares); err != nil {
+		return err.Result()
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(lp.Stock, stock), sdk.NewCoin(lp.Money, money))
+	if err := k.UnFreezeCoins(ctx, lp.Address(), coins); err != nil {
+		return err.Result()
+	}
+	if err := k.SendCoins(ctx, lp.Address(), msg.Sender, coins); err != nil {
+		return err.Result()
+	}
+	lp.StockInPool = lp.StockInPool.Sub(stock)
+	lp.MoneyInPool = lp.MoneyInPool.Sub(money)
+	lp.TotalShares = lp.TotalShares.Sub(msg.Shares)
+	k.SaveLiquidityPool(ctx, lp)
+	return liquidityChangedResult(ctx, k, lp, msg.Sender, msg.Shares, stock, money, EventTypeKeyLiquidityPoolWithdraw, false)
+}
+
+func liquidityChangedResult(ctx sdk.Context, k Keeper, lp *keepers.LiquidityPool, provider sdk.AccAddress,
+	shares, stock, money sdk.Int, eventType string, isAdd bool) sdk.Result {
+	m := types.MsgLiquidityPoolChangeForKafka{
+		Sender:      provider,
+		Stock:       lp.Stock,
+		Money:       lp.Money,
+		ShareSymbol: lp.ShareSymbol,
+		Shares:      shares,
+		StockAmount: stock,
+		MoneyAmount: money,
+		IsAdd:       isAdd,
+		BlockHeight: ctx.BlockHeight(),
+	}
+	fillMsgQueue(ctx, k, KafkaLiquidityPoolChange, m)
+	fillMsgQueue(ctx, k, KafkaLiquidityPoolInfo, keepers.NewLiquidityPoolDisplay(lp))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(AttributeSymbol, lp.GetSymbol()),
+			sdk.NewAttribute(AttributeShareSymbol, lp.ShareSymbol),
+			sdk.NewAttribute(AttributeShares, shares.String()),
+			sdk.NewAttribute(AttributeNewStockInPool, lp.StockInPool.String()),
+			sdk.NewAttribute(AttributeNewMoneyInPool, lp.MoneyInPool.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, provider.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleLiquidityPoolTrade(ctx sdk.Context, k Keeper, lp *keepers.LiquidityPool, msg types.MsgBancorTrade) sdk.Result {
+	if k.IsForbiddenByTokenIssuer(ctx, lp.Stock, msg.Sender) ||
+		k.IsForbiddenByTokenIssuer(ctx, lp.Money, msg.Sender) {
+		return types.ErrTokenForbiddenByOwner().Result()
+	}
+	if msg.IsMoneyDenominated() {
+		amount := lp.MaxStockForMoney(sdk.NewInt(msg.MoneyAmount), msg.IsBuy)
+		if !amount.IsPositive() {
+			return types.ErrMoneyAmountTooSmall().Result()
+		}
+		msg.Amount = amount.Int64()
+	}
+	if msg.IsBuy && lp.StockInPool.LTE(sdk.NewInt(msg.Amount)) {
+		return types.ErrStockInPoolOutofBound().Result()
+	}
+	lpNew, money, poolFee, ok := lp.Trade(sdk.NewInt(msg.Amount), msg.IsBuy)
+	if !ok {
+		return types.ErrTradeMoneyNotPositive().Result()
+	}
+	coinsFromPool, coinsToPool := tradeCoins(msg, money)
+	if err := checkMoneyLimit(msg, money); err != nil {
+		return err.Result()
+	}
+	tc := calculateCommission(ctx, k, msg, money)
+	if err := tc.pay(ctx, k, msg.Sender); err != nil {
+		return err.Result()
+	}
+	if err := swapStockAndMoney(ctx, k, msg.Sender, lp.Address(), coinsFromPool, coinsToPool); err != nil {
+		return err.Result()
+	}
+	k.SaveLiquidityPool(ctx, &lpNew)
 
 	sideStr := "sell"
 	side := market.SELL
@@ -224,7 +706,6 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		sideStr = "buy"
 		side = market.BUY
 	}
-
 	m := types.MsgBancorTradeInfoForKafka{
 		Sender:            msg.Sender,
 		Stock:             msg.Stock,
@@ -232,28 +713,30 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		Amount:            msg.Amount,
 		Side:              byte(side),
 		MoneyLimit:        msg.MoneyLimit,
-		TxPrice:           sdk.NewDecFromInt(diff).QuoInt64(msg.Amount),
-		UsedCommission:    balance.Int64(),
-		RebateAmount:      rebate.Int64(),
-		RebateRefereeAddr: rebateAcc,
+		MoneyAmount:       msg.MoneyAmount,
+		TxPrice:           sdk.NewDecFromInt(money).QuoInt64(msg.Amount),
+		UsedCommission:    tc.balance.Int64(),
+		RebateAmount:      tc.rebate.Int64(),
+		RebateRefereeAddr: tc.rebateAcc,
 		BlockHeight:       ctx.BlockHeight(),
 	}
-	info := keepers.NewBancorInfoDisplay(&biNew)
-	fillMsgQueue(ctx, k, KafkaBancorTrade, m)
-	fillMsgQueue(ctx, k, KafkaBancorInfo, info)
+	info := keepers.NewLiquidityPoolDisplay(&lpNew)
+	fillMsgQueue(ctx, k, KafkaLiquidityPoolTrade, m)
+	fillMsgQueue(ctx, k, KafkaLiquidityPoolInfo, info)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			EventTypeKeyBancorTrade,
-			sdk.NewAttribute(AttributeSymbol, bi.GetSymbol()),
-			sdk.NewAttribute(AttributeNewStockInPool, biNew.StockInPool.String()),
-			sdk.NewAttribute(AttributeNewMoneyInPool, biNew.MoneyInPool.String()),
+			EventTypeKeyLiquidityPoolTrade,
+			sdk.NewAttribute(AttributeSymbol, lp.GetSymbol()),
+			sdk.NewAttribute(AttributeNewStockInPool, lpNew.StockInPool.String()),
+			sdk.NewAttribute(AttributeNewMoneyInPool, lpNew.MoneyInPool.String()),
 			sdk.NewAttribute(AttributeNewPrice, info.CurrentPrice),
 			sdk.NewAttribute(AttributeTradeSide, sideStr),
 			sdk.NewAttribute(AttributeCoinsFromPool, coinsFromPool.String()),
 			sdk.NewAttribute(AttributeCoinsToPool, coinsToPool.String()),
-			sdk.NewAttribute(AttributeRebateReferee, rebateAcc.String()),
-			sdk.NewAttribute(AttributeRebateAmount, rebate.String()),
+			sdk.NewAttribute(AttributeLiquidityPoolFee, poolFee.String()),
+			sdk.NewAttribute(AttributeRebateReferee, tc.rebateAcc.String()),
+			sdk.NewAttribute(AttributeRebateAmount, tc.rebate.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -267,6 +750,119 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 	}
 }
 
+type tradeCommission struct {
+	commission  sdk.Int
+	rebateAcc   sdk.AccAddress
+	rebate      sdk.Int
+	balance     sdk.Int
+	rebateExist bool
+}
+
+type tradeResult struct {
+	biNew         keepers.BancorInfo
+	diff          sdk.Int // the money amount of this trade, moved into or out of the pool
+	premium       sdk.Int // the auction premium paid by the buyer to the owner over diff
+	coinsFromPool sdk.Coins
+	coinsToPool   sdk.Coins
+	tradeCommission
+}
+
+// money returns the money amount which the trader pays or gets
+func (tr tradeResult) money() sdk.Int {
+	return tr.diff.Add(tr.premium)
+}
+
+// payPremium sends the auction premium from the buyer to the owner, outside the reserve of the pool
+func (tr tradeResult) payPremium(ctx sdk.Context, k Keeper, trader, owner sdk.AccAddress, money string) sdk.Error {
+	if !tr.premium.IsPositive() {
+		return nil
+	}
+	return k.SendCoins(ctx, trader, owner, sdk.NewCoins(sdk.NewCoin(money, tr.premium)))
+}
+
+func calculateCommission(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade, amountOfMoney sdk.Int) (tc tradeCommission) {
+	tc.commission = getTradeFee(ctx, k, msg, amountOfMoney)
+	tc.rebateAcc, tc.rebate, tc.balance, tc.rebateExist = k.GetRebate(ctx, msg.Sender, tc.commission)
+	return
+}
+
+// pay deducts the commission from the trader, part of which goes to the referee as rebate
+func (tc tradeCommission) pay(ctx sdk.Context, k Keeper, trader sdk.AccAddress) sdk.Error {
+	if tc.rebateExist {
+		if err := k.DeductFee(ctx, trader, sdk.NewCoins(sdk.NewCoin(dex.CET, tc.balance))); err != nil {
+			return err
+		}
+		return k.SendCoins(ctx, trader, tc.rebateAcc, sdk.NewCoins(sdk.NewCoin(dex.CET, tc.rebate)))
+	}
+	return k.DeductFee(ctx, trader, sdk.NewCoins(sdk.NewCoin(dex.CET, tc.commission)))
+}
+
+func checkMoneyLimit(msg types.MsgBancorTrade, amountOfMoney sdk.Int) sdk.Error {
+	if msg.MoneyLimit <= 0 {
+		return nil
+	}
+	if msg.IsBuy && amountOfMoney.GT(sdk.NewInt(msg.MoneyLimit)) {
+		return types.ErrMoneyCrossLimit("more than")
+	}
+	if !msg.IsBuy && amountOfMoney.LT(sdk.NewInt(msg.MoneyLimit)) {
+		return types.ErrMoneyCrossLimit("less than")
+	}
+	return nil
+}
+
+// calculateTrade computes the new pool state, the money amount and the fees of a trade,
+// without changing any state
+func calculateTrade(ctx sdk.Context, k Keeper, bi *keepers.BancorInfo, msg types.MsgBancorTrade) (tr tradeResult, err sdk.Error) {
+	if !types.CheckStockPrecision(sdk.NewInt(msg.Amount), bi.StockPrecision) {
+		return tr, types.ErrStockAmountPrecisionNotMatch()
+	}
+	stockInPool := bi.StockInPool.AddRaw(msg.Amount)
+	if msg.IsBuy {
+		stockInPool = bi.StockInPool.SubRaw(msg.Amount)
+	}
+	tr.biNew = *bi
+	if ok := tr.biNew.UpdateStockInPool(stockInPool); !ok {
+		return tr, types.ErrStockInPoolOutofBound()
+	}
+
+	if msg.IsBuy {
+		tr.diff = tr.biNew.MoneyInPool.Sub(bi.MoneyInPool)
+	} else {
+		tr.diff = bi.MoneyInPool.Sub(tr.biNew.MoneyInPool)
+	}
+	if !tr.diff.IsPositive() {
+		return tr, types.ErrTradeMoneyNotPositive()
+	}
+	tr.premium = sdk.ZeroInt()
+	if msg.IsBuy {
+		tr.premium = bi.AuctionPremium(sdk.NewInt(msg.Amount), ctx.BlockHeader().Time.Unix())
+	}
+	traded := int64(0)
+	if bi.MaxStockPerBlock > 0 {
+		traded = k.GetBlockVolume(ctx, bi.GetSymbol(), msg.Sender)
+	}
+	if err := bi.CheckTradeLimits(&tr.biNew, ctx.BlockHeight(), msg.Amount, traded); err != nil {
+		return tr, err
+	}
+	tr.coinsFromPool, tr.coinsToPool = tradeCoins(msg, tr.diff)
+	if err := checkMoneyLimit(msg, tr.money()); err != nil {
+		return tr, err
+	}
+
+	tr.tradeCommission = calculateCommission(ctx, k, msg, tr.money())
+	return tr, nil
+}
+
+// tradeCoins returns the coins from and to the pool in a trade
+func tradeCoins(msg types.MsgBancorTrade, amountOfMoney sdk.Int) (coinsFromPool, coinsToPool sdk.Coins) {
+	stockCoins := sdk.Coins{sdk.NewCoin(msg.Stock, sdk.NewInt(msg.Amount))}
+	moneyCoins := sdk.Coins{sdk.NewCoin(msg.Money, amountOfMoney)}
+	if msg.IsBuy {
+		return stockCoins, moneyCoins
+	}
+	return moneyCoins, stockCoins
+}
+
 func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
 	if keeper.IsSubscribed(types.Topic) {
 		msgqueue.FillMsgs(ctx, key, msg)
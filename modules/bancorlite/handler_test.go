@@ -1,9 +1,12 @@
 package bancorlite_test
 
 import (
+	"bytes"
 	"math"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
 	"github.com/coinexchain/cet-sdk/modules/authx"
@@ -30,6 +34,7 @@ type testInput struct {
 	bik     keepers.Keeper
 	handler sdk.Handler
 	akp     auth.AccountKeeper
+	mk      market.Keeper
 	cdc     *codec.Codec // mk.cdc
 }
 
@@ -100,7 +105,7 @@ func prepareAccounts(ctx sdk.Context, ak auth.AccountKeeper) {
 
 	//set module account
 	ak.SetAccount(ctx, supply.NewEmptyModuleAccount(authx.ModuleName))
-	ak.SetAccount(ctx, supply.NewEmptyModuleAccount(asset.ModuleName, supply.Minter))
+	ak.SetAccount(ctx, supply.NewEmptyModuleAccount(asset.ModuleName, supply.Burner, supply.Minter))
 }
 
 func prepareBank(ctx sdk.Context, keeper bank.Keeper) {
@@ -127,7 +132,7 @@ func prepareMockInput(t *testing.T, addrForbid, tokenForbid bool) testInput {
 	prepareBankx(ctx, testApp.BankxKeeper)
 	prepareMarket(ctx, testApp.MarketKeeper)
 
-	return testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper), akp: testApp.AccountKeeper, cdc: testApp.Cdc}
+	return testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper), akp: testApp.AccountKeeper, mk: testApp.MarketKeeper, cdc: testApp.Cdc}
 }
 
 func Test_handleMsgBancorInit(t *testing.T) {
@@ -533,6 +538,632 @@ func Test_handleMsgBancorTradeAfterInit(t *testing.T) {
 	}
 }
 
+func Test_handleMsgBancorTradeByMoney(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+
+	biBefore := input.bik.Load(input.ctx, stock+"/"+money)
+	msg := types.MsgBancorTrade{
+		Sender:      tradeAddr,
+		Stock:       stock,
+		Money:       money,
+		IsBuy:       true,
+		MoneyLimit:  500000,
+		MoneyAmount: 500000,
+	}
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+	biAfter := input.bik.Load(input.ctx, stock+"/"+money)
+	paid := biAfter.MoneyInPool.Sub(biBefore.MoneyInPool)
+	bought := biBefore.StockInPool.Sub(biAfter.StockInPool)
+	require.True(t, paid.IsPositive())
+	require.True(t, paid.LTE(sdk.NewInt(msg.MoneyAmount)))
+	require.True(t, bought.IsPositive())
+
+	// one more unit of stock would exceed the money amount
+	next := *biAfter
+	require.True(t, next.UpdateStockInPool(next.StockInPool.SubRaw(1)))
+	require.True(t, next.MoneyInPool.Sub(biBefore.MoneyInPool).GT(sdk.NewInt(msg.MoneyAmount)))
+
+	msg.MoneyAmount = 1
+	msg.MoneyLimit = 1
+	require.Equal(t, types.ErrMoneyAmountTooSmall().Result(), input.handler(input.ctx, msg))
+
+	msg = types.MsgBancorTrade{
+		Sender:      tradeAddr,
+		Stock:       stock,
+		Money:       money,
+		IsBuy:       false,
+		MoneyAmount: 100000,
+	}
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+	biSold := input.bik.Load(input.ctx, stock+"/"+money)
+	got := biAfter.MoneyInPool.Sub(biSold.MoneyInPool)
+	require.True(t, got.IsPositive())
+	require.True(t, got.LTE(sdk.NewInt(msg.MoneyAmount)))
+}
+
+func Test_handleMsgBancorWithCurveShapes(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	msgInit := types.MsgBancorInit{
+		Owner:     haveCetAddress,
+		Stock:     stock,
+		Money:     money,
+		InitPrice: "1",
+		MaxSupply: sdk.NewInt(1000000),
+		MaxMoney:  sdk.ZeroInt(),
+		MaxPrice:  "3",
+		CurveType: types.CurveSigmoid,
+		Steepness: 12,
+	}
+	require.True(t, input.handler(input.ctx, msgInit).IsOK())
+	bi := input.bik.Load(input.ctx, stock+"/"+money)
+	require.Equal(t, types.CurveSigmoid, bi.CurveType)
+	require.InDelta(t, 2000000, bi.MaxMoney.Int64(), 1)
+	require.True(t, bi.IsConsistent())
+
+	msgInit.Money = "cet"
+	msgInit.CurveType = types.CurveTranches
+	msgInit.Steepness = 0
+	msgInit.Tranches = []types.Tranche{
+		{Supply: sdk.NewInt(500000), Price: sdk.NewDec(1)},
+		{Supply: sdk.NewInt(500000), Price: sdk.NewDec(3)},
+	}
+	require.True(t, input.handler(input.ctx, msgInit).IsOK())
+
+	msgTrade := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     600000,
+		IsBuy:      true,
+		MoneyLimit: 2000000,
+	}
+	require.True(t, input.handler(input.ctx, msgTrade).IsOK())
+	bi = input.bik.Load(input.ctx, stock+"/"+money)
+	require.True(t, bi.IsConsistent())
+	require.True(t, bi.Price.GT(sdk.NewDec(2)))
+
+	msgTrade.Money = "cet"
+	require.True(t, input.handler(input.ctx, msgTrade).IsOK())
+	bi = input.bik.Load(input.ctx, stock+"/cet")
+	require.Equal(t, sdk.NewInt(500000+3*100000), bi.MoneyInPool)
+	require.Equal(t, sdk.NewDec(3), bi.Price)
+
+	gs := bancorlite.ExportGenesis(input.ctx, input.bik)
+	require.Nil(t, gs.Validate())
+}
+
+func Test_handleMsgBancorUpdate(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	symbol := stock + "/" + money
+	msgTrade := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     300000,
+		IsBuy:      true,
+		MoneyLimit: 3000000,
+	}
+	require.True(t, input.handler(input.ctx, msgTrade).IsOK())
+	checkReserves := func() {
+		bi := input.bik.Load(input.ctx, symbol)
+		require.True(t, bi.IsConsistent())
+		require.Equal(t, bi.MoneyInPool, input.bik.GetFrozenCoins(input.ctx, haveCetAddress).AmountOf(money))
+	}
+	checkReserves()
+
+	msg := types.MsgBancorUpdate{
+		Owner:              tradeAddr,
+		Stock:              stock,
+		Money:              money,
+		MaxSupply:          sdk.ZeroInt(),
+		EarliestCancelTime: 100,
+		WithdrawMoney:      sdk.ZeroInt(),
+	}
+	require.Equal(t, types.ErrNotBancorOwner().Result(), input.handler(input.ctx, msg))
+	msg.Owner = haveCetAddress
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+	require.Equal(t, int64(100), input.bik.Load(input.ctx, symbol).EarliestCancelTime)
+	msg.EarliestCancelTime = 50
+	require.Equal(t, types.ErrInvalidBancorUpdate("earliest cancel time can only be extended").Result(),
+		input.handler(input.ctx, msg))
+
+	// the power curve can not be extended without changing the money in pool
+	msg.EarliestCancelTime = 0
+	msg.MaxSupply = sdk.NewInt(2000000)
+	require.Equal(t, types.ErrInvalidBancorUpdate("the curve can not be extended to the max supply without changing the money in pool").Result(),
+		input.handler(input.ctx, msg))
+	bi := input.bik.Load(input.ctx, symbol)
+	require.Equal(t, sdk.NewInt(1000000), bi.MaxSupply)
+	checkReserves()
+
+	msg.MaxSupply = sdk.NewInt(500000)
+	require.Equal(t, types.ErrInvalidBancorUpdate("max supply can only be raised").Result(), input.handler(input.ctx, msg))
+
+	// the owner puts back stock for the money withdrawn
+	biBefore := bi
+	msg.MaxSupply = sdk.ZeroInt()
+	msg.WithdrawMoney = sdk.NewInt(10000)
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+	bi = input.bik.Load(input.ctx, symbol)
+	withdrawn := biBefore.MoneyInPool.Sub(bi.MoneyInPool)
+	require.True(t, withdrawn.IsPositive())
+	require.True(t, withdrawn.LTE(msg.WithdrawMoney))
+	require.True(t, bi.StockInPool.GT(biBefore.StockInPool))
+	checkReserves()
+
+	msg.WithdrawMoney = bi.MoneyInPool.AddRaw(1)
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+	bi = input.bik.Load(input.ctx, symbol)
+	require.True(t, bi.MoneyInPool.IsZero())
+	require.Equal(t, bi.MaxSupply, bi.StockInPool)
+	checkReserves()
+
+	gs := bancorlite.ExportGenesis(input.ctx, input.bik)
+	require.Nil(t, gs.Validate())
+}
+
+func Test_handleMsgBancorUpdateLinear(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	msgInit := types.MsgBancorInit{
+		Owner:     haveCetAddress,
+		Stock:     stock,
+		Money:     money,
+		InitPrice: "1",
+		MaxSupply: sdk.NewInt(1000000),
+		MaxMoney:  sdk.ZeroInt(),
+		MaxPrice:  "3",
+	}
+	require.True(t, input.handler(input.ctx, msgInit).IsOK())
+	msgTrade := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     500000,
+		IsBuy:      true,
+		MoneyLimit: 3000000,
+	}
+	require.True(t, input.handler(input.ctx, msgTrade).IsOK())
+	symbol := stock + "/" + money
+	biBefore := input.bik.Load(input.ctx, symbol)
+	frozenBefore := input.bik.GetFrozenCoins(input.ctx, haveCetAddress)
+
+	// the curve keeps its slope, so only the extra stock goes into the pool
+	msg := types.MsgBancorUpdate{
+		Owner:         haveCetAddress,
+		Stock:         stock,
+		Money:         money,
+		MaxSupply:     sdk.NewInt(2000000),
+		WithdrawMoney: sdk.ZeroInt(),
+	}
+	require.True(t, input.handler(input.ctx, msg).IsOK())
+	bi := input.bik.Load(input.ctx, symbol)
+	require.Equal(t, sdk.NewInt(2000000), bi.MaxSupply)
+	require.Equal(t, sdk.NewDec(5), bi.MaxPrice)
+	require.Equal(t, biBefore.Price, bi.Price)
+	require.Equal(t, biBefore.MoneyInPool, bi.MoneyInPool)
+	require.Equal(t, biBefore.StockInPool.AddRaw(1000000), bi.StockInPool)
+	frozen := input.bik.GetFrozenCoins(input.ctx, haveCetAddress)
+	require.Equal(t, frozenBefore.AmountOf(stock).AddRaw(1000000), frozen.AmountOf(stock))
+	require.Equal(t, frozenBefore.AmountOf(money), frozen.AmountOf(money))
+	require.True(t, bi.IsConsistent())
+}
+
+type invariantRegistry map[string]sdk.Invariant
+
+func (ir invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir[moduleName+"/"+route] = invar
+}
+
+func Test_BancorInvariants(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	ir := invariantRegistry{}
+	bancorlite.RegisterInvariants(ir, input.bik)
+	checkInvariants := func(brokenRoute string) {
+		for route, invar := range ir {
+			msg, broken := invar(input.ctx)
+			require.Equal(t, route == brokenRoute, broken, msg)
+		}
+	}
+	checkInvariants("")
+
+	for _, isBuy := range []bool{true, true, false} {
+		for _, m := range []string{money, "cet"} {
+			res := input.handler(input.ctx, types.MsgBancorTrade{
+				Sender: tradeAddr,
+				Stock:  stock,
+				Money:  m,
+				Amount: 100000,
+				IsBuy:  isBuy,
+			})
+			require.True(t, res.IsOK(), res.Log)
+		}
+	}
+	checkInvariants("")
+
+	// the frozen stock is less than the stock in both pools
+	frozen := input.bik.GetFrozenCoins(input.ctx, haveCetAddress).AmountOf(stock)
+	require.Nil(t, input.bik.UnFreezeCoins(input.ctx, haveCetAddress, sdk.NewCoins(sdk.NewCoin(stock, sdk.OneInt()))))
+	checkInvariants(bancorlite.ModuleName + "/bancor-reserves")
+	require.Nil(t, input.bik.FreezeCoins(input.ctx, haveCetAddress, sdk.NewCoins(sdk.NewCoin(stock, sdk.OneInt()))))
+	require.Equal(t, frozen, input.bik.GetFrozenCoins(input.ctx, haveCetAddress).AmountOf(stock))
+	checkInvariants("")
+
+	bi := input.bik.Load(input.ctx, stock+"/"+money)
+	bi.Price = bi.Price.Add(sdk.OneDec())
+	input.bik.Save(input.ctx, bi)
+	checkInvariants(bancorlite.ModuleName + "/bancor-consistency")
+}
+
+func Test_handleMsgBancorTradeLimits(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	symbol := stock + "/" + money
+	// the price grows by 1 for every 100000 stocks sold
+	msgInit := types.MsgBancorInit{
+		Owner:            haveCetAddress,
+		Stock:            stock,
+		Money:            money,
+		InitPrice:        "1",
+		MaxSupply:        sdk.NewInt(1000000),
+		MaxMoney:         sdk.ZeroInt(),
+		MaxPrice:         "11",
+		MaxStockPerTrade: 80000,
+		MaxStockPerBlock: 150000,
+		MaxPriceChange:   "1",
+	}
+	require.True(t, input.handler(input.ctx, msgInit).IsOK())
+	info := keepers.NewBancorInfoDisplay(input.bik.Load(input.ctx, symbol))
+	require.Equal(t, int64(80000), info.MaxStockPerTrade)
+	require.Equal(t, int64(150000), info.MaxStockPerBlock)
+	require.Equal(t, sdk.NewDec(1).String(), info.MaxPriceChange)
+
+	trade := func(amount int64, isBuy bool) sdk.Result {
+		return input.handler(input.ctx, types.MsgBancorTrade{
+			Sender: tradeAddr,
+			Stock:  stock,
+			Money:  money,
+			Amount: amount,
+			IsBuy:  isBuy,
+		})
+	}
+	require.Equal(t, types.ErrTradeLimitExceeded("the stock amount is more than 80000").Result(), trade(90000, true))
+	require.True(t, trade(60000, true).IsOK())
+	require.True(t, trade(40000, true).IsOK())
+	require.Equal(t, types.ErrTradeLimitExceeded("the stock traded in this block is more than 150000").Result(), trade(60000, true))
+	require.True(t, trade(40000, false).IsOK())
+	require.Equal(t, int64(140000), input.bik.GetBlockVolume(input.ctx, symbol, tradeAddr))
+
+	// the limits start over in the next block, from the price of 1.6
+	input.bik.ClearBlockVolumes(input.ctx)
+	input.ctx = input.ctx.WithBlockHeight(input.ctx.BlockHeight() + 1)
+	require.True(t, trade(70000, true).IsOK())
+	require.Equal(t, types.ErrTradeLimitExceeded("the price changes more than 1.000000000000000000 in this block").Result(), trade(40000, true))
+	require.True(t, trade(30000, true).IsOK())
+	bi := input.bik.Load(input.ctx, symbol)
+	require.Equal(t, sdk.NewDecWithPrec(16, 1), bi.BlockStartPrice)
+	require.Equal(t, sdk.NewDecWithPrec(26, 1), bi.Price)
+}
+
+func Test_handleMsgBancorAuction(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	input.ctx = input.ctx.WithBlockTime(time.Unix(1000, 0))
+	symbol := stock + "/" + money
+	// the price grows by 1 for every 100000 stocks sold, and the premium decays from 2 to 0 in 1000 seconds
+	msgInit := types.MsgBancorInit{
+		Owner:             haveCetAddress,
+		Stock:             stock,
+		Money:             money,
+		InitPrice:         "1",
+		MaxSupply:         sdk.NewInt(1000000),
+		MaxMoney:          sdk.ZeroInt(),
+		MaxPrice:          "11",
+		AuctionStartPrice: "3",
+		AuctionDuration:   1000,
+	}
+	require.True(t, input.handler(input.ctx, msgInit).IsOK())
+	bi := input.bik.Load(input.ctx, symbol)
+	require.Equal(t, int64(1000), bi.AuctionStartTime)
+	require.Equal(t, int64(2000), bi.AuctionEndTime)
+	require.Equal(t, "3.000000000000000000", keepers.NewBancorInfoDisplayAt(bi, 1000).TimeAdjustedPrice)
+
+	trade := func(amount int64, isBuy bool) sdk.Result {
+		return input.handler(input.ctx, types.MsgBancorTrade{
+			Sender: tradeAddr,
+			Stock:  stock,
+			Money:  money,
+			Amount: amount,
+			IsBuy:  isBuy,
+		})
+	}
+	balance := func(addr sdk.AccAddress) sdk.Int {
+		return input.akp.GetAccount(input.ctx, addr).GetCoins().AmountOf(money)
+	}
+
+	// halfway the premium is 1, which goes to the owner outside the pool
+	input.ctx = input.ctx.WithBlockTime(time.Unix(1500, 0))
+	traderMoney, ownerMoney := balance(tradeAddr), balance(haveCetAddress)
+	res := trade(10000, true)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(10500+10000), traderMoney.Sub(balance(tradeAddr)))
+	require.Equal(t, sdk.NewInt(10000), balance(haveCetAddress).Sub(ownerMoney))
+	require.Equal(t, sdk.NewInt(10500), input.bik.GetFrozenCoins(input.ctx, haveCetAddress).AmountOf(money))
+	bi = input.bik.Load(input.ctx, symbol)
+	require.Equal(t, sdk.NewDecWithPrec(21, 1), bi.TimeAdjustedPrice(1500))
+
+	// selling gets the curve price only
+	traderMoney = balance(tradeAddr)
+	require.True(t, trade(5000, false).IsOK())
+	require.Equal(t, sdk.NewInt(5375), balance(tradeAddr).Sub(traderMoney))
+
+	// the auction is over, and the curve price is paid
+	input.ctx = input.ctx.WithBlockTime(time.Unix(2000, 0))
+	traderMoney, ownerMoney = balance(tradeAddr), balance(haveCetAddress)
+	require.True(t, trade(5000, true).IsOK())
+	require.Equal(t, sdk.NewInt(5375), traderMoney.Sub(balance(tradeAddr)))
+	require.Equal(t, ownerMoney, balance(haveCetAddress))
+	require.Equal(t, "", keepers.NewBancorInfoDisplay(input.bik.Load(input.ctx, symbol)).TimeAdjustedPrice)
+}
+
+func Test_handleMsgBancorRouteTrade(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	symbol := stock + "/" + money
+	// the market requires an address of the standard length
+	trader := sdk.AccAddress(bytes.Repeat([]byte{0x5}, sdk.AddrLen))
+	acc := input.akp.NewAccountWithAddress(input.ctx, trader)
+	_ = acc.SetCoins(sdk.NewCoins(sdk.NewCoin(money, sdk.NewInt(issueAmount)), sdk.NewCoin(dex.CET, sdk.NewInt(issueAmount))))
+	input.akp.SetAccount(input.ctx, acc)
+	msg := types.MsgBancorRouteTrade{
+		Sender: trader,
+		Stock:  stock,
+		Money:  money,
+		Amount: 100000,
+		IsBuy:  true,
+	}
+	// all goes to the pool without a market
+	res := input.handler(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1000000-100000), input.bik.Load(input.ctx, symbol).StockInPool.Int64())
+
+	require.Nil(t, input.mk.SetMarket(input.ctx, market.MarketInfo{Stock: stock, Money: money,
+		PricePrecision: 2, LastExecutedPrice: sdk.ZeroDec()}))
+	biBefore := input.bik.Load(input.ctx, symbol)
+	for i, price := range []int64{20, 20} {
+		require.Nil(t, input.bik.FreezeCoins(input.ctx, haveCetAddress, sdk.NewCoins(sdk.NewCoin(stock, sdk.NewInt(2000)))))
+		require.Nil(t, input.mk.SetOrder(input.ctx, &market.Order{
+			Sender:      haveCetAddress,
+			Sequence:    uint64(i),
+			TradingPair: symbol,
+			OrderType:   market.LimitOrder,
+			Price:       sdk.NewDecWithPrec(price, 2),
+			Quantity:    2000,
+			Side:        market.SELL,
+			TimeInForce: market.GTE,
+			LeftStock:   2000,
+			Freeze:      2000,
+		}))
+	}
+	poolTakes := biBefore.MaxStockWithinPrice(sdk.NewDecWithPrec(20, 2), true).Int64()
+	require.True(t, poolTakes > 0)
+	routeEvent := func(res sdk.Result) map[string]string {
+		attrs := make(map[string]string)
+		for _, e := range res.Events {
+			if e.Type == bancorlite.EventTypeKeyBancorRouteTrade {
+				for _, attr := range e.Attributes {
+					attrs[string(attr.Key)] = string(attr.Value)
+				}
+			}
+		}
+		return attrs
+	}
+
+	// the money limit applies to the money of both parts
+	msg.Amount = poolTakes + 3000
+	msg.MoneyLimit = 1
+	cachedCtx, _ := input.ctx.CacheContext()
+	require.Equal(t, types.ErrMoneyCrossLimit("more than").Result(), input.handler(cachedCtx, msg))
+
+	// the stock left by the market goes to the pool
+	cachedCtx, _ = input.ctx.CacheContext()
+	require.Nil(t, input.mk.SetMarket(cachedCtx, market.MarketInfo{Stock: stock, Money: money,
+		PricePrecision: 2, LastExecutedPrice: sdk.NewDecWithPrec(1, 2)}))
+	msg.MoneyLimit = 0
+	res = input.handler(cachedCtx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	attrs := routeEvent(res)
+	require.Equal(t, "0", attrs[bancorlite.AttributeMarketAmount])
+	require.Equal(t, strconv.FormatInt(msg.Amount, 10), attrs[bancorlite.AttributeBancorAmount])
+	require.Equal(t, biBefore.StockInPool.SubRaw(msg.Amount), input.bik.Load(cachedCtx, symbol).StockInPool)
+	require.Len(t, input.mk.GetAllOrders(cachedCtx), 2)
+
+	// the pool trades until its price reaches the asks, and the rest deals with them at once
+	moneyBefore := input.akp.GetAccount(input.ctx, trader).GetCoins().AmountOf(money)
+	res = input.handler(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	bi := input.bik.Load(input.ctx, symbol)
+	require.Equal(t, biBefore.StockInPool.SubRaw(poolTakes), bi.StockInPool)
+	attrs = routeEvent(res)
+	require.Equal(t, "3000", attrs[bancorlite.AttributeMarketAmount])
+	require.Equal(t, "600", attrs[bancorlite.AttributeMarketMoney])
+	require.NotEmpty(t, attrs[bancorlite.AttributeMarketOrder])
+	poolMoney := bi.MoneyInPool.Sub(biBefore.MoneyInPool)
+	require.Equal(t, poolMoney.AddRaw(600), moneyBefore.Sub(input.akp.GetAccount(input.ctx, trader).GetCoins().AmountOf(money)))
+	require.Equal(t, sdk.NewInt(100000+msg.Amount), input.akp.GetAccount(input.ctx, trader).GetCoins().AmountOf(stock))
+
+	// the IOC order and the filled ask are removed
+	orders := input.mk.GetAllOrders(input.ctx)
+	require.Len(t, orders, 1)
+	require.Equal(t, int64(1000), orders[0].LeftStock)
+	require.True(t, input.bik.GetFrozenCoins(input.ctx, trader).AmountOf(money).IsZero())
+}
+
+func Test_FeePricedInToken(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	cetOf := func(addr sdk.AccAddress) sdk.Int {
+		return input.akp.GetAccount(input.ctx, addr).GetCoins().AmountOf(dex.CET)
+	}
+	require.Nil(t, input.bik.SendCoins(input.ctx, tradeAddr, haveCetAddress,
+		sdk.NewCoins(sdk.NewCoin(money, sdk.NewInt(1e8)))))
+
+	// 1 tusdt is 1e4 CET, and the fees set at 2e-4 tusdt per CET are doubled
+	params := input.bik.GetParams(input.ctx)
+	params.FeePricing = dex.NewFeePricing(stock, sdk.NewDecWithPrec(2, 4))
+	input.bik.SetParams(input.ctx, params)
+	info, err := input.mk.GetMarketInfo(input.ctx, stock+"/"+dex.CET)
+	require.Nil(t, err)
+	input.mk.UpdateCetPrice(input.ctx, info)
+	input.ctx = input.ctx.WithBlockTime(input.ctx.BlockHeader().Time.Add(time.Duration(market.CetPriceWindow) * time.Second))
+	fee := input.bik.GetCreateBancorFee(input.ctx)
+	require.Equal(t, 2*params.CreateBancorFee, fee)
+
+	cetBefore := cetOf(haveCetAddress)
+	msgBancorInit := types.MsgBancorInit{
+		Owner:     haveCetAddress,
+		Stock:     stock,
+		Money:     dex.CET,
+		InitPrice: "0",
+		MaxSupply: sdk.NewInt(100),
+		MaxPrice:  "10",
+		MaxMoney:  sdk.NewInt(300),
+	}
+	require.True(t, input.handler(input.ctx, msgBancorInit).IsOK())
+	require.Equal(t, cetBefore.SubRaw(fee), cetOf(haveCetAddress))
+
+	cetBefore = cetOf(haveCetAddress)
+	msgInit := types.MsgLiquidityPoolInit{
+		Sender:      haveCetAddress,
+		Stock:       stock,
+		Money:       money,
+		ShareSymbol: "teoslp",
+		StockAmount: sdk.NewInt(1e6),
+		MoneyAmount: sdk.NewInt(4e6),
+		FeeRate:     30,
+	}
+	res := input.handler(input.ctx, msgInit)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, cetBefore.SubRaw(fee+asset.DefaultParams().GetIssueTokenFee("teoslp")), cetOf(haveCetAddress))
+}
+
+func Test_handleLiquidityPool(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	symbol := stock + "/" + money
+	shareSymbol := "teoslp"
+	checkInvariant := func() {
+		msg, broken := bancorlite.LiquidityPoolInvariant(input.bik)(input.ctx)
+		require.False(t, broken, msg)
+	}
+	require.Nil(t, input.bik.SendCoins(input.ctx, tradeAddr, haveCetAddress,
+		sdk.NewCoins(sdk.NewCoin(money, sdk.NewInt(1e8)))))
+
+	msgInit := types.MsgLiquidityPoolInit{
+		Sender:      haveCetAddress,
+		Stock:       stock,
+		Money:       money,
+		ShareSymbol: shareSymbol,
+		StockAmount: sdk.NewInt(1e6),
+		MoneyAmount: sdk.NewInt(4e6),
+		FeeRate:     30,
+	}
+	cetBefore := input.akp.GetAccount(input.ctx, haveCetAddress).GetCoins().AmountOf("cet")
+	require.True(t, input.handler(input.ctx, msgInit).IsOK())
+	require.Equal(t, types.ErrBancorAlreadyExists().Result(), input.handler(input.ctx, msgInit))
+	// the pool pays for its share token as if it were issued in the asset module
+	fee := input.bik.GetParams(input.ctx).CreateBancorFee + asset.DefaultParams().GetIssueTokenFee(shareSymbol)
+	require.Equal(t, cetBefore.SubRaw(fee), input.akp.GetAccount(input.ctx, haveCetAddress).GetCoins().AmountOf("cet"))
+	lp := input.bik.LoadLiquidityPool(input.ctx, symbol)
+	require.Equal(t, sdk.NewInt(2e6), lp.TotalShares)
+	require.Equal(t, sdk.NewInt(2e6-keepers.MinimumLiquidity), input.akp.GetAccount(input.ctx, haveCetAddress).GetCoins().AmountOf(shareSymbol))
+	checkInvariant()
+
+	// the reserves are held by a module account, which is not paid dividends or airdrops
+	_, ok := input.akp.GetAccount(input.ctx, lp.Address()).(supplyexported.ModuleAccountI)
+	require.True(t, ok)
+	require.Equal(t, []sdk.AccAddress{lp.Address()}, input.bik.GetReserveAccounts(input.ctx, shareSymbol))
+	require.Equal(t, []sdk.AccAddress{lp.Address()}, input.bik.GetReserveAccounts(input.ctx, money))
+	require.Empty(t, input.bik.GetReserveAccounts(input.ctx, "cet"))
+
+	// no bancor pool can be created for the symbol of a liquidity pool
+	msgBancorInit := types.MsgBancorInit{
+		Owner:     haveCetAddress,
+		Stock:     stock,
+		Money:     money,
+		InitPrice: "1",
+		MaxSupply: sdk.NewInt(1000),
+		MaxMoney:  sdk.ZeroInt(),
+		MaxPrice:  "2",
+	}
+	require.Equal(t, types.ErrBancorAlreadyExists().Result(), input.handler(input.ctx, msgBancorInit))
+
+	// the trade fee is kept in the pool
+	msgTrade := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     1e4,
+		IsBuy:      true,
+		MoneyLimit: 50000,
+	}
+	moneyBefore := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(money)
+	require.True(t, input.handler(input.ctx, msgTrade).IsOK())
+	lp = input.bik.LoadLiquidityPool(input.ctx, symbol)
+	paid := moneyBefore.Sub(input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(money))
+	// ceil(4e6*1e4/(1e6-1e4)) = 40405, and the fee is ceil(40405*0.003) = 122
+	require.Equal(t, sdk.NewInt(40405+122), paid)
+	require.Equal(t, sdk.NewInt(1e6-1e4), lp.StockInPool)
+	require.Equal(t, sdk.NewInt(4e6).Add(paid), lp.MoneyInPool)
+	require.Equal(t, sdk.NewInt(1e4), input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(stock))
+	checkInvariant()
+
+	msgTrade.MoneyLimit = 100
+	require.Equal(t, types.ErrMoneyCrossLimit("more than").Result(), input.handler(input.ctx, msgTrade))
+
+	// the trader adds all its stock, and the money is taken in the ratio of the pool
+	msgAdd := types.MsgLiquidityPoolAdd{
+		Sender:      tradeAddr,
+		Stock:       stock,
+		Money:       money,
+		StockAmount: sdk.NewInt(1e4),
+		MoneyAmount: sdk.NewInt(1e8),
+	}
+	require.True(t, input.handler(input.ctx, msgAdd).IsOK())
+	lpAdded := input.bik.LoadLiquidityPool(input.ctx, symbol)
+	shares := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(shareSymbol)
+	require.Equal(t, lp.TotalShares.Add(shares), lpAdded.TotalShares)
+	require.Equal(t, lp.StockInPool.AddRaw(1e4), lpAdded.StockInPool)
+	require.True(t, lpAdded.MoneyInPool.Sub(lp.MoneyInPool).LT(sdk.NewInt(1e8)))
+	checkInvariant()
+
+	msgWithdraw := types.MsgLiquidityPoolWithdraw{
+		Sender: tradeAddr,
+		Stock:  stock,
+		Money:  money,
+		Shares: shares.AddRaw(1),
+	}
+	require.False(t, input.handler(input.ctx, msgWithdraw).IsOK())
+	msgWithdraw.Shares = shares
+	require.True(t, input.handler(input.ctx, msgWithdraw).IsOK())
+	require.True(t, input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(shareSymbol).IsZero())
+	checkInvariant()
+
+	gs := bancorlite.ExportGenesis(input.ctx, input.bik)
+	require.Nil(t, gs.Validate())
+	require.Equal(t, 1, len(gs.LiquidityPoolMap))
+
+	// the locked shares keep the pool from being drained
+	msgWithdraw.Sender = haveCetAddress
+	msgWithdraw.Shares = sdk.NewInt(2e6 - keepers.MinimumLiquidity)
+	require.True(t, input.handler(input.ctx, msgWithdraw).IsOK())
+	lp = input.bik.LoadLiquidityPool(input.ctx, symbol)
+	require.Equal(t, sdk.NewInt(keepers.MinimumLiquidity), lp.TotalShares)
+	require.True(t, lp.StockInPool.IsPositive())
+	require.True(t, lp.MoneyInPool.IsPositive())
+	checkInvariant()
+	msgWithdraw.Shares = sdk.OneInt()
+	require.False(t, input.handler(input.ctx, msgWithdraw).IsOK())
+}
+
 func Test_BancorCancel(t *testing.T) {
 	type args struct {
 		ctx       sdk.Context
@@ -2,6 +2,7 @@ package keepers
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
 
@@ -11,72 +12,373 @@ import (
 )
 
 type BancorInfo struct {
-	Owner              sdk.AccAddress `json:"sender"`
-	Stock              string         `json:"stock"`
-	Money              string         `json:"money"`
-	InitPrice          sdk.Dec        `json:"init_price"`
-	MaxSupply          sdk.Int        `json:"max_supply"`
-	StockPrecision     byte           `json:"stock_precision"`
-	MaxPrice           sdk.Dec        `json:"max_price"`
-	MaxMoney           sdk.Int        `json:"max_money"` // DEX2
-	AR                 int64          `json:"ar"`        // DEX2
-	Price              sdk.Dec        `json:"price"`
-	StockInPool        sdk.Int        `json:"stock_in_pool"`
-	MoneyInPool        sdk.Int        `json:"money_in_pool"`
-	EarliestCancelTime int64          `json:"earliest_cancel_time"`
+	Owner              sdk.AccAddress  `json:"sender"`
+	Stock              string          `json:"stock"`
+	Money              string          `json:"money"`
+	InitPrice          sdk.Dec         `json:"init_price"`
+	MaxSupply          sdk.Int         `json:"max_supply"`
+	StockPrecision     byte            `json:"stock_precision"`
+	MaxPrice           sdk.Dec         `json:"max_price"`
+	MaxMoney           sdk.Int         `json:"max_money"` // DEX2
+	AR                 int64           `json:"ar"`        // DEX2
+	Price              sdk.Dec         `json:"price"`
+	StockInPool        sdk.Int         `json:"stock_in_pool"`
+	MoneyInPool        sdk.Int         `json:"money_in_pool"`
+	EarliestCancelTime int64           `json:"earliest_cancel_time"`
+	CurveType          string          `json:"curve_type,omitempty"`
+	Steepness          int64           `json:"steepness,omitempty"`
+	Tranches           []types.Tranche `json:"tranches,omitempty"`
+	// the optional limits of trades, zero means no limit
+	MaxStockPerTrade int64   `json:"max_stock_per_trade,omitempty"`
+	MaxStockPerBlock int64   `json:"max_stock_per_block,omitempty"`
+	MaxPriceChange   sdk.Dec `json:"max_price_change,omitempty"`
+	// the height of the last trade and the price before the first trade at that height,
+	// for limiting the price change in a block
+	LastTradeHeight int64   `json:"last_trade_height,omitempty"`
+	BlockStartPrice sdk.Dec `json:"block_start_price,omitempty"`
+	// the optional Dutch auction, in which buyers pay a premium over the curve price. The premium
+	// is AuctionStartPrice - InitPrice until AuctionStartTime, and decays linearly to zero at
+	// AuctionEndTime, so the curve price is the floor and buying still pushes the price up.
+	AuctionStartPrice sdk.Dec `json:"auction_start_price,omitempty"`
+	AuctionStartTime  int64   `json:"auction_start_time,omitempty"`
+	AuctionEndTime    int64   `json:"auction_end_time,omitempty"`
 }
 
 func (bi *BancorInfo) GetSymbol() string {
 	return dex.GetSymbol(bi.Stock, bi.Money)
 }
 
+// Curve returns the curve of the pool, which is decided by MaxMoney and AR for the default shape
+func (bi *BancorInfo) Curve() types.Curve {
+	if bi.CurveType == types.CurveDefault {
+		return defaultCurve{bi: bi}
+	}
+	return types.NewCurve(bi.CurveType, bi.InitPrice, bi.MaxPrice, bi.MaxSupply, bi.Steepness, bi.Tranches)
+}
+
+func (bi *BancorInfo) CurveName() string {
+	if bi.CurveType != types.CurveDefault {
+		return bi.CurveType
+	}
+	if bi.MaxMoney.IsZero() {
+		return types.CurveLinear
+	}
+	return types.CurvePower
+}
+
 func (bi *BancorInfo) UpdateStockInPool(stockInPool sdk.Int) bool {
 	if stockInPool.IsNegative() || stockInPool.GT(bi.MaxSupply) {
 		return false
 	}
 	bi.StockInPool = stockInPool
-	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
+	price, money, ok := bi.Curve().PriceAndMoney(bi.MaxSupply.Sub(bi.StockInPool))
+	if !ok {
+		return false
+	}
+	bi.Price = price
+	bi.MoneyInPool = money
+	return true
+}
+
+// defaultCurve is the linear curve when MaxMoney is zero, or the power curve parameterised by AR
+type defaultCurve struct {
+	bi *BancorInfo
+}
+
+func (c defaultCurve) PriceAndMoney(suppliedStock sdk.Int) (price sdk.Dec, money sdk.Int, ok bool) {
+	bi := c.bi
 	if bi.MaxMoney.IsZero() {
-		bi.Price = bi.MaxPrice.Sub(bi.InitPrice).MulInt(suppliedStock).QuoInt(bi.MaxSupply).Add(bi.InitPrice)
-		bi.MoneyInPool = bi.Price.Add(bi.InitPrice).MulInt(suppliedStock).QuoInt64(2).RoundInt()
-	} else {
-		// s = s/s_max * 1000, as of precision is 0.001
-		factoredStock := suppliedStock.MulRaw(types.SupplyRatioSamples)
-		s := factoredStock.Quo(bi.MaxSupply).Int64()
+		price = bi.MaxPrice.Sub(bi.InitPrice).MulInt(suppliedStock).QuoInt(bi.MaxSupply).Add(bi.InitPrice)
+		money = price.Add(bi.InitPrice).MulInt(suppliedStock).QuoInt64(2).RoundInt()
+		return price, money, true
+	}
+	// s = s/s_max * 1000, as of precision is 0.001
+	factoredStock := suppliedStock.MulRaw(types.SupplyRatioSamples)
+	s := factoredStock.Quo(bi.MaxSupply).Int64()
+	if s > types.SupplyRatioSamples {
+		return
+	}
+	contrast := sdk.NewInt(s).Mul(bi.MaxSupply)
+	// ratio = (s/s_max)^ar, ar = (p_max * s_max - m_max) / (m_max - p_init * s_max)
+	ratio := types.TableLookup(bi.AR+types.ARSamples, s)
+	// price_ratio = (s/s_max)^(ar)
+	priceRatio := types.TableLookup(bi.AR, s)
+	if factoredStock.GT(contrast) {
 		if s > types.SupplyRatioSamples {
+			return
+		}
+		// ratio = (ratioNear - ratio) * (stock_now / s_max * 1000 - (s)) + ratio
+		ratioNear := types.TableLookup(bi.AR+types.ARSamples, s+1)
+		ratio = ratioNear.Sub(ratio).MulInt(factoredStock.Sub(sdk.NewInt(s).Mul(bi.MaxSupply))).
+			Quo(sdk.NewDecFromInt(bi.MaxSupply)).Add(ratio)
+		priceRatioNear := types.TableLookup(bi.AR, s+1)
+		priceRatio = priceRatioNear.Sub(priceRatio).MulInt(factoredStock.Sub(sdk.NewInt(s).Mul(bi.MaxSupply))).
+			Quo(sdk.NewDecFromInt(bi.MaxSupply)).Add(priceRatio)
+	}
+
+	// m_now = (m_max - s_max * price_max) * ratio + price_init * s_now
+	money = ratio.MulInt(bi.MaxMoney.Sub(bi.InitPrice.MulInt(bi.MaxSupply).TruncateInt())).
+		Add(bi.InitPrice.MulInt(suppliedStock)).TruncateInt()
+	// price = priceRatio * (maxPrice - initPrice) + initPrice
+	price = priceRatio.MulTruncate(bi.MaxPrice.Sub(bi.InitPrice)).Add(bi.InitPrice)
+	return price, money, true
+}
+
+// RaiseMaxSupply returns the pool whose curve is extended to a larger max supply. The curve is
+// kept as it is up to the current supplied stock, so the price and the money in pool do not
+// change, and the extra stock is added to the pool. Only the curves which can be extended this
+// way are supported: the linear curve keeps its slope, a flat curve keeps its price and the last
+// tranche gets the extra stock. ok is false for the other curves, or if the new pool could not
+// have been initialized with the parameters.
+func (bi *BancorInfo) RaiseMaxSupply(maxSupply sdk.Int) (biNew BancorInfo, ok bool) {
+	biNew = *bi
+	if !maxSupply.GT(bi.MaxSupply) {
+		return biNew, false
+	}
+	delta := maxSupply.Sub(bi.MaxSupply)
+	msg := types.MsgBancorInit{
+		Owner:              bi.Owner,
+		Stock:              bi.Stock,
+		Money:              bi.Money,
+		InitPrice:          bi.InitPrice.String(),
+		MaxSupply:          maxSupply,
+		MaxPrice:           bi.MaxPrice.String(),
+		MaxMoney:           sdk.ZeroInt(),
+		StockPrecision:     bi.StockPrecision,
+		EarliestCancelTime: bi.EarliestCancelTime,
+		CurveType:          bi.CurveType,
+		Steepness:          bi.Steepness,
+	}
+	maxPrice := bi.MaxPrice
+	switch {
+	case bi.CurveType == types.CurveTranches:
+		// the last tranche gets the extra stock
+		msg.Tranches = append([]types.Tranche(nil), bi.Tranches...)
+		last := len(msg.Tranches) - 1
+		msg.Tranches[last].Supply = msg.Tranches[last].Supply.Add(delta)
+	case bi.CurveType == types.CurveDefault && bi.InitPrice.Equal(bi.MaxPrice):
+		if bi.MaxMoney.IsPositive() {
+			msg.MaxMoney = bi.InitPrice.MulInt(maxSupply).TruncateInt()
+		}
+	case bi.CurveType == types.CurveDefault && bi.MaxMoney.IsZero():
+		// the max price goes up with the supply, to keep the slope of the linear curve
+		maxPrice = bi.MaxPrice.Sub(bi.InitPrice).MulInt(maxSupply).QuoInt(bi.MaxSupply).Add(bi.InitPrice)
+		msg.MaxPrice = maxPrice.String()
+	default:
+		return biNew, false
+	}
+	if msg.ValidateBasic() != nil {
+		return biNew, false
+	}
+
+	biNew.MaxSupply = maxSupply
+	biNew.MaxPrice = maxPrice
+	biNew.Tranches = msg.Tranches
+	biNew.MaxMoney = msg.MaxMoney
+	if bi.CurveType == types.CurveDefault {
+		biNew.AR = types.CalculateAR(msg, bi.InitPrice, maxPrice)
+	} else if _, biNew.MaxMoney, ok = biNew.Curve().PriceAndMoney(maxSupply); !ok {
+		return biNew, false
+	}
+	if !biNew.UpdateStockInPool(bi.StockInPool.Add(delta)) {
+		return biNew, false
+	}
+	// the rounding of the extended curve must not move the current point
+	if !biNew.Price.Equal(bi.Price) || !biNew.MoneyInPool.Equal(bi.MoneyInPool) {
+		return biNew, false
+	}
+	return biNew, biNew.IsConsistent()
+}
+
+// WithdrawMoney returns the pool after its owner withdraws at most 'money' from it. To keep the
+// pool on its curve, the owner puts back stock as if selling it to the pool. The stock put back
+// and the money withdrawn are also returned.
+func (bi *BancorInfo) WithdrawMoney(money sdk.Int) (biNew BancorInfo, stock, withdrawn sdk.Int, ok bool) {
+	biNew = *bi
+	stock = bi.MaxStockForMoney(money, false)
+	if !stock.IsPositive() || !biNew.UpdateStockInPool(bi.StockInPool.Add(stock)) {
+		return biNew, sdk.ZeroInt(), sdk.ZeroInt(), false
+	}
+	return biNew, stock, bi.MoneyInPool.Sub(biNew.MoneyInPool), true
+}
+
+// MaxStockForMoney returns the largest stock amount, in multiples of StockPrecision, whose
+// money amount in a trade does not exceed 'money'. For buying, the money amount is what
+// the trader pays to the pool; for selling, it is what the trader gets from the pool.
+func (bi *BancorInfo) MaxStockForMoney(money sdk.Int, isBuy bool) sdk.Int {
+	return bi.maxStockForMoney(money, isBuy, sdk.ZeroDec())
+}
+
+// MaxStockForMoneyAt is like MaxStockForMoney, but the money amount of buying includes
+// the auction premium at 'now'
+func (bi *BancorInfo) MaxStockForMoneyAt(money sdk.Int, isBuy bool, now int64) sdk.Int {
+	if !isBuy {
+		return bi.MaxStockForMoney(money, isBuy)
+	}
+	return bi.maxStockForMoney(money, isBuy, bi.AuctionPremiumPrice(now))
+}
+
+func (bi *BancorInfo) maxStockForMoney(money sdk.Int, isBuy bool, premiumPrice sdk.Dec) sdk.Int {
+	unit := bi.StockUnit()
+	available := bi.MaxSupply.Sub(bi.StockInPool)
+	if isBuy {
+		available = bi.StockInPool
+	}
+	moneyOfStock := func(stock sdk.Int) (sdk.Int, bool) {
+		biNew := *bi
+		stockInPool := bi.StockInPool.Add(stock)
+		if isBuy {
+			stockInPool = bi.StockInPool.Sub(stock)
+		}
+		if ok := biNew.UpdateStockInPool(stockInPool); !ok {
+			return sdk.ZeroInt(), false
+		}
+		if isBuy {
+			return biNew.MoneyInPool.Sub(bi.MoneyInPool).Add(premiumOf(premiumPrice, stock)), true
+		}
+		return bi.MoneyInPool.Sub(biNew.MoneyInPool), true
+	}
+
+	// binary search in [low, high] units, moneyOfStock grows with the stock amount
+	low, high := sdk.ZeroInt(), available.Quo(unit)
+	for low.LT(high) {
+		mid := low.Add(high).AddRaw(1).QuoRaw(2)
+		if m, ok := moneyOfStock(mid.Mul(unit)); ok && m.LTE(money) {
+			low = mid
+		} else {
+			high = mid.SubRaw(1)
+		}
+	}
+	return low.Mul(unit)
+}
+
+// StockUnit returns the smallest stock amount which can be traded, decided by StockPrecision
+func (bi *BancorInfo) StockUnit() sdk.Int {
+	if bi.StockPrecision > 0 && bi.StockPrecision <= 8 {
+		return sdk.NewInt(int64(math.Pow10(int(bi.StockPrecision))))
+	}
+	return sdk.OneInt()
+}
+
+// MaxStockWithinPrice returns the largest stock amount, in multiples of StockPrecision, after
+// trading which the price of the pool does not cross 'price', i.e. not above it for buying
+// and not below it for selling
+func (bi *BancorInfo) MaxStockWithinPrice(price sdk.Dec, isBuy bool) sdk.Int {
+	unit := bi.StockUnit()
+	available := bi.MaxSupply.Sub(bi.StockInPool)
+	if isBuy {
+		available = bi.StockInPool
+	}
+	within := func(stock sdk.Int) bool {
+		biNew := *bi
+		stockInPool := bi.StockInPool.Add(stock)
+		if isBuy {
+			stockInPool = bi.StockInPool.Sub(stock)
+		}
+		if ok := biNew.UpdateStockInPool(stockInPool); !ok {
 			return false
 		}
-		contrast := sdk.NewInt(s).Mul(bi.MaxSupply)
-		// ratio = (s/s_max)^ar, ar = (p_max * s_max - m_max) / (m_max - p_init * s_max)
-		ratio := types.TableLookup(bi.AR+types.ARSamples, s)
-		// price_ratio = (s/s_max)^(ar)
-		priceRatio := types.TableLookup(bi.AR, s)
-		if factoredStock.GT(contrast) {
-			if s > types.SupplyRatioSamples {
-				return false
-			}
-			// ratio = (ratioNear - ratio) * (stock_now / s_max * 1000 - (s)) + ratio
-			ratioNear := types.TableLookup(bi.AR+types.ARSamples, s+1)
-			ratio = ratioNear.Sub(ratio).MulInt(factoredStock.Sub(sdk.NewInt(s).Mul(bi.MaxSupply))).
-				Quo(sdk.NewDecFromInt(bi.MaxSupply)).Add(ratio)
-			priceRatioNear := types.TableLookup(bi.AR, s+1)
-			priceRatio = priceRatioNear.Sub(priceRatio).MulInt(factoredStock.Sub(sdk.NewInt(s).Mul(bi.MaxSupply))).
-				Quo(sdk.NewDecFromInt(bi.MaxSupply)).Add(priceRatio)
+		if isBuy {
+			return biNew.Price.LTE(price)
 		}
+		return biNew.Price.GTE(price)
+	}
 
-		// m_now = (m_max - s_max * price_max) * ratio + price_init * s_now
-		bi.MoneyInPool = ratio.MulInt(bi.MaxMoney.Sub(bi.InitPrice.MulInt(bi.MaxSupply).TruncateInt())).
-			Add(bi.InitPrice.MulInt(suppliedStock)).TruncateInt()
-		// price = priceRatio * (maxPrice - initPrice) + initPrice
-		bi.Price = priceRatio.MulTruncate(bi.MaxPrice.Sub(bi.InitPrice)).Add(bi.InitPrice)
+	// binary search in [low, high] units, the price moves away from 'price' as the stock amount grows
+	low, high := sdk.ZeroInt(), available.Quo(unit)
+	for low.LT(high) {
+		mid := low.Add(high).AddRaw(1).QuoRaw(2)
+		if within(mid.Mul(unit)) {
+			low = mid
+		} else {
+			high = mid.SubRaw(1)
+		}
 	}
-	return true
+	return low.Mul(unit)
+}
+
+func (bi *BancorInfo) HasAuction() bool {
+	return !bi.AuctionStartPrice.IsNil() && bi.AuctionStartPrice.IsPositive()
+}
+
+// AuctionPremiumPrice returns the premium over the curve price which buyers pay for each
+// unit of stock at 'now', in unix seconds
+func (bi *BancorInfo) AuctionPremiumPrice(now int64) sdk.Dec {
+	if !bi.HasAuction() || now >= bi.AuctionEndTime {
+		return sdk.ZeroDec()
+	}
+	premium := bi.AuctionStartPrice.Sub(bi.InitPrice)
+	if now <= bi.AuctionStartTime {
+		return premium
+	}
+	return premium.MulInt64(bi.AuctionEndTime - now).QuoInt64(bi.AuctionEndTime - bi.AuctionStartTime)
+}
+
+// AuctionPremium returns the extra money which a buyer pays for 'stock' at 'now', which goes
+// to the owner instead of the pool
+func (bi *BancorInfo) AuctionPremium(stock sdk.Int, now int64) sdk.Int {
+	return premiumOf(bi.AuctionPremiumPrice(now), stock)
+}
+
+// TimeAdjustedPrice returns the price which buyers pay at 'now', including the auction premium
+func (bi *BancorInfo) TimeAdjustedPrice(now int64) sdk.Dec {
+	return bi.Price.Add(bi.AuctionPremiumPrice(now))
+}
+
+func premiumOf(premiumPrice sdk.Dec, stock sdk.Int) sdk.Int {
+	return premiumPrice.MulInt(stock).Ceil().RoundInt()
+}
+
+func (bi *BancorInfo) HasPriceChangeLimit() bool {
+	return !bi.MaxPriceChange.IsNil() && bi.MaxPriceChange.IsPositive()
+}
+
+// StartPriceOfBlock returns the price of the pool before the first trade at 'height'
+func (bi *BancorInfo) StartPriceOfBlock(height int64) sdk.Dec {
+	if bi.LastTradeHeight == height && !bi.BlockStartPrice.IsNil() {
+		return bi.BlockStartPrice
+	}
+	return bi.Price
+}
+
+// CheckTradeLimits checks a trade of 'amount' stock at 'height', after which the pool becomes biNew,
+// and 'traded' is the stock which the trader has traded with the pool at the same height.
+// The height and the start price of the block are recorded in biNew for later trades.
+func (bi *BancorInfo) CheckTradeLimits(biNew *BancorInfo, height, amount, traded int64) sdk.Error {
+	if bi.MaxStockPerTrade > 0 && amount > bi.MaxStockPerTrade {
+		return types.ErrTradeLimitExceeded(fmt.Sprintf("the stock amount is more than %d", bi.MaxStockPerTrade))
+	}
+	if bi.MaxStockPerBlock > 0 && amount+traded > bi.MaxStockPerBlock {
+		return types.ErrTradeLimitExceeded(fmt.Sprintf("the stock traded in this block is more than %d", bi.MaxStockPerBlock))
+	}
+	if !bi.HasPriceChangeLimit() {
+		return nil
+	}
+	startPrice := bi.StartPriceOfBlock(height)
+	if biNew.Price.Sub(startPrice).Abs().GT(bi.MaxPriceChange) {
+		return types.ErrTradeLimitExceeded(fmt.Sprintf("the price changes more than %s in this block", bi.MaxPriceChange))
+	}
+	biNew.LastTradeHeight = height
+	biNew.BlockStartPrice = startPrice
+	return nil
 }
 
 func (bi *BancorInfo) IsConsistent() bool {
 	if bi.StockInPool.IsNegative() || bi.StockInPool.GT(bi.MaxSupply) {
 		return false
 	}
+	if bi.MaxStockPerTrade < 0 || bi.MaxStockPerBlock < 0 || (!bi.MaxPriceChange.IsNil() && bi.MaxPriceChange.IsNegative()) {
+		return false
+	}
+	if bi.HasAuction() && (!bi.AuctionStartPrice.GT(bi.InitPrice) || bi.AuctionEndTime <= bi.AuctionStartTime) {
+		return false
+	}
+	if bi.CurveType != types.CurveDefault {
+		return bi.isShapedCurveConsistent()
+	}
+	if bi.Steepness != 0 || len(bi.Tranches) != 0 {
+		return false
+	}
 	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
 	if bi.InitPrice.Equal(bi.MaxPrice) {
 		if !bi.MaxMoney.Equal(bi.InitPrice.MulInt(bi.MaxSupply).TruncateInt()) || bi.AR != 0 {
@@ -103,7 +405,7 @@ func (bi *BancorInfo) IsConsistent() bool {
 		MaxMoney:  bi.MaxMoney,
 	}
 	ar, ok := types.CheckAR(biMsg, bi.InitPrice, bi.MaxPrice)
-	if ok || ar != bi.AR {
+	if !ok || ar != bi.AR {
 		return false
 	}
 	biNew := *bi
@@ -114,26 +416,53 @@ func (bi *BancorInfo) IsConsistent() bool {
 	return bi.MoneyInPool.Equal(biNew.MoneyInPool) && bi.Price.Equal(biNew.Price)
 }
 
+// a pool whose curve is not of the default shape is consistent if its MaxMoney is
+// the money of all the stock along the curve, and its price and money fit the curve
+func (bi *BancorInfo) isShapedCurveConsistent() bool {
+	if bi.AR != 0 || types.ValidateCurve(bi.CurveType, bi.InitPrice, bi.MaxPrice, bi.MaxSupply,
+		bi.Steepness, bi.Tranches) != nil {
+		return false
+	}
+	curve := bi.Curve()
+	_, maxMoney, ok := curve.PriceAndMoney(bi.MaxSupply)
+	if !ok || !maxMoney.Equal(bi.MaxMoney) {
+		return false
+	}
+	price, money, ok := curve.PriceAndMoney(bi.MaxSupply.Sub(bi.StockInPool))
+	return ok && price.Equal(bi.Price) && money.Equal(bi.MoneyInPool)
+}
+
 type BancorInfoDisplay struct {
-	Owner              string `json:"owner"`
-	Stock              string `json:"stock"`
-	Money              string `json:"money"`
-	InitPrice          string `json:"init_price"`
-	MaxSupply          string `json:"max_supply"`
-	StockPrecision     string `json:"stock_precision"`
-	MaxPrice           string `json:"max_price"`
-	MaxMoney           string `json:"max_money"`
-	AR                 string `json:"ar"`
-	CurrentPrice       string `json:"current_price"`
-	StockInPool        string `json:"stock_in_pool"`
-	MoneyInPool        string `json:"money_in_pool"`
-	EarliestCancelTime int64  `json:"earliest_cancel_time"`
+	Owner              string          `json:"owner"`
+	Stock              string          `json:"stock"`
+	Money              string          `json:"money"`
+	InitPrice          string          `json:"init_price"`
+	MaxSupply          string          `json:"max_supply"`
+	StockPrecision     string          `json:"stock_precision"`
+	MaxPrice           string          `json:"max_price"`
+	MaxMoney           string          `json:"max_money"`
+	AR                 string          `json:"ar"`
+	CurrentPrice       string          `json:"current_price"`
+	StockInPool        string          `json:"stock_in_pool"`
+	MoneyInPool        string          `json:"money_in_pool"`
+	EarliestCancelTime int64           `json:"earliest_cancel_time"`
+	CurveType          string          `json:"curve_type"`
+	Steepness          int64           `json:"steepness,omitempty"`
+	Tranches           []types.Tranche `json:"tranches,omitempty"`
+	MaxStockPerTrade   int64           `json:"max_stock_per_trade,omitempty"`
+	MaxStockPerBlock   int64           `json:"max_stock_per_block,omitempty"`
+	MaxPriceChange     string          `json:"max_price_change,omitempty"`
+	AuctionStartPrice  string          `json:"auction_start_price,omitempty"`
+	AuctionStartTime   int64           `json:"auction_start_time,omitempty"`
+	AuctionEndTime     int64           `json:"auction_end_time,omitempty"`
+	// the price which buyers pay at the time of the query, including the auction premium
+	TimeAdjustedPrice string `json:"time_adjusted_price,omitempty"`
 }
 
 func NewBancorInfoDisplay(bi *BancorInfo) BancorInfoDisplay {
 	price := sdk.ZeroDec()
 	suppliedStock := bi.MaxSupply.Sub(bi.StockInPool)
-	if bi.MaxMoney.IsPositive() {
+	if bi.CurveType == types.CurveDefault && bi.MaxMoney.IsPositive() {
 		s := suppliedStock.MulRaw(types.SupplyRatioSamples).Quo(bi.MaxSupply).Int64()
 		if s == types.SupplyRatioSamples {
 			price = bi.MaxPrice
@@ -150,6 +479,14 @@ func NewBancorInfoDisplay(bi *BancorInfo) BancorInfoDisplay {
 	} else {
 		price = bi.Price
 	}
+	maxPriceChange := ""
+	if bi.HasPriceChangeLimit() {
+		maxPriceChange = bi.MaxPriceChange.String()
+	}
+	auctionStartPrice := ""
+	if bi.HasAuction() {
+		auctionStartPrice = bi.AuctionStartPrice.String()
+	}
 	return BancorInfoDisplay{
 		Owner:              bi.Owner.String(),
 		Stock:              bi.Stock,
@@ -164,5 +501,24 @@ func NewBancorInfoDisplay(bi *BancorInfo) BancorInfoDisplay {
 		StockInPool:        bi.StockInPool.String(),
 		MoneyInPool:        bi.MoneyInPool.String(),
 		EarliestCancelTime: bi.EarliestCancelTime,
+		CurveType:          bi.CurveName(),
+		Steepness:          bi.Steepness,
+		Tranches:           bi.Tranches,
+		MaxStockPerTrade:   bi.MaxStockPerTrade,
+		MaxStockPerBlock:   bi.MaxStockPerBlock,
+		MaxPriceChange:     maxPriceChange,
+		AuctionStartPrice:  auctionStartPrice,
+		AuctionStartTime:   bi.AuctionStartTime,
+		AuctionEndTime:     bi.AuctionEndTime,
+	}
+}
+
+// NewBancorInfoDisplayAt returns the display of a pool with its time-adjusted price at 'now'
+// if it has an auction
+func NewBancorInfoDisplayAt(bi *BancorInfo, now int64) BancorInfoDisplay {
+	display := NewBancorInfoDisplay(bi)
+	if bi.HasAuction() {
+		display.TimeAdjustedPrice = bi.TimeAdjustedPrice(now).String()
 	}
+	return display
 }
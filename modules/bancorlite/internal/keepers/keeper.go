@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -13,6 +14,13 @@ import (
 var (
 	BancorInfoKey    = []byte{0x10}
 	BancorInfoKeyEnd = []byte{0x11}
+
+	LiquidityPoolKey    = []byte{0x12}
+	LiquidityPoolKeyEnd = []byte{0x13}
+
+	// the stock traded by each account with each pool in the current block, cleared at the end of the block
+	BlockVolumeKey    = []byte{0x14}
+	BlockVolumeKeyEnd = []byte{0x15}
 )
 
 type BancorInfoKeeper struct {
@@ -78,18 +86,80 @@ func (keeper *BancorInfoKeeper) Iterate(ctx sdk.Context, biProc func(bi *BancorI
 	}
 }
 
+func (keeper *BancorInfoKeeper) SaveLiquidityPool(ctx sdk.Context, lp *LiquidityPool) {
+	store := ctx.KVStore(keeper.biKey)
+	value := keeper.codec.MustMarshalBinaryBare(lp)
+	key := append(LiquidityPoolKey, []byte(lp.GetSymbol())...)
+	store.Set(key, value)
+}
+
+//key: stock/money pair
+func (keeper *BancorInfoKeeper) LoadLiquidityPool(ctx sdk.Context, symbol string) *LiquidityPool {
+	store := ctx.KVStore(keeper.biKey)
+	key := append(LiquidityPoolKey, []byte(symbol)...)
+	lpBytes := store.Get(key)
+	if lpBytes == nil {
+		return nil
+	}
+	lp := &LiquidityPool{}
+	keeper.codec.MustUnmarshalBinaryBare(lpBytes, lp)
+	return lp
+}
+
+func (keeper *BancorInfoKeeper) IterateLiquidityPools(ctx sdk.Context, lpProc func(lp *LiquidityPool)) {
+	store := ctx.KVStore(keeper.biKey)
+	iter := store.Iterator(LiquidityPoolKey, LiquidityPoolKeyEnd)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		lp := &LiquidityPool{}
+		keeper.codec.MustUnmarshalBinaryBare(iter.Value(), lp)
+		lpProc(lp)
+	}
+}
+
+func blockVolumeKey(symbol string, addr sdk.AccAddress) []byte {
+	return dex.ConcatKeys(BlockVolumeKey, []byte(symbol), []byte{0x0}, addr)
+}
+
+func (keeper *BancorInfoKeeper) GetBlockVolume(ctx sdk.Context, symbol string, addr sdk.AccAddress) (volume int64) {
+	bz := ctx.KVStore(keeper.biKey).Get(blockVolumeKey(symbol, addr))
+	if bz != nil {
+		keeper.codec.MustUnmarshalBinaryBare(bz, &volume)
+	}
+	return
+}
+
+func (keeper *BancorInfoKeeper) AddBlockVolume(ctx sdk.Context, symbol string, addr sdk.AccAddress, amount int64) {
+	volume := keeper.GetBlockVolume(ctx, symbol, addr) + amount
+	ctx.KVStore(keeper.biKey).Set(blockVolumeKey(symbol, addr), keeper.codec.MustMarshalBinaryBare(volume))
+}
+
+func (keeper *BancorInfoKeeper) ClearBlockVolumes(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.biKey)
+	iter := store.Iterator(BlockVolumeKey, BlockVolumeKeyEnd)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 type Keeper struct {
 	bik         *BancorInfoKeeper
 	bxk         types.ExpectedBankxKeeper
-	ask         types.ExpectedAssetStatusKeeper
+	ask         types.ExpectedAssetKeeper
 	mk          types.ExpectedMarketKeeper
 	axk         types.ExpectedAuthXKeeper
 	msgProducer msgqueue.MsgSender
+	oc          types.ExpectedOrderCreator
 }
 
 func NewKeeper(bik *BancorInfoKeeper,
 	bxk types.ExpectedBankxKeeper,
-	ask types.ExpectedAssetStatusKeeper,
+	ask types.ExpectedAssetKeeper,
 	mk types.ExpectedMarketKeeper,
 	axk types.ExpectedAuthXKeeper,
 	mq msgqueue.MsgSender) Keeper {
@@ -103,6 +173,12 @@ func NewKeeper(bik *BancorInfoKeeper,
 	}
 }
 
+// SetOrderCreator enables routing trades to the order books of the market module.
+// Route trades are all settled in the pool if it is not set.
+func (keeper *Keeper) SetOrderCreator(oc types.ExpectedOrderCreator) {
+	keeper.oc = oc
+}
+
 func (keeper Keeper) IsBancorExist(ctx sdk.Context, stock string) bool {
 	store := ctx.KVStore(keeper.bik.biKey)
 	key := append(BancorInfoKey, []byte(stock+dex.SymbolSeparator)...)
@@ -131,6 +207,12 @@ func (keeper *Keeper) GetParams(ctx sdk.Context) (param types.Params) {
 	return
 }
 
+// GetCreateBancorFee returns the fee of creating a bancor, which may be priced in a reference token
+func (keeper *Keeper) GetCreateBancorFee(ctx sdk.Context) int64 {
+	params := keeper.GetParams(ctx)
+	return params.FeePricing.GetFee(ctx, keeper.mk, params.CreateBancorFee)
+}
+
 func (keeper *Keeper) Save(ctx sdk.Context, bi *BancorInfo) {
 	keeper.bik.Save(ctx, bi)
 }
@@ -147,6 +229,54 @@ func (keeper *Keeper) Iterate(ctx sdk.Context, biProc func(bi *BancorInfo)) {
 	keeper.bik.Iterate(ctx, biProc)
 }
 
+func (keeper *Keeper) SaveLiquidityPool(ctx sdk.Context, lp *LiquidityPool) {
+	keeper.bik.SaveLiquidityPool(ctx, lp)
+}
+
+func (keeper *Keeper) LoadLiquidityPool(ctx sdk.Context, symbol string) *LiquidityPool {
+	return keeper.bik.LoadLiquidityPool(ctx, symbol)
+}
+
+func (keeper *Keeper) IterateLiquidityPools(ctx sdk.Context, lpProc func(lp *LiquidityPool)) {
+	keeper.bik.IterateLiquidityPools(ctx, lpProc)
+}
+
+func (keeper *Keeper) GetBlockVolume(ctx sdk.Context, symbol string, addr sdk.AccAddress) int64 {
+	return keeper.bik.GetBlockVolume(ctx, symbol, addr)
+}
+
+func (keeper *Keeper) AddBlockVolume(ctx sdk.Context, symbol string, addr sdk.AccAddress, amount int64) {
+	keeper.bik.AddBlockVolume(ctx, symbol, addr, amount)
+}
+
+func (keeper *Keeper) ClearBlockVolumes(ctx sdk.Context) {
+	keeper.bik.ClearBlockVolumes(ctx)
+}
+
+func (keeper *Keeper) GetAllLiquidityPools(ctx sdk.Context) (list []*LiquidityPool) {
+	keeper.IterateLiquidityPools(ctx, func(lp *LiquidityPool) {
+		list = append(list, lp)
+	})
+	return
+}
+
+// GetReserveAccounts returns the accounts of the liquidity pools which hold denom, whose balances
+// belong to the liquidity providers and are excluded from the dividends of denom
+func (keeper *Keeper) GetReserveAccounts(ctx sdk.Context, denom string) (addrs []sdk.AccAddress) {
+	keeper.IterateLiquidityPools(ctx, func(lp *LiquidityPool) {
+		if lp.HoldsReserveOf(denom) {
+			addrs = append(addrs, lp.Address())
+		}
+	})
+	return
+}
+
+// SetReserveAccount makes the account of the pool a module account, so that its reserves are
+// not paid dividends or airdrops, which the liquidity providers could never claim
+func (keeper *Keeper) SetReserveAccount(ctx sdk.Context, lp *LiquidityPool) {
+	keeper.bxk.SetModuleAccount(ctx, lp.AccountName())
+}
+
 func (keeper *Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	return keeper.bxk.SendCoins(ctx, from, to, amt)
 }
@@ -173,6 +303,53 @@ func (keeper *Keeper) IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, ad
 	return keeper.ask.IsForbiddenByTokenIssuer(ctx, denom, addr)
 }
 
+func (keeper *Keeper) GetTokenTotalSupply(ctx sdk.Context, denom string) sdk.Int {
+	token := keeper.ask.GetToken(ctx, denom)
+	if token == nil {
+		return sdk.ZeroInt()
+	}
+	return token.GetTotalSupply()
+}
+
+// GetIssueShareTokenFee returns the fee of issuing the share token of a liquidity pool, which is
+// the same as issuing the symbol in the asset module
+func (keeper *Keeper) GetIssueShareTokenFee(ctx sdk.Context, shareSymbol string) int64 {
+	return keeper.ask.GetIssueTokenFee(ctx, shareSymbol)
+}
+
+// IssueShareToken issues the share token of a liquidity pool, which is owned by the pool.
+// The first 'locked' shares are kept by the pool itself, so the share token never runs out of supply.
+func (keeper *Keeper) IssueShareToken(ctx sdk.Context, lp *LiquidityPool, locked sdk.Int) sdk.Error {
+	err := keeper.ask.IssueToken(ctx, "LP share "+lp.ShareSymbol, lp.ShareSymbol, locked, lp.Address(),
+		true, true, false, false, "", "Share of liquidity pool "+lp.GetSymbol(), lp.GetSymbol())
+	if err != nil {
+		return err
+	}
+	return keeper.ask.SendCoinsFromAssetModuleToAccount(ctx, lp.Address(), sdk.NewCoins(sdk.NewCoin(lp.ShareSymbol, locked)))
+}
+
+func (keeper *Keeper) MintShareToken(ctx sdk.Context, lp *LiquidityPool, provider sdk.AccAddress, amount sdk.Int) sdk.Error {
+	if err := keeper.ask.MintToken(ctx, lp.ShareSymbol, lp.Address(), amount); err != nil {
+		return err
+	}
+	return keeper.ask.SendCoinsFromAssetModuleToAccount(ctx, provider, sdk.NewCoins(sdk.NewCoin(lp.ShareSymbol, amount)))
+}
+
+func (keeper *Keeper) BurnShareToken(ctx sdk.Context, lp *LiquidityPool, provider sdk.AccAddress, amount sdk.Int) sdk.Error {
+	if err := keeper.ask.SendCoinsFromAccountToAssetModule(ctx, provider, sdk.NewCoins(sdk.NewCoin(lp.ShareSymbol, amount))); err != nil {
+		return err
+	}
+	return keeper.ask.BurnToken(ctx, lp.ShareSymbol, lp.Address(), amount)
+}
+
+func (keeper *Keeper) GetFrozenCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	accx, ok := keeper.axk.GetAccountX(ctx, addr)
+	if !ok {
+		return sdk.Coins{}
+	}
+	return accx.FrozenCoins
+}
+
 func (keeper *Keeper) GetMarketVolume(ctx sdk.Context, stock, money string, stockVolume, moneyVolume sdk.Dec) sdk.Dec {
 	return keeper.mk.GetMarketVolume(ctx, stock, money, stockVolume, moneyVolume)
 }
@@ -184,6 +361,25 @@ func (keeper *Keeper) GetMarketFeeMin(ctx sdk.Context) int64 {
 	return keeper.mk.GetMarketFeeMin(ctx)
 }
 
+func (keeper *Keeper) GetMarketInfo(ctx sdk.Context, symbol string) (market.MarketInfo, error) {
+	return keeper.mk.GetMarketInfo(ctx, symbol)
+}
+
+func (keeper *Keeper) GetOrdersCrossingPrice(ctx sdk.Context, symbol string, side byte, price sdk.Dec) []*market.Order {
+	return keeper.mk.GetOrdersCrossingPrice(ctx, symbol, side, price)
+}
+
+func (keeper *Keeper) CanRouteToMarket() bool {
+	return keeper.oc != nil
+}
+
+func (keeper *Keeper) CreateIOCOrder(ctx sdk.Context, msg market.MsgCreateOrder) (market.Order, sdk.Error) {
+	if keeper.oc == nil {
+		return market.Order{}, types.ErrRouteUnavailable("no order creator")
+	}
+	return keeper.oc.CreateIOCOrder(ctx, msg)
+}
+
 func (keeper *Keeper) GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress {
 	acc := keeper.axk.GetRefereeAddr(ctx, accAddr)
 	if len(acc) == 0 {
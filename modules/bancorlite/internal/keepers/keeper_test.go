@@ -17,6 +17,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/testapp"
 )
 
@@ -245,6 +246,13 @@ func TestBancorInfoKeeper(t *testing.T) {
 			StockInPool:        sdk.NewInt(90),
 			MoneyInPool:        sdk.NewInt(5),
 			EarliestCancelTime: 100,
+			MaxStockPerTrade:   10,
+			MaxPriceChange:     sdk.NewDec(2),
+			LastTradeHeight:    1,
+			BlockStartPrice:    sdk.NewDec(1),
+			AuctionStartPrice:  sdk.NewDec(2),
+			AuctionStartTime:   100,
+			AuctionEndTime:     200,
 		},
 		{
 			Owner:              owner,
@@ -259,6 +267,9 @@ func TestBancorInfoKeeper(t *testing.T) {
 			StockInPool:        sdk.NewInt(90),
 			MoneyInPool:        sdk.NewInt(5),
 			EarliestCancelTime: 0,
+			MaxPriceChange:     sdk.ZeroDec(),
+			BlockStartPrice:    sdk.ZeroDec(),
+			AuctionStartPrice:  sdk.ZeroDec(),
 		},
 	}
 	for _, p := range bi {
@@ -319,3 +330,266 @@ func TestCurrentPriceCalculate(t *testing.T) {
 		require.Equal(t, display.CurrentPrice[i], pp.String()[i])
 	}
 }
+
+func TestBancorInfo_MaxStockForMoney(t *testing.T) {
+	bi := keepers.BancorInfo{
+		Owner:          owner,
+		Stock:          bch,
+		Money:          cet,
+		InitPrice:      sdk.NewDec(1),
+		MaxSupply:      sdk.NewInt(10000),
+		StockPrecision: 2,
+		MaxPrice:       sdk.NewDec(3),
+		MaxMoney:       sdk.ZeroInt(),
+		Price:          sdk.NewDec(1),
+		StockInPool:    sdk.NewInt(10000),
+		MoneyInPool:    sdk.ZeroInt(),
+	}
+	// buying 1000 stocks costs (1 + 1.2) * 1000 / 2 = 1100
+	require.Equal(t, int64(1000), bi.MaxStockForMoney(sdk.NewInt(1100), true).Int64())
+	require.Equal(t, int64(900), bi.MaxStockForMoney(sdk.NewInt(1099), true).Int64())
+	require.Equal(t, int64(10000), bi.MaxStockForMoney(sdk.NewInt(1e9), true).Int64())
+	require.True(t, bi.MaxStockForMoney(sdk.NewInt(1e9), false).IsZero())
+
+	require.True(t, bi.UpdateStockInPool(sdk.NewInt(9000)))
+	require.Equal(t, int64(1000), bi.MaxStockForMoney(sdk.NewInt(1100), false).Int64())
+	require.Equal(t, int64(500), bi.MaxStockForMoney(sdk.NewInt(600), false).Int64())
+}
+
+func TestPlanRoute(t *testing.T) {
+	bi := keepers.BancorInfo{
+		Owner:          owner,
+		Stock:          bch,
+		Money:          cet,
+		InitPrice:      sdk.NewDec(1),
+		MaxSupply:      sdk.NewInt(10000),
+		StockPrecision: 2,
+		MaxPrice:       sdk.NewDec(3),
+		MaxMoney:       sdk.ZeroInt(),
+		Price:          sdk.NewDec(1),
+		StockInPool:    sdk.NewInt(10000),
+		MoneyInPool:    sdk.ZeroInt(),
+	}
+	// the price of the pool reaches 1.5 after 2500 stocks are bought
+	require.Equal(t, int64(2500), bi.MaxStockWithinPrice(sdk.NewDecWithPrec(15, 1), true).Int64())
+	require.True(t, bi.MaxStockWithinPrice(sdk.NewDec(2), false).IsZero())
+
+	plan := keepers.PlanRoute(&bi, nil, 5000, true, 1, 0)
+	require.Equal(t, keepers.RoutePlan{BancorAmount: 5000, MarketPrice: sdk.ZeroDec()}, plan)
+
+	// the pool takes 500, the first ask 1000, the pool 2000, the second ask 250, and the pool the rest.
+	// the market part is rounded down to the stock unit of the pool
+	asks := []*market.Order{
+		{Price: sdk.NewDecWithPrec(11, 1), LeftStock: 1000},
+		{Price: sdk.NewDecWithPrec(15, 1), LeftStock: 250},
+		{Price: sdk.NewDec(2), LeftStock: 1000},
+	}
+	plan = keepers.PlanRoute(&bi, asks, 5000, true, 1, 0)
+	require.Equal(t, int64(3800), plan.BancorAmount)
+	require.Equal(t, int64(1200), plan.MarketAmount)
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), plan.MarketPrice)
+
+	// the bid is better than the pool, so it is taken first
+	require.True(t, bi.UpdateStockInPool(sdk.NewInt(5000)))
+	bids := []*market.Order{{Price: sdk.NewDecWithPrec(25, 1), LeftStock: 300}}
+	plan = keepers.PlanRoute(&bi, bids, 1000, false, 10, 0)
+	require.Equal(t, int64(700), plan.BancorAmount)
+	require.Equal(t, int64(300), plan.MarketAmount)
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), plan.MarketPrice)
+}
+
+func TestBancorInfo_CurveShapes(t *testing.T) {
+	maxSupply := sdk.NewInt(1000000)
+	tranches := []types.Tranche{
+		{Supply: sdk.NewInt(300000), Price: sdk.NewDec(1)},
+		{Supply: sdk.NewInt(700000), Price: sdk.NewDec(2)},
+	}
+	for _, bi := range []keepers.BancorInfo{
+		{CurveType: types.CurveTranches, Tranches: tranches},
+		{CurveType: types.CurveSigmoid, Steepness: 8},
+		{CurveType: types.CurveExponential},
+	} {
+		bi.Owner = owner
+		bi.Stock = bch
+		bi.Money = cet
+		bi.InitPrice = sdk.NewDec(1)
+		bi.MaxPrice = sdk.NewDec(2)
+		bi.MaxSupply = maxSupply
+		_, bi.MaxMoney, _ = bi.Curve().PriceAndMoney(maxSupply)
+		require.True(t, bi.UpdateStockInPool(maxSupply), bi.CurveType)
+		require.True(t, bi.IsConsistent(), bi.CurveType)
+		require.Equal(t, bi.InitPrice, bi.Price, bi.CurveType)
+
+		require.True(t, bi.UpdateStockInPool(sdk.NewInt(400000)), bi.CurveType)
+		require.True(t, bi.IsConsistent(), bi.CurveType)
+		display := keepers.NewBancorInfoDisplay(&bi)
+		require.Equal(t, bi.CurveType, display.CurveType)
+		require.Equal(t, bi.Price.String(), display.CurrentPrice)
+
+		biBad := bi
+		biBad.MoneyInPool = biBad.MoneyInPool.AddRaw(1)
+		require.False(t, biBad.IsConsistent(), bi.CurveType)
+		biBad = bi
+		biBad.MaxMoney = biBad.MaxMoney.AddRaw(1)
+		require.False(t, biBad.IsConsistent(), bi.CurveType)
+		biBad = bi
+		biBad.AR = 1
+		require.False(t, biBad.IsConsistent(), bi.CurveType)
+
+		// buying with the money amount of a trade gets back the same stock
+		biOld := bi
+		require.True(t, bi.UpdateStockInPool(sdk.NewInt(100000)), bi.CurveType)
+		money := bi.MoneyInPool.Sub(biOld.MoneyInPool)
+		require.Equal(t, sdk.NewInt(300000), biOld.MaxStockForMoney(money, true), bi.CurveType)
+	}
+
+	bi := keepers.BancorInfo{MaxMoney: sdk.ZeroInt()}
+	require.Equal(t, types.CurveLinear, bi.CurveName())
+	bi.MaxMoney = sdk.NewInt(100)
+	require.Equal(t, types.CurvePower, bi.CurveName())
+}
+
+func TestLiquidityPool(t *testing.T) {
+	lp := keepers.LiquidityPool{
+		Stock:       abc,
+		Money:       cet,
+		ShareSymbol: "abclp",
+		FeeRate:     30,
+		StockInPool: sdk.ZeroInt(),
+		MoneyInPool: sdk.ZeroInt(),
+		TotalShares: sdk.ZeroInt(),
+	}
+	require.True(t, lp.IsConsistent())
+	_, _, _, ok := lp.Trade(sdk.NewInt(10), true)
+	require.False(t, ok)
+
+	shares, stock, money := lp.AddLiquidity(sdk.NewInt(10000), sdk.NewInt(40000))
+	require.Equal(t, sdk.NewInt(20000), shares)
+	require.Equal(t, sdk.NewInt(10000), stock)
+	require.Equal(t, sdk.NewInt(40000), money)
+	lp.StockInPool, lp.MoneyInPool, lp.TotalShares = stock, money, shares
+	require.True(t, lp.IsConsistent())
+
+	// only the amounts in the ratio of the pool are added, rounded up for the pool
+	shares, stock, money = lp.AddLiquidity(sdk.NewInt(1001), sdk.NewInt(1e8))
+	require.Equal(t, sdk.NewInt(2002), shares)
+	require.Equal(t, sdk.NewInt(1001), stock)
+	require.Equal(t, sdk.NewInt(4004), money)
+
+	// buying 1000 stock: ceil(40000*1000/9000) = 4445, fee ceil(4445*0.003) = 14
+	lpNew, paid, fee, ok := lp.Trade(sdk.NewInt(1000), true)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(14), fee)
+	require.Equal(t, sdk.NewInt(4445+14), paid)
+	require.Equal(t, sdk.NewInt(9000), lpNew.StockInPool)
+	require.Equal(t, sdk.NewInt(40000+4459), lpNew.MoneyInPool)
+	require.True(t, lpNew.StockInPool.Mul(lpNew.MoneyInPool).GT(lp.StockInPool.Mul(lp.MoneyInPool)))
+	_, _, _, ok = lp.Trade(sdk.NewInt(10000), true)
+	require.False(t, ok)
+
+	// selling 1000 stock: 40000*1000/11000 = 3636, fee ceil(3636*0.003) = 11
+	lpNew, got, fee, ok := lp.Trade(sdk.NewInt(1000), false)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(11), fee)
+	require.Equal(t, sdk.NewInt(3636-11), got)
+	require.Equal(t, sdk.NewInt(40000-3625), lpNew.MoneyInPool)
+
+	for _, isBuy := range []bool{true, false} {
+		amount := lp.MaxStockForMoney(sdk.NewInt(3000), isBuy)
+		require.True(t, amount.IsPositive())
+		_, m, _, _ := lp.Trade(amount, isBuy)
+		require.True(t, m.LTE(sdk.NewInt(3000)))
+		_, m, _, _ = lp.Trade(amount.AddRaw(1), isBuy)
+		require.True(t, m.GT(sdk.NewInt(3000)))
+	}
+	require.True(t, lp.MaxStockForMoney(sdk.NewInt(1), true).IsZero())
+
+	stock, money = lp.Withdraw(sdk.NewInt(5000))
+	require.Equal(t, sdk.NewInt(2500), stock)
+	require.Equal(t, sdk.NewInt(10000), money)
+	stock, money = lp.Withdraw(lp.TotalShares)
+	require.Equal(t, lp.StockInPool, stock)
+	require.Equal(t, lp.MoneyInPool, money)
+
+	lp.MoneyInPool = sdk.ZeroInt()
+	require.False(t, lp.IsConsistent())
+}
+
+func TestLiquidityPoolKeeper(t *testing.T) {
+	keeper, ctx := defaultContext()
+	lp := &keepers.LiquidityPool{
+		Stock:       abc,
+		Money:       cet,
+		ShareSymbol: "abclp",
+		StockInPool: sdk.NewInt(10),
+		MoneyInPool: sdk.NewInt(20),
+		TotalShares: sdk.NewInt(14),
+	}
+	require.Nil(t, keeper.LoadLiquidityPool(ctx, lp.GetSymbol()))
+	keeper.SaveLiquidityPool(ctx, lp)
+	require.Equal(t, lp, keeper.LoadLiquidityPool(ctx, lp.GetSymbol()))
+	require.Nil(t, keeper.Load(ctx, lp.GetSymbol()))
+	require.Equal(t, []*keepers.LiquidityPool{lp}, keeper.GetAllLiquidityPools(ctx))
+	require.Equal(t, "2.000000000000000000", keepers.NewLiquidityPoolDisplay(lp).CurrentPrice)
+}
+
+func TestBancorInfo_RaiseMaxSupply(t *testing.T) {
+	bi := keepers.BancorInfo{
+		Owner:       owner,
+		Stock:       bch,
+		Money:       cet,
+		InitPrice:   sdk.NewDec(1),
+		MaxSupply:   sdk.NewInt(1000),
+		MaxPrice:    sdk.NewDec(3),
+		MaxMoney:    sdk.ZeroInt(),
+		StockInPool: sdk.NewInt(1000),
+		MoneyInPool: sdk.ZeroInt(),
+		Price:       sdk.NewDec(1),
+	}
+	require.True(t, bi.UpdateStockInPool(sdk.NewInt(500)))
+	require.True(t, bi.IsConsistent())
+	require.Equal(t, sdk.NewInt(750), bi.MoneyInPool)
+
+	// the linear curve keeps its slope over a larger supply, so the money in pool is unchanged
+	biNew, ok := bi.RaiseMaxSupply(sdk.NewInt(2000))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(1500), biNew.StockInPool)
+	require.Equal(t, sdk.NewDec(5), biNew.MaxPrice)
+	require.Equal(t, bi.Price, biNew.Price)
+	require.Equal(t, sdk.NewInt(750), biNew.MoneyInPool)
+	_, ok = bi.RaiseMaxSupply(sdk.NewInt(1000))
+	require.False(t, ok)
+	_, ok = bi.RaiseMaxSupply(sdk.NewInt(types.MaxTradeAmount))
+	require.False(t, ok)
+
+	// the power curve can not be extended without changing its shape
+	power := bi
+	power.MaxMoney = sdk.NewInt(1800)
+	power.AR = 2000
+	_, ok = power.RaiseMaxSupply(sdk.NewInt(2000))
+	require.False(t, ok)
+
+	// the last tranche gets the extra stock, so the money in pool is unchanged
+	bi.CurveType = types.CurveTranches
+	bi.Tranches = []types.Tranche{
+		{Supply: sdk.NewInt(600), Price: sdk.NewDec(1)},
+		{Supply: sdk.NewInt(400), Price: sdk.NewDec(3)},
+	}
+	bi.MaxMoney = sdk.NewInt(1800)
+	require.True(t, bi.UpdateStockInPool(sdk.NewInt(500)))
+	require.True(t, bi.IsConsistent())
+	biNew, ok = bi.RaiseMaxSupply(sdk.NewInt(1100))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(500), biNew.Tranches[1].Supply)
+	require.Equal(t, sdk.NewInt(400), bi.Tranches[1].Supply)
+	require.Equal(t, sdk.NewInt(2100), biNew.MaxMoney)
+	require.Equal(t, bi.MoneyInPool, biNew.MoneyInPool)
+
+	biNew, stock, money, ok := bi.WithdrawMoney(sdk.NewInt(100))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(100), stock)
+	require.Equal(t, sdk.NewInt(100), money)
+	require.Equal(t, sdk.NewInt(400), biNew.MoneyInPool)
+	_, _, _, ok = bi.WithdrawMoney(sdk.ZeroInt())
+	require.False(t, ok)
+}
@@ -0,0 +1,190 @@
+package keepers
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+const (
+	FeeRateBase = 10000
+	// the shares locked in the pool when it is created, which can never be withdrawn
+	MinimumLiquidity = 1000
+)
+
+// LiquidityPool is a constant-product pool, which anyone can provide liquidity to.
+// Its reserves are frozen in the account of Address(), and the liquidity providers
+// own them in proportion to their shares, which are the tokens of ShareSymbol.
+type LiquidityPool struct {
+	Stock       string  `json:"stock"`
+	Money       string  `json:"money"`
+	ShareSymbol string  `json:"share_symbol"`
+	FeeRate     int64   `json:"fee_rate"`
+	StockInPool sdk.Int `json:"stock_in_pool"`
+	MoneyInPool sdk.Int `json:"money_in_pool"`
+	TotalShares sdk.Int `json:"total_shares"`
+}
+
+func (lp *LiquidityPool) GetSymbol() string {
+	return dex.GetSymbol(lp.Stock, lp.Money)
+}
+
+// AccountName returns the name of the module account which holds the reserves and owns the share token
+func (lp *LiquidityPool) AccountName() string {
+	return types.ModuleName + "/" + lp.GetSymbol()
+}
+
+// Address returns the address of the module account named AccountName()
+func (lp *LiquidityPool) Address() sdk.AccAddress {
+	return supply.NewModuleAddress(lp.AccountName())
+}
+
+// HoldsReserveOf returns whether the account of the pool holds denom, as a reserve or as the locked shares
+func (lp *LiquidityPool) HoldsReserveOf(denom string) bool {
+	return denom == lp.Stock || denom == lp.Money || denom == lp.ShareSymbol
+}
+
+func (lp *LiquidityPool) IsEmpty() bool {
+	return lp.TotalShares.IsZero()
+}
+
+// Trade returns the new pool, and the money amount which the trader pays for buying
+// or gets for selling 'amount' stock. The fee, which is also returned, is paid in money
+// and kept in the pool.
+func (lp *LiquidityPool) Trade(amount sdk.Int, isBuy bool) (lpNew LiquidityPool, money sdk.Int, fee sdk.Int, ok bool) {
+	lpNew = *lp
+	if !amount.IsPositive() || lp.IsEmpty() {
+		return lpNew, sdk.ZeroInt(), sdk.ZeroInt(), false
+	}
+	feeOf := func(m sdk.Int) sdk.Int {
+		return ceilQuo(m.MulRaw(lp.FeeRate), sdk.NewInt(FeeRateBase))
+	}
+	if isBuy {
+		if amount.GTE(lp.StockInPool) {
+			return lpNew, sdk.ZeroInt(), sdk.ZeroInt(), false
+		}
+		// (s - a) * (m + money) >= s * m
+		money = ceilQuo(lp.MoneyInPool.Mul(amount), lp.StockInPool.Sub(amount))
+		fee = feeOf(money)
+		money = money.Add(fee)
+		lpNew.StockInPool = lp.StockInPool.Sub(amount)
+		lpNew.MoneyInPool = lp.MoneyInPool.Add(money)
+	} else {
+		// (s + a) * (m - money) >= s * m
+		money = lp.MoneyInPool.Mul(amount).Quo(lp.StockInPool.Add(amount))
+		fee = feeOf(money)
+		money = money.Sub(fee)
+		lpNew.StockInPool = lp.StockInPool.Add(amount)
+		lpNew.MoneyInPool = lp.MoneyInPool.Sub(money)
+	}
+	return lpNew, money, fee, money.IsPositive()
+}
+
+// MaxStockForMoney returns the largest stock amount whose money amount in a trade does not exceed 'money'
+func (lp *LiquidityPool) MaxStockForMoney(money sdk.Int, isBuy bool) sdk.Int {
+	fits := func(stock sdk.Int) bool {
+		_, m, _, ok := lp.Trade(stock, isBuy)
+		if !ok {
+			// selling too little stock gets no money, while buying too much stock is impossible
+			return !isBuy
+		}
+		return m.LTE(money)
+	}
+	low, high := sdk.ZeroInt(), sdk.NewInt(types.MaxTradeAmount)
+	if isBuy {
+		high = sdk.MinInt(high, lp.StockInPool)
+	}
+	// binary search in [low, high], the money amount grows with the stock amount
+	for low.LT(high) {
+		mid := low.Add(high).AddRaw(1).QuoRaw(2)
+		if fits(mid) {
+			low = mid
+		} else {
+			high = mid.SubRaw(1)
+		}
+	}
+	if _, _, _, ok := lp.Trade(low, isBuy); !ok {
+		return sdk.ZeroInt()
+	}
+	return low
+}
+
+// AddLiquidity returns the shares for adding at most 'stockMax' stock and 'moneyMax' money,
+// and the stock and money which are actually added to keep the ratio of the pool
+func (lp *LiquidityPool) AddLiquidity(stockMax, moneyMax sdk.Int) (shares, stock, money sdk.Int) {
+	if lp.IsEmpty() {
+		shares = sdk.NewIntFromBigInt(new(big.Int).Sqrt(stockMax.Mul(moneyMax).BigInt()))
+		return shares, stockMax, moneyMax
+	}
+	shares = sdk.MinInt(stockMax.Mul(lp.TotalShares).Quo(lp.StockInPool),
+		moneyMax.Mul(lp.TotalShares).Quo(lp.MoneyInPool))
+	stock = ceilQuo(shares.Mul(lp.StockInPool), lp.TotalShares)
+	money = ceilQuo(shares.Mul(lp.MoneyInPool), lp.TotalShares)
+	return shares, stock, money
+}
+
+// Withdraw returns the stock and money of 'shares'
+func (lp *LiquidityPool) Withdraw(shares sdk.Int) (stock, money sdk.Int) {
+	if shares.Equal(lp.TotalShares) {
+		return lp.StockInPool, lp.MoneyInPool
+	}
+	stock = shares.Mul(lp.StockInPool).Quo(lp.TotalShares)
+	money = shares.Mul(lp.MoneyInPool).Quo(lp.TotalShares)
+	return stock, money
+}
+
+func (lp *LiquidityPool) IsConsistent() bool {
+	if lp.StockInPool.IsNegative() || lp.MoneyInPool.IsNegative() || lp.TotalShares.IsNegative() {
+		return false
+	}
+	if lp.FeeRate < 0 || lp.FeeRate > types.MaxLiquidityPoolFeeRate {
+		return false
+	}
+	if asset.ValidateTokenSymbol(lp.ShareSymbol) != nil {
+		return false
+	}
+	// a pool is either empty, or has both stock and money
+	if lp.IsEmpty() {
+		return lp.StockInPool.IsZero() && lp.MoneyInPool.IsZero()
+	}
+	return lp.StockInPool.IsPositive() && lp.MoneyInPool.IsPositive()
+}
+
+func ceilQuo(a, b sdk.Int) sdk.Int {
+	return a.Add(b).SubRaw(1).Quo(b)
+}
+
+type LiquidityPoolDisplay struct {
+	Stock        string `json:"stock"`
+	Money        string `json:"money"`
+	ShareSymbol  string `json:"share_symbol"`
+	FeeRate      int64  `json:"fee_rate"`
+	Address      string `json:"address"`
+	CurrentPrice string `json:"current_price"`
+	StockInPool  string `json:"stock_in_pool"`
+	MoneyInPool  string `json:"money_in_pool"`
+	TotalShares  string `json:"total_shares"`
+}
+
+func NewLiquidityPoolDisplay(lp *LiquidityPool) LiquidityPoolDisplay {
+	price := sdk.ZeroDec()
+	if lp.StockInPool.IsPositive() {
+		price = sdk.NewDecFromInt(lp.MoneyInPool).QuoInt(lp.StockInPool)
+	}
+	return LiquidityPoolDisplay{
+		Stock:        lp.Stock,
+		Money:        lp.Money,
+		ShareSymbol:  lp.ShareSymbol,
+		FeeRate:      lp.FeeRate,
+		Address:      lp.Address().String(),
+		CurrentPrice: price.String(),
+		StockInPool:  lp.StockInPool.String(),
+		MoneyInPool:  lp.MoneyInPool.String(),
+		TotalShares:  lp.TotalShares.String(),
+	}
+}
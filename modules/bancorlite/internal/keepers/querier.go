@@ -9,9 +9,13 @@ import (
 )
 
 const (
-	QueryBancorInfo = "bancor-info"
-	QueryParameters = "parameters"
-	QueryBancors    = "bancor-list"
+	QueryBancorInfo  = "bancor-info"
+	QueryParameters  = "parameters"
+	QueryBancors     = "bancor-list"
+	QueryBancorQuote = "bancor-quote"
+
+	QueryLiquidityPool  = "liquidity-pool"
+	QueryLiquidityPools = "liquidity-pool-list"
 )
 
 // creates a querier for asset REST endpoints
@@ -24,6 +28,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryBancorInfo(ctx, req, keeper)
 		case QueryBancors:
 			return queryBancorList(ctx, req, keeper)
+		case QueryLiquidityPool:
+			return queryLiquidityPool(ctx, req, keeper)
+		case QueryLiquidityPools:
+			return queryLiquidityPoolList(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -42,7 +50,7 @@ func queryBancorInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 	bi := keeper.Load(ctx, param.Symbol)
 	var biD BancorInfoDisplay
 	if bi != nil {
-		biD = NewBancorInfoDisplay(bi)
+		biD = NewBancorInfoDisplayAt(bi, ctx.BlockHeader().Time.Unix())
 	}
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, biD)
 	if err != nil {
@@ -56,7 +64,7 @@ func queryBancorList(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	infoList := make([]BancorInfoDisplay, len(infos))
 
 	for i, info := range infos {
-		infoList[i] = NewBancorInfoDisplay(info)
+		infoList[i] = NewBancorInfoDisplayAt(info, ctx.BlockHeader().Time.Unix())
 	}
 	bz, err := codec.MarshalJSONIndent(k.bik.codec, infoList)
 	if err != nil {
@@ -65,6 +73,35 @@ func queryBancorList(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return bz, nil
 }
 
+func queryLiquidityPool(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var param QueryBancorInfoParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	lp := keeper.LoadLiquidityPool(ctx, param.Symbol)
+	if lp == nil {
+		return nil, types.ErrNoLiquidityPoolExists()
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, NewLiquidityPoolDisplay(lp))
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
+func queryLiquidityPoolList(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	pools := k.GetAllLiquidityPools(ctx)
+	list := make([]LiquidityPoolDisplay, len(pools))
+	for i, lp := range pools {
+		list[i] = NewLiquidityPoolDisplay(lp)
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, list)
+	if err != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
+
 func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	params := k.GetParams(ctx)
 
@@ -75,3 +112,25 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 
 	return res, nil
 }
+
+// When MoneyAmount is positive, the quote is in the inverse mode: the maximum stock
+// amount whose money amount does not exceed MoneyAmount is quoted and Amount is ignored.
+type QueryBancorQuoteParam struct {
+	Symbol      string         `json:"symbol"`
+	IsBuy       bool           `json:"is_buy"`
+	Amount      int64          `json:"amount"`
+	MoneyAmount int64          `json:"money_amount"`
+	Sender      sdk.AccAddress `json:"sender"`
+}
+
+type ResBancorQuote struct {
+	Symbol            string            `json:"symbol"`
+	IsBuy             bool              `json:"is_buy"`
+	StockAmount       int64             `json:"stock_amount"`
+	MoneyAmount       sdk.Int           `json:"money_amount"`
+	AuctionPremium    sdk.Int           `json:"auction_premium"` // included in MoneyAmount
+	Commission        sdk.Int           `json:"commission"`
+	RebateRefereeAddr string            `json:"rebate_referee_addr"`
+	RebateAmount      sdk.Int           `json:"rebate_amount"`
+	PoolAfterTrade    BancorInfoDisplay `json:"pool_after_trade"`
+}
@@ -0,0 +1,63 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market"
+)
+
+// RoutePlan splits a trade between a bancor pool and the resting orders of a market
+type RoutePlan struct {
+	BancorAmount int64
+	MarketAmount int64
+	// the worst price of the resting orders to deal with, which is the price of the market order
+	MarketPrice sdk.Dec
+}
+
+// PlanRoute splits 'amount' stock between the pool and 'orders', which are on the opposite side
+// with the best price first. It walks the orders and lets the pool take the stock it can trade
+// at a better price than each order before dealing with it, until the amount is used up. The rest
+// goes to the pool. The market part is rounded down to multiples of 'granularity' and of the
+// stock unit of the pool, both of which are powers of 10. For buying, the pool is compared with
+// the orders by its price plus the auction premium at 'now'.
+func PlanRoute(bi *BancorInfo, orders []*market.Order, amount int64, isBuy bool, granularity, now int64) RoutePlan {
+	pool := *bi
+	premiumPrice := sdk.ZeroDec()
+	if isBuy {
+		premiumPrice = bi.AuctionPremiumPrice(now)
+	}
+	left := sdk.NewInt(amount)
+	toMarket := sdk.ZeroInt()
+	price := sdk.ZeroDec()
+	for _, order := range orders {
+		if !left.IsPositive() {
+			break
+		}
+		stock := sdk.MinInt(pool.MaxStockWithinPrice(order.Price.Sub(premiumPrice), isBuy), left)
+		if stock.IsPositive() {
+			stockInPool := pool.StockInPool.Add(stock)
+			if isBuy {
+				stockInPool = pool.StockInPool.Sub(stock)
+			}
+			pool.UpdateStockInPool(stockInPool)
+			left = left.Sub(stock)
+		}
+		stock = sdk.MinInt(sdk.NewInt(order.LeftStock), left)
+		if stock.IsPositive() {
+			toMarket = toMarket.Add(stock)
+			left = left.Sub(stock)
+			price = order.Price
+		}
+	}
+
+	unit := sdk.MaxInt(sdk.NewInt(granularity), bi.StockUnit())
+	toMarket = toMarket.Sub(toMarket.Mod(unit))
+	if toMarket.IsZero() {
+		price = sdk.ZeroDec()
+	}
+	return RoutePlan{
+		BancorAmount: amount - toMarket.Int64(),
+		MarketAmount: toMarket.Int64(),
+		MarketPrice:  price,
+	}
+}
@@ -16,4 +16,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBancorInit{}, "bancorlite/MsgBancorInit", nil)
 	cdc.RegisterConcrete(MsgBancorTrade{}, "bancorlite/MsgBancorTrade", nil)
 	cdc.RegisterConcrete(MsgBancorCancel{}, "bancorlite/MsgBancorCancel", nil)
+	cdc.RegisterConcrete(MsgBancorUpdate{}, "bancorlite/MsgBancorUpdate", nil)
+	cdc.RegisterConcrete(MsgBancorRouteTrade{}, "bancorlite/MsgBancorRouteTrade", nil)
+	cdc.RegisterConcrete(MsgLiquidityPoolInit{}, "bancorlite/MsgLiquidityPoolInit", nil)
+	cdc.RegisterConcrete(MsgLiquidityPoolAdd{}, "bancorlite/MsgLiquidityPoolAdd", nil)
+	cdc.RegisterConcrete(MsgLiquidityPoolWithdraw{}, "bancorlite/MsgLiquidityPoolWithdraw", nil)
 }
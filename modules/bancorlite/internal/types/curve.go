@@ -0,0 +1,269 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The shapes of bancor curves. The default shape is the linear curve when MaxMoney is zero,
+// or the power curve parameterised by AR when MaxMoney is positive.
+const (
+	CurveDefault     = ""
+	CurveTranches    = "tranches"
+	CurveSigmoid     = "sigmoid"
+	CurveExponential = "exponential"
+
+	// only used for display, to tell the two variants of the default shape
+	CurveLinear = "linear"
+	CurvePower  = "power"
+)
+
+const (
+	MaxTranches  = 16
+	MaxSteepness = 50
+)
+
+// MinExponentialK is the lowest k = ln(p_max/p_init) of an exponential curve, by which its money is divided.
+// A lower k, which may be rounded to zero, gives too much rounding error in the money.
+var MinExponentialK = sdk.NewDecWithPrec(1, 3)
+
+// Tranche is a segment of a tranches curve, in which 'Supply' stock is sold at a constant 'Price'
+type Tranche struct {
+	Supply sdk.Int `json:"supply"`
+	Price  sdk.Dec `json:"price"`
+}
+
+// Curve gives the price and the money in pool of a bancor pool, after 'supplied' stock has been sold out of the pool.
+// The money must not decrease as the supplied stock increases.
+type Curve interface {
+	PriceAndMoney(supplied sdk.Int) (price sdk.Dec, money sdk.Int, ok bool)
+}
+
+// NewCurve returns the curve of a shape other than the default one.
+// The parameters must have been checked by ValidateCurve.
+func NewCurve(curveType string, initPrice, maxPrice sdk.Dec, maxSupply sdk.Int, steepness int64, tranches []Tranche) Curve {
+	switch curveType {
+	case CurveTranches:
+		return tranchesCurve{tranches: tranches}
+	case CurveSigmoid:
+		return sigmoidCurve{initPrice: initPrice, maxPrice: maxPrice, maxSupply: maxSupply, steepness: steepness}
+	case CurveExponential:
+		return exponentialCurve{initPrice: initPrice, maxPrice: maxPrice, maxSupply: maxSupply}
+	default:
+		return nil
+	}
+}
+
+// ValidateCurve checks the parameters of a curve whose shape is not the default one.
+func ValidateCurve(curveType string, initPrice, maxPrice sdk.Dec, maxSupply sdk.Int, steepness int64, tranches []Tranche) (err sdk.Error) {
+	switch curveType {
+	case CurveTranches:
+		if steepness != 0 {
+			return ErrInvalidCurveParam("steepness is only for sigmoid curve")
+		}
+		return checkTranches(initPrice, maxPrice, maxSupply, tranches)
+	case CurveSigmoid:
+		if len(tranches) != 0 {
+			return ErrInvalidCurveParam("tranches are only for tranches curve")
+		}
+		if steepness <= 0 || steepness > MaxSteepness {
+			return ErrInvalidCurveParam("steepness is out of range")
+		}
+		if !initPrice.LT(maxPrice) {
+			return ErrPriceConfiguration()
+		}
+		// the sigmoid curve is symmetric, so its max money is the same as the linear one
+		return checkMaxPrice(initPrice, maxPrice, maxSupply)
+	case CurveExponential:
+		if steepness != 0 || len(tranches) != 0 {
+			return ErrInvalidCurveParam("exponential curve has no steepness or tranches")
+		}
+		if !initPrice.IsPositive() {
+			return ErrNonPositivePrice()
+		}
+		if !initPrice.LT(maxPrice) {
+			return ErrPriceConfiguration()
+		}
+		// the max money of the exponential curve is less than the linear one
+		if err := checkMaxPrice(initPrice, maxPrice, maxSupply); err != nil {
+			return err
+		}
+		if decLn(maxPrice.Quo(initPrice)).LT(MinExponentialK) {
+			return ErrInvalidCurveParam("max price is too close to init price for exponential curve")
+		}
+		return nil
+	default:
+		return ErrInvalidCurveType()
+	}
+}
+
+func checkTranches(initPrice, maxPrice sdk.Dec, maxSupply sdk.Int, tranches []Tranche) (err sdk.Error) {
+	if len(tranches) == 0 || len(tranches) > MaxTranches {
+		return ErrInvalidCurveParam("the count of tranches is out of range")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrPriceTooBig()
+		}
+	}()
+	totalSupply := sdk.ZeroInt()
+	totalMoney := sdk.ZeroDec()
+	for i, t := range tranches {
+		if t.Supply == (sdk.Int{}) || !t.Supply.IsPositive() {
+			return ErrInvalidCurveParam("the supply of a tranche must be positive")
+		}
+		if t.Price.IsNil() || t.Price.IsNegative() {
+			return ErrInvalidCurveParam("the price of a tranche must not be negative")
+		}
+		if i > 0 && t.Price.LT(tranches[i-1].Price) {
+			return ErrInvalidCurveParam("the prices of tranches must not decrease")
+		}
+		totalSupply = totalSupply.Add(t.Supply)
+		totalMoney = totalMoney.Add(t.Price.MulInt(t.Supply))
+	}
+	if !totalSupply.Equal(maxSupply) {
+		return ErrInvalidCurveParam("the supplies of tranches must sum up to max supply")
+	}
+	if !tranches[0].Price.Equal(initPrice) || !tranches[len(tranches)-1].Price.Equal(maxPrice) {
+		return ErrInvalidCurveParam("init price and max price must be the prices of the first and last tranches")
+	}
+	if totalMoney.GT(sdk.NewDec(MaxTradeAmount)) {
+		return ErrPriceTooBig()
+	}
+	return nil
+}
+
+type tranchesCurve struct {
+	tranches []Tranche
+}
+
+func (c tranchesCurve) PriceAndMoney(supplied sdk.Int) (sdk.Dec, sdk.Int, bool) {
+	money := sdk.ZeroDec()
+	left := supplied
+	for i, t := range c.tranches {
+		// the price is the one of the next stock to be sold
+		if left.LT(t.Supply) || i == len(c.tranches)-1 {
+			if left.GT(t.Supply) {
+				return sdk.ZeroDec(), sdk.ZeroInt(), false
+			}
+			money = money.Add(t.Price.MulInt(left))
+			return t.Price, money.TruncateInt(), true
+		}
+		money = money.Add(t.Price.MulInt(t.Supply))
+		left = left.Sub(t.Supply)
+	}
+	return sdk.ZeroDec(), sdk.ZeroInt(), false
+}
+
+// price = p_init * e^(k*x), x = s/s_max, k = ln(p_max/p_init)
+// money = s_max * p_init * (e^(k*x) - 1) / k
+type exponentialCurve struct {
+	initPrice sdk.Dec
+	maxPrice  sdk.Dec
+	maxSupply sdk.Int
+}
+
+func (c exponentialCurve) PriceAndMoney(supplied sdk.Int) (sdk.Dec, sdk.Int, bool) {
+	if supplied.IsNegative() || supplied.GT(c.maxSupply) {
+		return sdk.ZeroDec(), sdk.ZeroInt(), false
+	}
+	k := decLn(c.maxPrice.Quo(c.initPrice))
+	x := sdk.NewDecFromInt(supplied).QuoInt(c.maxSupply)
+	e := decExp(k.Mul(x))
+	money := c.initPrice.MulInt(c.maxSupply).Mul(e.Sub(sdk.OneDec())).Quo(k).TruncateInt()
+	if supplied.Equal(c.maxSupply) {
+		return c.maxPrice, money, true
+	}
+	return c.initPrice.Mul(e), money, true
+}
+
+// f(x) = 1/(1+e^(-k*(x-1/2))), x = s/s_max, k is the steepness
+// price = p_init + (p_max - p_init) * (f(x) - f(0)) / (f(1) - f(0))
+// money = s_max * (p_init * x + (p_max - p_init) * (F(x) - F(0) - f(0)*x) / (f(1) - f(0))),
+// where F(x) = ln(1+e^(k*(x-1/2)))/k is the integral of f(x)
+type sigmoidCurve struct {
+	initPrice sdk.Dec
+	maxPrice  sdk.Dec
+	maxSupply sdk.Int
+	steepness int64
+}
+
+func (c sigmoidCurve) PriceAndMoney(supplied sdk.Int) (sdk.Dec, sdk.Int, bool) {
+	if supplied.IsNegative() || supplied.GT(c.maxSupply) {
+		return sdk.ZeroDec(), sdk.ZeroInt(), false
+	}
+	if supplied.IsZero() {
+		return c.initPrice, sdk.ZeroInt(), true
+	}
+	half := sdk.NewDecWithPrec(5, 1)
+	k := sdk.NewDec(c.steepness)
+	f := func(x sdk.Dec) sdk.Dec {
+		return sdk.OneDec().Quo(sdk.OneDec().Add(decExp(k.Mul(half.Sub(x)))))
+	}
+	integralF := func(x sdk.Dec) sdk.Dec {
+		return decLn(sdk.OneDec().Add(decExp(k.Mul(x.Sub(half))))).Quo(k)
+	}
+	x := sdk.NewDecFromInt(supplied).QuoInt(c.maxSupply)
+	f0 := f(sdk.ZeroDec())
+	// f(1) = 1 - f(0) by symmetry
+	d := sdk.OneDec().Sub(f0).Sub(f0)
+	priceRange := c.maxPrice.Sub(c.initPrice)
+
+	price := priceRange.Mul(f(x).Sub(f0)).Quo(d).Add(c.initPrice)
+	area := integralF(x).Sub(integralF(sdk.ZeroDec())).Sub(f0.Mul(x)).Quo(d)
+	money := c.initPrice.Mul(x).Add(priceRange.Mul(area)).MulInt(c.maxSupply).TruncateInt()
+	if supplied.Equal(c.maxSupply) {
+		price = c.maxPrice
+	}
+	return price, money, true
+}
+
+var ln2 = lnNear1(sdk.NewDec(2))
+
+// decExp calculates e^x with Taylor series, after halving x into [0, 1/2)
+func decExp(x sdk.Dec) sdk.Dec {
+	if x.IsNegative() {
+		return sdk.OneDec().Quo(decExp(x.Neg()))
+	}
+	halvings := 0
+	limit := sdk.NewDecWithPrec(5, 1)
+	for x.GTE(limit) {
+		x = x.QuoInt64(2)
+		halvings++
+	}
+	sum, term := sdk.OneDec(), sdk.OneDec()
+	for i := int64(1); !term.IsZero(); i++ {
+		term = term.Mul(x).QuoInt64(i)
+		sum = sum.Add(term)
+	}
+	for ; halvings > 0; halvings-- {
+		sum = sum.Mul(sum)
+	}
+	return sum
+}
+
+// decLn calculates ln(x) for positive x as n*ln(2) + ln(y), where y = x/2^n is in [1, 2)
+func decLn(x sdk.Dec) sdk.Dec {
+	n := int64(0)
+	two := sdk.NewDec(2)
+	for x.GTE(two) {
+		x = x.QuoInt64(2)
+		n++
+	}
+	for x.LT(sdk.OneDec()) {
+		x = x.MulInt64(2)
+		n--
+	}
+	return ln2.MulInt64(n).Add(lnNear1(x))
+}
+
+// lnNear1 calculates ln(x) = 2*atanh((x-1)/(x+1)) with Taylor series, for x in [1, 2]
+func lnNear1(x sdk.Dec) sdk.Dec {
+	z := x.Sub(sdk.OneDec()).Quo(x.Add(sdk.OneDec()))
+	z2 := z.Mul(z)
+	sum, power := sdk.ZeroDec(), z
+	for i := int64(1); !power.IsZero(); i += 2 {
+		sum = sum.Add(power.QuoInt64(i))
+		power = power.Mul(z2)
+	}
+	return sum.MulInt64(2)
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func toFloat(d sdk.Dec) float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func TestDecExpAndLn(t *testing.T) {
+	for _, x := range []float64{-20, -3.5, -0.1, 0, 0.3, 1, 2.5, 10, 25} {
+		got := toFloat(decExp(sdk.NewDecWithPrec(int64(x*10), 1)))
+		require.InDelta(t, math.Exp(x), got, math.Exp(x)*1e-12+1e-17, "exp(%v)", x)
+	}
+	for _, x := range []float64{0.001, 0.5, 1, 1.7, 2, 3, 100, 1e9} {
+		d := sdk.NewDecWithPrec(int64(x*1000), 3)
+		got := toFloat(decLn(d))
+		require.InDelta(t, math.Log(x), got, 1e-12, "ln(%v)", x)
+	}
+}
+
+func TestCurvePriceAndMoney(t *testing.T) {
+	maxSupply := sdk.NewInt(1000000)
+	initPrice := sdk.NewDec(1)
+	maxPrice := sdk.NewDec(5)
+	tranches := []Tranche{
+		{Supply: sdk.NewInt(400000), Price: sdk.NewDec(1)},
+		{Supply: sdk.NewInt(600000), Price: sdk.NewDec(5)},
+	}
+	curves := map[string]Curve{
+		CurveTranches:    NewCurve(CurveTranches, initPrice, maxPrice, maxSupply, 0, tranches),
+		CurveSigmoid:     NewCurve(CurveSigmoid, initPrice, maxPrice, maxSupply, 10, nil),
+		CurveExponential: NewCurve(CurveExponential, initPrice, maxPrice, maxSupply, 0, nil),
+	}
+	for name, curve := range curves {
+		price, money, ok := curve.PriceAndMoney(sdk.ZeroInt())
+		require.True(t, ok, name)
+		require.Equal(t, initPrice, price, name)
+		require.True(t, money.IsZero(), name)
+
+		price, _, ok = curve.PriceAndMoney(maxSupply)
+		require.True(t, ok, name)
+		require.Equal(t, maxPrice, price, name)
+
+		_, _, ok = curve.PriceAndMoney(maxSupply.AddRaw(1))
+		require.False(t, ok, name)
+
+		// price and money never decrease along the curve
+		lastPrice, lastMoney := sdk.ZeroDec(), sdk.ZeroInt()
+		for s := int64(0); s <= maxSupply.Int64(); s += 50000 {
+			price, money, ok := curve.PriceAndMoney(sdk.NewInt(s))
+			require.True(t, ok, name)
+			require.True(t, price.GTE(lastPrice), name)
+			require.True(t, money.GTE(lastMoney), name)
+			lastPrice, lastMoney = price, money
+		}
+	}
+
+	_, money, _ := curves[CurveTranches].PriceAndMoney(sdk.NewInt(500000))
+	require.Equal(t, sdk.NewInt(400000+5*100000), money)
+	price, _, _ := curves[CurveTranches].PriceAndMoney(sdk.NewInt(400000))
+	require.Equal(t, sdk.NewDec(5), price)
+
+	// the sigmoid curve is symmetric, so it sells all the stock at the average price
+	_, money, _ = curves[CurveSigmoid].PriceAndMoney(maxSupply)
+	require.InDelta(t, 3000000, money.Int64(), 1)
+	price, _, _ = curves[CurveSigmoid].PriceAndMoney(maxSupply.QuoRaw(2))
+	require.Equal(t, "3.000000000000000000", price.String())
+
+	// (p_max - p_init) / ln(p_max / p_init) * s_max
+	_, money, _ = curves[CurveExponential].PriceAndMoney(maxSupply)
+	require.InDelta(t, 4/math.Log(5)*1000000, float64(money.Int64()), 1)
+}
+
+func TestValidateCurve(t *testing.T) {
+	maxSupply := sdk.NewInt(1000)
+	one, two := sdk.NewDec(1), sdk.NewDec(2)
+	tranches := []Tranche{
+		{Supply: sdk.NewInt(400), Price: one},
+		{Supply: sdk.NewInt(600), Price: two},
+	}
+	require.Nil(t, ValidateCurve(CurveTranches, one, two, maxSupply, 0, tranches))
+	require.Equal(t, ErrInvalidCurveParam("steepness is only for sigmoid curve"),
+		ValidateCurve(CurveTranches, one, two, maxSupply, 1, tranches))
+	require.Equal(t, ErrInvalidCurveParam("the supplies of tranches must sum up to max supply"),
+		ValidateCurve(CurveTranches, one, two, maxSupply.AddRaw(1), 0, tranches))
+	require.Equal(t, ErrInvalidCurveParam("init price and max price must be the prices of the first and last tranches"),
+		ValidateCurve(CurveTranches, sdk.ZeroDec(), two, maxSupply, 0, tranches))
+	require.Equal(t, ErrInvalidCurveParam("the prices of tranches must not decrease"),
+		ValidateCurve(CurveTranches, two, one, maxSupply, 0, []Tranche{tranches[1], tranches[0]}))
+	require.Equal(t, ErrInvalidCurveParam("the count of tranches is out of range"),
+		ValidateCurve(CurveTranches, one, two, maxSupply, 0, nil))
+
+	require.Nil(t, ValidateCurve(CurveSigmoid, sdk.ZeroDec(), two, maxSupply, 10, nil))
+	require.Equal(t, ErrInvalidCurveParam("steepness is out of range"),
+		ValidateCurve(CurveSigmoid, one, two, maxSupply, MaxSteepness+1, nil))
+	require.Equal(t, ErrPriceConfiguration(), ValidateCurve(CurveSigmoid, two, one, maxSupply, 10, nil))
+
+	require.Nil(t, ValidateCurve(CurveExponential, one, two, maxSupply, 0, nil))
+	require.Equal(t, ErrNonPositivePrice(), ValidateCurve(CurveExponential, sdk.ZeroDec(), two, maxSupply, 0, nil))
+	require.Equal(t, ErrPriceTooBig(), ValidateCurve(CurveExponential, one, sdk.NewDec(MaxTradeAmount), maxSupply, 0, nil))
+	// a tiny price ratio rounds k to zero, which must not be divided by
+	require.Equal(t, ErrInvalidCurveParam("max price is too close to init price for exponential curve"),
+		ValidateCurve(CurveExponential, one, one.Add(sdk.NewDecWithPrec(1, 18)), maxSupply, 0, nil))
+	require.Equal(t, ErrInvalidCurveParam("max price is too close to init price for exponential curve"),
+		ValidateCurve(CurveExponential, one, sdk.NewDecWithPrec(10009, 4), maxSupply, 0, nil))
+	require.Nil(t, ValidateCurve(CurveExponential, one, sdk.NewDecWithPrec(1002, 3), maxSupply, 0, nil))
+
+	require.Equal(t, ErrInvalidCurveType(), ValidateCurve("cubic", one, two, maxSupply, 0, nil))
+}
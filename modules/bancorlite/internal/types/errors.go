@@ -38,6 +38,18 @@ const (
 	CodeAlphaBreakLimit              sdk.CodeType = 1030
 	CodeMaxMoneyTooBig               sdk.CodeType = 1031
 	CodeNegativeMaxMoney             sdk.CodeType = 1032
+	CodeAmountAndMoneyAmountBothSet  sdk.CodeType = 1033
+	CodeMoneyAmountTooSmall          sdk.CodeType = 1034
+	CodeInvalidCurveType             sdk.CodeType = 1035
+	CodeInvalidCurveParam            sdk.CodeType = 1036
+	CodeNoLiquidityPoolExists        sdk.CodeType = 1037
+	CodeInvalidFeeRate               sdk.CodeType = 1038
+	CodeLiquidityTooSmall            sdk.CodeType = 1039
+	CodeInvalidBancorUpdate          sdk.CodeType = 1040
+	CodeRouteUnavailable             sdk.CodeType = 1041
+	CodeInvalidTradeLimit            sdk.CodeType = 1042
+	CodeTradeLimitExceeded           sdk.CodeType = 1043
+	CodeInvalidAuction               sdk.CodeType = 1044
 )
 
 func ErrInvalidSymbol() sdk.Error {
@@ -152,6 +164,54 @@ func ErrMaxMoneyTooBIg() sdk.Error {
 	return sdk.NewError(CodeSpaceBancorlite, CodeMaxMoneyTooBig, "max money is too big")
 }
 
+func ErrAmountAndMoneyAmountBothSet() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeAmountAndMoneyAmountBothSet, "stock amount and money amount can not be both set")
+}
+
+func ErrMoneyAmountTooSmall() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeMoneyAmountTooSmall, "The money amount is too small to trade any stock")
+}
+
 func ErrMarshalFailed() sdk.Error {
 	return sdk.NewError(CodeSpaceBancorlite, CodeMarshalFailed, "could not marshal result to JSON")
 }
+
+func ErrInvalidCurveType() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidCurveType, "Invalid curve type")
+}
+
+func ErrInvalidCurveParam(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidCurveParam, "Invalid curve parameter: "+reason)
+}
+
+func ErrNoLiquidityPoolExists() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeNoLiquidityPoolExists, "No liquidity pool exists")
+}
+
+func ErrInvalidFeeRate() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidFeeRate, "Invalid fee rate of liquidity pool")
+}
+
+func ErrLiquidityTooSmall() sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeLiquidityTooSmall, "The liquidity is too small to get any shares or coins")
+}
+
+func ErrInvalidBancorUpdate(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidBancorUpdate, "Invalid bancor update: "+reason)
+}
+
+func ErrRouteUnavailable(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeRouteUnavailable, "Can not route the trade: "+reason)
+}
+
+func ErrInvalidTradeLimit(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidTradeLimit, "Invalid trade limit: "+reason)
+}
+
+func ErrTradeLimitExceeded(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeTradeLimitExceeded, "Trade limit exceeded: "+reason)
+}
+
+func ErrInvalidAuction(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceBancorlite, CodeInvalidAuction, "Invalid auction: "+reason)
+}
@@ -2,6 +2,10 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/market"
 )
 
 // Bankx Keeper will implement the interface
@@ -11,6 +15,7 @@ type ExpectedBankxKeeper interface {
 	UnFreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                 // unfreeze coins and then orders can be executed
 	DeductFee(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error
 	DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error
+	SetModuleAccount(ctx sdk.Context, name string) sdk.AccAddress // hold the reserves of liquidity pools
 }
 
 // Asset Keeper will implement the interface
@@ -20,15 +25,38 @@ type ExpectedAssetStatusKeeper interface {
 	IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
 }
 
+// Asset Keeper will implement the interface, to manage the share tokens of liquidity pools
+type ExpectedAssetKeeper interface {
+	ExpectedAssetStatusKeeper
+	GetToken(ctx sdk.Context, symbol string) asset.Token
+	GetIssueTokenFee(ctx sdk.Context, symbol string) int64
+	IssueToken(ctx sdk.Context, name string, symbol string, totalSupply sdk.Int, owner sdk.AccAddress,
+		mintable bool, burnable bool, addrForbiddable bool, tokenForbiddable bool,
+		url string, description string, identity string) sdk.Error
+	MintToken(ctx sdk.Context, symbol string, owner sdk.AccAddress, amount sdk.Int) sdk.Error
+	BurnToken(ctx sdk.Context, symbol string, owner sdk.AccAddress, amount sdk.Int) sdk.Error
+	SendCoinsFromAssetModuleToAccount(ctx sdk.Context, addresses sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToAssetModule(ctx sdk.Context, addresses sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
 // market keeper will implement the interface
 type ExpectedMarketKeeper interface {
 	IsMarketExist(ctx sdk.Context, symbol string) bool
 	GetMarketFeeMin(ctx sdk.Context) int64
 	GetMarketVolume(ctx sdk.Context, stock, money string, stockVolume, moneyVolume sdk.Dec) sdk.Dec
+	GetMarketInfo(ctx sdk.Context, symbol string) (market.MarketInfo, error)
+	GetOrdersCrossingPrice(ctx sdk.Context, symbol string, side byte, price sdk.Dec) []*market.Order
+	GetCetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
+
+// market module will implement the interface, to route trades to the order books
+type ExpectedOrderCreator interface {
+	CreateIOCOrder(ctx sdk.Context, msg market.MsgCreateOrder) (market.Order, sdk.Error)
 }
 
 type ExpectedAuthXKeeper interface {
 	GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress
 	GetRebateRatio(ctx sdk.Context) int64
 	GetRebateRatioBase(ctx sdk.Context) int64
+	GetAccountX(ctx sdk.Context, addr sdk.AccAddress) (authx.AccountX, bool)
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/modules/asset"
 	"github.com/coinexchain/cet-sdk/modules/market"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -13,9 +14,19 @@ import (
 
 const MaxTradeAmount = int64(10000) * int64(10000) * int64(10000) * int64(10000) * 100 // Ten Billion
 
+const MaxLiquidityPoolFeeRate = 1000 // 10%
+
+// the identify of the market orders created by MsgBancorRouteTrade
+const RouteOrderIdentify = byte(255)
+
 var _ sdk.Msg = MsgBancorInit{}
 var _ sdk.Msg = MsgBancorTrade{}
 var _ sdk.Msg = MsgBancorCancel{}
+var _ sdk.Msg = MsgBancorUpdate{}
+var _ sdk.Msg = MsgBancorRouteTrade{}
+var _ sdk.Msg = MsgLiquidityPoolInit{}
+var _ sdk.Msg = MsgLiquidityPoolAdd{}
+var _ sdk.Msg = MsgLiquidityPoolWithdraw{}
 
 type MsgBancorInit struct {
 	Owner              sdk.AccAddress `json:"owner"`
@@ -27,6 +38,18 @@ type MsgBancorInit struct {
 	MaxMoney           sdk.Int        `json:"max_money"`
 	StockPrecision     byte           `json:"stock_precision"`
 	EarliestCancelTime int64          `json:"earliest_cancel_time"`
+	// the shape of the curve, the default one is decided by MaxMoney
+	CurveType string    `json:"curve_type,omitempty"`
+	Steepness int64     `json:"steepness,omitempty"` // only for sigmoid curve
+	Tranches  []Tranche `json:"tranches,omitempty"`  // only for tranches curve
+	// the optional limits of trades, zero or empty means no limit
+	MaxStockPerTrade int64  `json:"max_stock_per_trade,omitempty"`
+	MaxStockPerBlock int64  `json:"max_stock_per_block,omitempty"` // traded by each account in a block
+	MaxPriceChange   string `json:"max_price_change,omitempty"`    // of the price in a block
+	// the optional Dutch auction after the pool is created, in which buyers pay a premium over
+	// the curve price, decaying from AuctionStartPrice to the curve price in AuctionDuration seconds
+	AuctionStartPrice string `json:"auction_start_price,omitempty"`
+	AuctionDuration   int64  `json:"auction_duration,omitempty"`
 }
 
 type MsgBancorCancel struct {
@@ -35,6 +58,20 @@ type MsgBancorCancel struct {
 	Money string         `json:"money"`
 }
 
+// MsgBancorUpdate changes a pool by its owner. A zero field is left unchanged.
+type MsgBancorUpdate struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Stock string         `json:"stock"`
+	Money string         `json:"money"`
+	// the new max supply, which can only be raised. The curve is extended beyond the current
+	// supplied stock, whose price and money are unchanged, and the extra stock is frozen into the pool
+	MaxSupply sdk.Int `json:"max_supply"`
+	// the new earliest cancel time, which can only be extended
+	EarliestCancelTime int64 `json:"earliest_cancel_time"`
+	// the money to be withdrawn, for which the owner puts back stock along the curve
+	WithdrawMoney sdk.Int `json:"withdraw_money"`
+}
+
 type MsgBancorTrade struct {
 	Sender sdk.AccAddress `json:"sender"`
 	Stock  string         `json:"stock"`
@@ -44,6 +81,51 @@ type MsgBancorTrade struct {
 	IsBuy  bool  `json:"is_buy"`
 	//money up limit
 	MoneyLimit int64 `json:"money_limit"`
+	//money amount, used instead of Amount to trade the maximum stock within it
+	MoneyAmount int64 `json:"money_amount,omitempty"`
+}
+
+// MsgBancorRouteTrade buys or sells stock at the best combined price of the bancor pool
+// and the resting orders of the market, splitting Amount between them
+type MsgBancorRouteTrade struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Stock  string         `json:"stock"`
+	Money  string         `json:"money"`
+	Amount int64          `json:"amount"`
+	IsBuy  bool           `json:"is_buy"`
+	// the limit of the total money of both parts, the upper one for buying and the lower one for selling
+	MoneyLimit int64 `json:"money_limit"`
+}
+
+// MsgLiquidityPoolInit creates a constant-product pool, whose liquidity providers
+// get share tokens issued as ShareSymbol through the asset module
+type MsgLiquidityPoolInit struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Stock       string         `json:"stock"`
+	Money       string         `json:"money"`
+	ShareSymbol string         `json:"share_symbol"`
+	StockAmount sdk.Int        `json:"stock_amount"`
+	MoneyAmount sdk.Int        `json:"money_amount"`
+	// the fee rate of trades, in 1/10000, which is paid in money and kept in the pool
+	FeeRate int64 `json:"fee_rate"`
+}
+
+// MsgLiquidityPoolAdd adds at most StockAmount stock and MoneyAmount money to a pool
+// in its current ratio, and gets share tokens in return
+type MsgLiquidityPoolAdd struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Stock       string         `json:"stock"`
+	Money       string         `json:"money"`
+	StockAmount sdk.Int        `json:"stock_amount"`
+	MoneyAmount sdk.Int        `json:"money_amount"`
+}
+
+// MsgLiquidityPoolWithdraw burns share tokens and withdraws their part of the pool
+type MsgLiquidityPoolWithdraw struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Stock  string         `json:"stock"`
+	Money  string         `json:"money"`
+	Shares sdk.Int        `json:"shares"`
 }
 
 func (msg MsgBancorInit) GetSymbol() string {
@@ -100,14 +182,27 @@ func (msg MsgBancorInit) ValidateBasic() (err sdk.Error) {
 		return ErrNegativePrice()
 	}
 
-	ar, ok := CheckAR(msg, initPrice, maxPrice)
-	if ar > MaxAR || ar < 0 || !ok {
-		return ErrAlphaBreakLimit()
-	}
-	if ar == 0 {
-		if err := checkMaxPrice(initPrice, maxPrice, msg.MaxSupply); err != nil {
+	if msg.CurveType != CurveDefault {
+		// the max money of such a curve is decided by its shape
+		if !msg.MaxMoney.IsZero() {
+			return ErrInvalidCurveParam("max money must be zero for a curve not of the default shape")
+		}
+		if err := ValidateCurve(msg.CurveType, initPrice, maxPrice, msg.MaxSupply, msg.Steepness, msg.Tranches); err != nil {
 			return err
 		}
+	} else {
+		if msg.Steepness != 0 || len(msg.Tranches) != 0 {
+			return ErrInvalidCurveParam("default curve has no steepness or tranches")
+		}
+		ar, ok := CheckAR(msg, initPrice, maxPrice)
+		if ar > MaxAR || ar < 0 || !ok {
+			return ErrAlphaBreakLimit()
+		}
+		if ar == 0 {
+			if err := checkMaxPrice(initPrice, maxPrice, msg.MaxSupply); err != nil {
+				return err
+			}
+		}
 	}
 	if !CheckStockPrecision(msg.MaxSupply, msg.StockPrecision) {
 		return ErrStockSupplyPrecisionNotMatch()
@@ -115,9 +210,58 @@ func (msg MsgBancorInit) ValidateBasic() (err sdk.Error) {
 	if msg.EarliestCancelTime < 0 {
 		return ErrEarliestCancelTimeIsNegative()
 	}
+	if _, err := msg.GetMaxPriceChange(); err != nil {
+		return err
+	}
+	if msg.MaxStockPerTrade < 0 || msg.MaxStockPerBlock < 0 {
+		return ErrInvalidTradeLimit("the max stock must not be negative")
+	}
+	if _, err := msg.GetAuctionStartPrice(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// GetMaxPriceChange returns the max price change in a block, which is zero when there is no limit
+func (msg MsgBancorInit) GetMaxPriceChange() (sdk.Dec, sdk.Error) {
+	if msg.MaxPriceChange == "" {
+		return sdk.ZeroDec(), nil
+	}
+	change, err := sdk.NewDecFromStr(msg.MaxPriceChange)
+	if err != nil {
+		return sdk.ZeroDec(), ErrPriceFmt()
+	}
+	if change.IsNegative() {
+		return sdk.ZeroDec(), ErrInvalidTradeLimit("the max price change must not be negative")
+	}
+	return change, nil
+}
+
+// GetAuctionStartPrice returns the start price of the Dutch auction, which is zero when there is no auction
+func (msg MsgBancorInit) GetAuctionStartPrice() (sdk.Dec, sdk.Error) {
+	if msg.AuctionStartPrice == "" {
+		if msg.AuctionDuration != 0 {
+			return sdk.ZeroDec(), ErrInvalidAuction("the start price is missing")
+		}
+		return sdk.ZeroDec(), nil
+	}
+	startPrice, err := sdk.NewDecFromStr(msg.AuctionStartPrice)
+	if err != nil {
+		return sdk.ZeroDec(), ErrPriceFmt()
+	}
+	initPrice, err := sdk.NewDecFromStr(msg.InitPrice)
+	if err != nil {
+		return sdk.ZeroDec(), ErrPriceFmt()
+	}
+	if !startPrice.GT(initPrice) {
+		return sdk.ZeroDec(), ErrInvalidAuction("the start price must be more than the init price")
+	}
+	if msg.AuctionDuration <= 0 {
+		return sdk.ZeroDec(), ErrInvalidAuction("the duration must be positive")
+	}
+	return startPrice, nil
+}
+
 func checkMaxPrice(initPrice, maxPrice sdk.Dec, maxSupply sdk.Int) (err sdk.Error) {
 	if initPrice.GT(maxPrice) {
 		return ErrPriceConfiguration()
@@ -208,6 +352,50 @@ func (msg MsgBancorCancel) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+func (msg MsgBancorUpdate) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+
+func (msg MsgBancorUpdate) Route() string { return RouterKey }
+
+func (msg MsgBancorUpdate) Type() string { return "bancor_update" }
+
+func (msg MsgBancorUpdate) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if len(msg.Stock) == 0 || len(msg.Money) == 0 {
+		return ErrInvalidSymbol()
+	}
+	if msg.EarliestCancelTime < 0 {
+		return ErrEarliestCancelTimeIsNegative()
+	}
+	if msg.MaxSupply.IsNegative() {
+		return ErrNonPositiveSupply()
+	}
+	if msg.MaxSupply.GT(sdk.NewInt(MaxTradeAmount)) {
+		return ErrMaxSupplyTooBig()
+	}
+	if msg.WithdrawMoney.IsNegative() {
+		return ErrNonPositiveAmount()
+	}
+	if msg.WithdrawMoney.GT(sdk.NewInt(MaxTradeAmount)) {
+		return ErrTradeAmountIsTooLarge()
+	}
+	if msg.MaxSupply.IsZero() && msg.WithdrawMoney.IsZero() && msg.EarliestCancelTime == 0 {
+		return ErrInvalidBancorUpdate("nothing to update")
+	}
+	return nil
+}
+
+func (msg MsgBancorUpdate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBancorUpdate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 func (msg MsgBancorTrade) Route() string { return RouterKey }
 
 func (msg MsgBancorTrade) Type() string { return "bancor_trade" }
@@ -222,6 +410,18 @@ func (msg MsgBancorTrade) ValidateBasic() sdk.Error {
 	if !market.IsValidTradingPair([]string{msg.Stock, msg.Money}) {
 		return ErrInvalidSymbol()
 	}
+	if msg.MoneyAmount < 0 {
+		return ErrNonPositiveAmount()
+	}
+	if msg.MoneyAmount > 0 {
+		if msg.Amount != 0 {
+			return ErrAmountAndMoneyAmountBothSet()
+		}
+		if msg.MoneyAmount > MaxTradeAmount {
+			return ErrTradeAmountIsTooLarge()
+		}
+		return nil
+	}
 	if msg.Amount <= 0 {
 		return ErrNonPositiveAmount()
 	}
@@ -231,6 +431,10 @@ func (msg MsgBancorTrade) ValidateBasic() sdk.Error {
 	return nil
 }
 
+func (msg MsgBancorTrade) IsMoneyDenominated() bool {
+	return msg.MoneyAmount > 0
+}
+
 func (msg MsgBancorTrade) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
@@ -239,6 +443,154 @@ func (msg MsgBancorTrade) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+func (msg MsgBancorRouteTrade) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+
+func (msg MsgBancorRouteTrade) Route() string { return RouterKey }
+
+func (msg MsgBancorRouteTrade) Type() string { return "bancor_route_trade" }
+
+func (msg MsgBancorRouteTrade) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.Stock) == 0 || len(msg.Money) == 0 || msg.Stock == "cet" {
+		return ErrInvalidSymbol()
+	}
+	if !market.IsValidTradingPair([]string{msg.Stock, msg.Money}) {
+		return ErrInvalidSymbol()
+	}
+	if msg.Amount <= 0 {
+		return ErrNonPositiveAmount()
+	}
+	if msg.Amount > MaxTradeAmount {
+		return ErrTradeAmountIsTooLarge()
+	}
+	if msg.MoneyLimit < 0 {
+		return ErrNonPositiveAmount()
+	}
+	return nil
+}
+
+func (msg MsgBancorRouteTrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBancorRouteTrade) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgLiquidityPoolInit) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+
+func (msg MsgLiquidityPoolInit) Route() string { return RouterKey }
+
+func (msg MsgLiquidityPoolInit) Type() string { return "liquidity_pool_init" }
+
+func (msg MsgLiquidityPoolInit) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if err := validateLiquidityPoolSymbol(msg.Stock, msg.Money); err != nil {
+		return err
+	}
+	if err := asset.ValidateTokenSymbol(msg.ShareSymbol); err != nil {
+		return err
+	}
+	if err := validateLiquidityAmounts(msg.StockAmount, msg.MoneyAmount); err != nil {
+		return err
+	}
+	if msg.FeeRate < 0 || msg.FeeRate > MaxLiquidityPoolFeeRate {
+		return ErrInvalidFeeRate()
+	}
+	return nil
+}
+
+func (msg MsgLiquidityPoolInit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgLiquidityPoolInit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgLiquidityPoolAdd) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+
+func (msg MsgLiquidityPoolAdd) Route() string { return RouterKey }
+
+func (msg MsgLiquidityPoolAdd) Type() string { return "liquidity_pool_add" }
+
+func (msg MsgLiquidityPoolAdd) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if err := validateLiquidityPoolSymbol(msg.Stock, msg.Money); err != nil {
+		return err
+	}
+	return validateLiquidityAmounts(msg.StockAmount, msg.MoneyAmount)
+}
+
+func (msg MsgLiquidityPoolAdd) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgLiquidityPoolAdd) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgLiquidityPoolWithdraw) GetSymbol() string {
+	return dex.GetSymbol(msg.Stock, msg.Money)
+}
+
+func (msg MsgLiquidityPoolWithdraw) Route() string { return RouterKey }
+
+func (msg MsgLiquidityPoolWithdraw) Type() string { return "liquidity_pool_withdraw" }
+
+func (msg MsgLiquidityPoolWithdraw) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if err := validateLiquidityPoolSymbol(msg.Stock, msg.Money); err != nil {
+		return err
+	}
+	if !msg.Shares.IsPositive() {
+		return ErrNonPositiveAmount()
+	}
+	return nil
+}
+
+func (msg MsgLiquidityPoolWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgLiquidityPoolWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func validateLiquidityPoolSymbol(stock, money string) sdk.Error {
+	if len(stock) == 0 || len(money) == 0 || stock == dex.CET {
+		return ErrInvalidSymbol()
+	}
+	if !market.IsValidTradingPair([]string{stock, money}) {
+		return ErrInvalidSymbol()
+	}
+	return nil
+}
+
+func validateLiquidityAmounts(stockAmount, moneyAmount sdk.Int) sdk.Error {
+	if !stockAmount.IsPositive() || !moneyAmount.IsPositive() {
+		return ErrNonPositiveAmount()
+	}
+	if stockAmount.GT(sdk.NewInt(MaxTradeAmount)) || moneyAmount.GT(sdk.NewInt(MaxTradeAmount)) {
+		return ErrTradeAmountIsTooLarge()
+	}
+	return nil
+}
+
 // --------------------------------------------------------
 // SetAccAddress
 
@@ -251,3 +603,18 @@ func (msg *MsgBancorTrade) SetAccAddress(addr sdk.AccAddress) {
 func (msg *MsgBancorCancel) SetAccAddress(addr sdk.AccAddress) {
 	msg.Owner = addr
 }
+func (msg *MsgBancorUpdate) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+func (msg *MsgBancorRouteTrade) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+func (msg *MsgLiquidityPoolInit) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+func (msg *MsgLiquidityPoolAdd) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+func (msg *MsgLiquidityPoolWithdraw) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
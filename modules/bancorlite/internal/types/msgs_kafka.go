@@ -37,11 +37,33 @@ type MsgBancorTradeInfoForKafka struct {
 	Amount            int64          `json:"amount"`
 	Side              byte           `json:"side"`
 	MoneyLimit        int64          `json:"money_limit"`
+	MoneyAmount       int64          `json:"money_amount"`
 	TxPrice           sdk.Dec        `json:"transaction_price"`
 	UsedCommission    int64          `json:"used_commission"`
 	RebateAmount      int64          `json:"rebate_amount"`
 	RebateRefereeAddr sdk.AccAddress `json:"rebate_referee_addr"`
 	BlockHeight       int64          `json:"block_height"`
+	// the money paid to the owner over the curve price in an auction, included in TxPrice
+	AuctionPremium int64 `json:"auction_premium,omitempty"`
+}
+
+type MsgBancorRouteTradeForKafka struct {
+	Sender            sdk.AccAddress `json:"sender"`
+	Stock             string         `json:"stock"`
+	Money             string         `json:"money"`
+	Amount            int64          `json:"amount"`
+	Side              byte           `json:"side"`
+	MoneyLimit        int64          `json:"money_limit"`
+	BancorAmount      int64          `json:"bancor_amount"`
+	BancorMoney       int64          `json:"bancor_money"`
+	MarketAmount      int64          `json:"market_amount"`
+	MarketMoney       int64          `json:"market_money"`
+	MarketPrice       sdk.Dec        `json:"market_price"`
+	MarketOrderID     string         `json:"market_order_id"`
+	UsedCommission    int64          `json:"used_commission"`
+	RebateAmount      int64          `json:"rebate_amount"`
+	RebateRefereeAddr sdk.AccAddress `json:"rebate_referee_addr"`
+	BlockHeight       int64          `json:"block_height"`
 }
 
 type MsgBancorCancelForKafka struct {
@@ -50,3 +72,15 @@ type MsgBancorCancelForKafka struct {
 	Money       string         `json:"money"`
 	BlockHeight int64          `json:"block_height"`
 }
+
+type MsgLiquidityPoolChangeForKafka struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Stock       string         `json:"stock"`
+	Money       string         `json:"money"`
+	ShareSymbol string         `json:"share_symbol"`
+	Shares      sdk.Int        `json:"shares"`
+	StockAmount sdk.Int        `json:"stock_amount"`
+	MoneyAmount sdk.Int        `json:"money_amount"`
+	IsAdd       bool           `json:"is_add"`
+	BlockHeight int64          `json:"block_height"`
+}
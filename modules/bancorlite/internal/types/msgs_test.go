@@ -143,14 +143,40 @@ func TestCheckAR(t *testing.T) {
 	assert.Equal(t, int64(2333), ar)
 }
 
+func TestMsgBancorInit_ValidateBasicCurveShapes(t *testing.T) {
+	msg := MsgBancorInit{
+		Owner:     addrOwner,
+		Stock:     "abc",
+		Money:     "cet",
+		InitPrice: "1",
+		MaxSupply: sdk.NewInt(100),
+		MaxPrice:  "10",
+		MaxMoney:  sdk.ZeroInt(),
+		CurveType: CurveSigmoid,
+		Steepness: 10,
+	}
+	assert.Nil(t, msg.ValidateBasic())
+
+	msg.MaxMoney = sdk.NewInt(800)
+	assert.Equal(t, ErrInvalidCurveParam("max money must be zero for a curve not of the default shape"), msg.ValidateBasic())
+
+	msg.CurveType = CurveDefault
+	assert.Equal(t, ErrInvalidCurveParam("default curve has no steepness or tranches"), msg.ValidateBasic())
+
+	msg.CurveType = "cubic"
+	msg.MaxMoney = sdk.ZeroInt()
+	assert.Equal(t, ErrInvalidCurveType(), msg.ValidateBasic())
+}
+
 func TestMsgBancorTrade_ValidateBasic(t *testing.T) {
 	type fields struct {
-		Sender     sdk.AccAddress
-		Stock      string
-		Money      string
-		Amount     int64
-		IsBuy      bool
-		MoneyLimit int64
+		Sender      sdk.AccAddress
+		Stock       string
+		Money       string
+		Amount      int64
+		IsBuy       bool
+		MoneyLimit  int64
+		MoneyAmount int64
 	}
 	tests := []struct {
 		name   string
@@ -217,16 +243,66 @@ func TestMsgBancorTrade_ValidateBasic(t *testing.T) {
 			},
 			want: ErrTradeAmountIsTooLarge(),
 		},
+		{
+			name: "money amount without amount",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				IsBuy:       true,
+				MoneyLimit:  10,
+				MoneyAmount: 10,
+			},
+			want: nil,
+		},
+		{
+			name: "amount and money amount both set",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				Amount:      10,
+				IsBuy:       true,
+				MoneyLimit:  10,
+				MoneyAmount: 10,
+			},
+			want: ErrAmountAndMoneyAmountBothSet(),
+		},
+		{
+			name: "money amount below zero",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				IsBuy:       true,
+				MoneyLimit:  10,
+				MoneyAmount: -1,
+			},
+			want: ErrNonPositiveAmount(),
+		},
+		{
+			name: "money amount exceeds max",
+			fields: fields{
+				Sender:      addrUser,
+				Stock:       "abc",
+				Money:       "cet",
+				IsBuy:       true,
+				MoneyLimit:  10,
+				MoneyAmount: MaxTradeAmount + 1,
+			},
+			want: ErrTradeAmountIsTooLarge(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			msg := MsgBancorTrade{
-				Sender:     tt.fields.Sender,
-				Stock:      tt.fields.Stock,
-				Money:      tt.fields.Money,
-				Amount:     tt.fields.Amount,
-				IsBuy:      tt.fields.IsBuy,
-				MoneyLimit: tt.fields.MoneyLimit,
+				Sender:      tt.fields.Sender,
+				Stock:       tt.fields.Stock,
+				Money:       tt.fields.Money,
+				Amount:      tt.fields.Amount,
+				IsBuy:       tt.fields.IsBuy,
+				MoneyLimit:  tt.fields.MoneyLimit,
+				MoneyAmount: tt.fields.MoneyAmount,
 			}
 			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("MsgBancorTrade.ValidateBasic() = %v, want %v", got, tt.want)
@@ -303,3 +379,150 @@ func TestCheckStockPrecision(t *testing.T) {
 	match = CheckStockPrecision(amount, 100)
 	assert.True(t, match)
 }
+
+func TestMsgLiquidityPool_ValidateBasic(t *testing.T) {
+	msgInit := MsgLiquidityPoolInit{
+		Sender:      addrUser,
+		Stock:       "abc",
+		Money:       "cet",
+		ShareSymbol: "abclp",
+		StockAmount: sdk.NewInt(100),
+		MoneyAmount: sdk.NewInt(200),
+		FeeRate:     30,
+	}
+	assert.Nil(t, msgInit.ValidateBasic())
+	msgInit.FeeRate = MaxLiquidityPoolFeeRate + 1
+	assert.Equal(t, ErrInvalidFeeRate(), msgInit.ValidateBasic())
+	msgInit.FeeRate = 0
+	msgInit.Stock = "cet"
+	msgInit.Money = "abc"
+	assert.Equal(t, ErrInvalidSymbol(), msgInit.ValidateBasic())
+	msgInit.Stock = "abc"
+	msgInit.Money = "cet"
+	msgInit.ShareSymbol = "A"
+	assert.NotNil(t, msgInit.ValidateBasic())
+
+	msgAdd := MsgLiquidityPoolAdd{
+		Sender:      addrUser,
+		Stock:       "abc",
+		Money:       "cet",
+		StockAmount: sdk.NewInt(100),
+		MoneyAmount: sdk.ZeroInt(),
+	}
+	assert.Equal(t, ErrNonPositiveAmount(), msgAdd.ValidateBasic())
+	msgAdd.MoneyAmount = sdk.NewInt(MaxTradeAmount + 1)
+	assert.Equal(t, ErrTradeAmountIsTooLarge(), msgAdd.ValidateBasic())
+	msgAdd.MoneyAmount = sdk.NewInt(100)
+	assert.Nil(t, msgAdd.ValidateBasic())
+	msgAdd.Sender = nil
+	assert.NotNil(t, msgAdd.ValidateBasic())
+
+	msgWithdraw := MsgLiquidityPoolWithdraw{
+		Sender: addrUser,
+		Stock:  "abc",
+		Money:  "cet",
+		Shares: sdk.ZeroInt(),
+	}
+	assert.Equal(t, ErrNonPositiveAmount(), msgWithdraw.ValidateBasic())
+	msgWithdraw.Shares = sdk.NewInt(10)
+	assert.Nil(t, msgWithdraw.ValidateBasic())
+}
+
+func TestMsgBancorUpdate_ValidateBasic(t *testing.T) {
+	msg := MsgBancorUpdate{
+		Owner:         addrOwner,
+		Stock:         "abc",
+		Money:         "cet",
+		MaxSupply:     sdk.ZeroInt(),
+		WithdrawMoney: sdk.ZeroInt(),
+	}
+	assert.Equal(t, ErrInvalidBancorUpdate("nothing to update"), msg.ValidateBasic())
+	msg.EarliestCancelTime = -1
+	assert.Equal(t, ErrEarliestCancelTimeIsNegative(), msg.ValidateBasic())
+	msg.EarliestCancelTime = 0
+	msg.MaxSupply = sdk.NewInt(MaxTradeAmount + 1)
+	assert.Equal(t, ErrMaxSupplyTooBig(), msg.ValidateBasic())
+	msg.MaxSupply = sdk.NewInt(100)
+	assert.Nil(t, msg.ValidateBasic())
+	msg.WithdrawMoney = sdk.NewInt(-1)
+	assert.Equal(t, ErrNonPositiveAmount(), msg.ValidateBasic())
+	msg.WithdrawMoney = sdk.NewInt(1)
+	msg.Owner = nil
+	assert.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgBancorRouteTrade_ValidateBasic(t *testing.T) {
+	msg := MsgBancorRouteTrade{
+		Sender:     addrOwner,
+		Stock:      "abc",
+		Money:      "cet",
+		Amount:     100,
+		IsBuy:      true,
+		MoneyLimit: 1000,
+	}
+	assert.Nil(t, msg.ValidateBasic())
+	msg.Amount = 0
+	assert.Equal(t, ErrNonPositiveAmount(), msg.ValidateBasic())
+	msg.Amount = MaxTradeAmount + 1
+	assert.Equal(t, ErrTradeAmountIsTooLarge(), msg.ValidateBasic())
+	msg.Amount = 100
+	msg.MoneyLimit = -1
+	assert.Equal(t, ErrNonPositiveAmount(), msg.ValidateBasic())
+	msg.MoneyLimit = 0
+	msg.Stock = "cet"
+	assert.Equal(t, ErrInvalidSymbol(), msg.ValidateBasic())
+	msg.Stock = "abc"
+	msg.Sender = nil
+	assert.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgBancorInit_ValidateBasicTradeLimits(t *testing.T) {
+	msg := MsgBancorInit{
+		Owner:            addrOwner,
+		Stock:            "abc",
+		Money:            "cet",
+		InitPrice:        "1",
+		MaxSupply:        sdk.NewInt(1000),
+		MaxMoney:         sdk.ZeroInt(),
+		MaxPrice:         "2",
+		MaxStockPerTrade: 10,
+		MaxStockPerBlock: 20,
+		MaxPriceChange:   "0.5",
+	}
+	assert.Nil(t, msg.ValidateBasic())
+	msg.MaxStockPerBlock = -1
+	assert.Equal(t, ErrInvalidTradeLimit("the max stock must not be negative"), msg.ValidateBasic())
+	msg.MaxStockPerBlock = 0
+	msg.MaxPriceChange = "-1"
+	assert.Equal(t, ErrInvalidTradeLimit("the max price change must not be negative"), msg.ValidateBasic())
+	msg.MaxPriceChange = "x"
+	assert.Equal(t, ErrPriceFmt(), msg.ValidateBasic())
+	msg.MaxPriceChange = ""
+	assert.Nil(t, msg.ValidateBasic())
+}
+
+func TestMsgBancorInit_ValidateBasicAuction(t *testing.T) {
+	msg := MsgBancorInit{
+		Owner:             addrOwner,
+		Stock:             "abc",
+		Money:             "cet",
+		InitPrice:         "1",
+		MaxSupply:         sdk.NewInt(1000),
+		MaxMoney:          sdk.ZeroInt(),
+		MaxPrice:          "2",
+		AuctionStartPrice: "3",
+		AuctionDuration:   3600,
+	}
+	assert.Nil(t, msg.ValidateBasic())
+	msg.AuctionDuration = 0
+	assert.Equal(t, ErrInvalidAuction("the duration must be positive"), msg.ValidateBasic())
+	msg.AuctionDuration = 3600
+	msg.AuctionStartPrice = "1"
+	assert.Equal(t, ErrInvalidAuction("the start price must be more than the init price"), msg.ValidateBasic())
+	msg.AuctionStartPrice = "x"
+	assert.Equal(t, ErrPriceFmt(), msg.ValidateBasic())
+	msg.AuctionStartPrice = ""
+	assert.Equal(t, ErrInvalidAuction("the start price is missing"), msg.ValidateBasic())
+	msg.AuctionDuration = 0
+	assert.Nil(t, msg.ValidateBasic())
+}
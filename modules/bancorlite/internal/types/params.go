@@ -6,6 +6,8 @@ import (
 	"math"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 const (
@@ -19,12 +21,15 @@ var (
 	KeyCreateBancorFee = []byte("CreateBancorFee")
 	KeyCancelBancorFee = []byte("CancelBancorFee")
 	KeyTradeFeeRate    = []byte("TradeFeeRate")
+	KeyFeePricing      = []byte("FeePricing")
 )
 
 type Params struct {
 	CreateBancorFee int64 `json:"create_bancor_fee"`
 	CancelBancorFee int64 `json:"cancel_bancor_fee"`
 	TradeFeeRate    int64 `json:"trade_fee_rate"`
+	// the optional mode in which CreateBancorFee is priced in a reference token
+	FeePricing dex.FeePricing `json:"fee_pricing"`
 }
 
 // ParamKeyTable for bancorlite module
@@ -38,6 +43,7 @@ func DefaultParams() Params {
 		DefaultCreateBancorFee,
 		DefaultCancelBancorFee,
 		DefaultTradeFeeRate,
+		dex.DefaultFeePricing(),
 	}
 }
 
@@ -49,6 +55,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyCreateBancorFee, Value: &p.CreateBancorFee},
 		{Key: KeyCancelBancorFee, Value: &p.CancelBancorFee},
 		{Key: KeyTradeFeeRate, Value: &p.TradeFeeRate},
+		{Key: KeyFeePricing, Value: &p.FeePricing},
 	}
 }
 
@@ -59,6 +66,9 @@ func (p *Params) ValidateGenesis() error {
 	if p.TradeFeeRate < 0 || p.TradeFeeRate >= int64(math.Pow10(TradeFeeRatePrecision)) {
 		return fmt.Errorf("TradeFeeRate is invalid")
 	}
+	if err := p.FeePricing.Validate(); err != nil {
+		return fmt.Errorf("%s is invalid: %s", KeyFeePricing, err)
+	}
 	return nil
 }
 
@@ -73,8 +83,10 @@ func (p Params) String() string {
 	return fmt.Sprintf(`BancorLite Params:
   CreateBancorFee: %d
   CancelBancorFee: %d
-  TradeFeeRate:    %d`,
+  TradeFeeRate:    %d
+  FeePricing:      %s`,
 		p.CreateBancorFee,
 		p.CancelBancorFee,
-		p.TradeFeeRate)
+		p.TradeFeeRate,
+		p.FeePricing)
 }
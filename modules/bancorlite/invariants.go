@@ -0,0 +1,81 @@
+package bancorlite
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all bancorlite invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "bancor-consistency", BancorConsistencyInvariant(k))
+	ir.RegisterRoute(ModuleName, "bancor-reserves", BancorReservesInvariant(k))
+	ir.RegisterRoute(ModuleName, "liquidity-pool-reserves", LiquidityPoolInvariant(k))
+}
+
+// BancorConsistencyInvariant checks that the price and money in pool of every bancor pool follow its curve
+func BancorConsistencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.Iterate(ctx, func(bi *BancorInfo) {
+			if !bi.IsConsistent() {
+				broken = true
+				msg += fmt.Sprintf("%s: stock in pool %s, money in pool %s and price %s do not follow the %s curve\n",
+					bi.GetSymbol(), bi.StockInPool, bi.MoneyInPool, bi.Price, bi.CurveName())
+			}
+		})
+		return sdk.FormatInvariant(ModuleName, "bancor consistency", msg), broken
+	}
+}
+
+// BancorReservesInvariant checks that the stock and money in the bancor pools of each owner are frozen
+// in the owner's account. The frozen coins may be more than the reserves, as the coins of the owner's
+// market orders are also frozen there.
+func BancorReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var owners []sdk.AccAddress
+		reserves := make(map[string]sdk.Coins)
+		k.Iterate(ctx, func(bi *BancorInfo) {
+			key := bi.Owner.String()
+			if _, ok := reserves[key]; !ok {
+				owners = append(owners, bi.Owner)
+			}
+			reserves[key] = reserves[key].Add(sdk.NewCoins(sdk.NewCoin(bi.Stock, bi.StockInPool), sdk.NewCoin(bi.Money, bi.MoneyInPool)))
+		})
+
+		var msg string
+		broken := false
+		for _, owner := range owners {
+			frozen := k.GetFrozenCoins(ctx, owner)
+			if reserve := reserves[owner.String()]; !frozen.IsAllGTE(reserve) {
+				broken = true
+				msg += fmt.Sprintf("%s: frozen coins %s, reserves of bancor pools %s\n", owner, frozen, reserve)
+			}
+		}
+		return sdk.FormatInvariant(ModuleName, "bancor reserves", msg), broken
+	}
+}
+
+// LiquidityPoolInvariant checks that the reserves of every liquidity pool are frozen in its account,
+// and its total shares are the total supply of its share token
+func LiquidityPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.IterateLiquidityPools(ctx, func(lp *LiquidityPool) {
+			frozen := k.GetFrozenCoins(ctx, lp.Address())
+			if !frozen.AmountOf(lp.Stock).Equal(lp.StockInPool) || !frozen.AmountOf(lp.Money).Equal(lp.MoneyInPool) {
+				broken = true
+				msg += fmt.Sprintf("%s: frozen coins %s, stock in pool %s, money in pool %s\n",
+					lp.GetSymbol(), frozen, lp.StockInPool, lp.MoneyInPool)
+			}
+			if supply := k.GetTokenTotalSupply(ctx, lp.ShareSymbol); !supply.Equal(lp.TotalShares) {
+				broken = true
+				msg += fmt.Sprintf("%s: total supply of %s is %s, total shares %s\n",
+					lp.GetSymbol(), lp.ShareSymbol, supply, lp.TotalShares)
+			}
+		})
+		return sdk.FormatInvariant(ModuleName, "liquidity pool reserves", msg), broken
+	}
+}
@@ -71,6 +71,7 @@ func NewAppModule(blKeeper keepers.Keeper) AppModule {
 
 // registers
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.blKeeper)
 }
 
 // routes
@@ -87,13 +88,15 @@ func (am AppModule) QuerierRoute() string {
 }
 
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return keepers.NewQuerier(am.blKeeper)
+	return NewQuerier(am.blKeeper)
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 }
 
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// the stock traded by each account is only limited in a block
+	am.blKeeper.ClearBlockVolumes(ctx)
 	return nil
 }
 
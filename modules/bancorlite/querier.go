@@ -0,0 +1,74 @@
+package bancorlite
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+)
+
+// NewQuerier serves the queries which need the trading logic of this package,
+// and leaves the others to keepers.NewQuerier
+func NewQuerier(k Keeper) sdk.Querier {
+	querier := keepers.NewQuerier(k)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case keepers.QueryBancorQuote:
+			return queryBancorQuote(ctx, req, k)
+		default:
+			return querier(ctx, path, req)
+		}
+	}
+}
+
+func queryBancorQuote(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var param keepers.QueryBancorQuoteParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, sdk.NewError(types.CodeSpaceBancorlite, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	bi := k.Load(ctx, param.Symbol)
+	if bi == nil {
+		return nil, types.ErrNoBancorExists()
+	}
+
+	amount := param.Amount
+	if param.MoneyAmount > 0 {
+		amount = bi.MaxStockForMoneyAt(sdk.NewInt(param.MoneyAmount), param.IsBuy, ctx.BlockHeader().Time.Unix()).Int64()
+	}
+	if amount <= 0 {
+		return nil, types.ErrNonPositiveAmount()
+	}
+	msg := types.MsgBancorTrade{
+		Sender: param.Sender,
+		Stock:  bi.Stock,
+		Money:  bi.Money,
+		Amount: amount,
+		IsBuy:  param.IsBuy,
+	}
+	tr, err := calculateTrade(ctx, k, bi, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := keepers.ResBancorQuote{
+		Symbol:         param.Symbol,
+		IsBuy:          param.IsBuy,
+		StockAmount:    amount,
+		MoneyAmount:    tr.money(),
+		AuctionPremium: tr.premium,
+		Commission:     tr.commission,
+		RebateAmount:   tr.rebate,
+		PoolAfterTrade: keepers.NewBancorInfoDisplayAt(&tr.biNew, ctx.BlockHeader().Time.Unix()),
+	}
+	if tr.rebateExist {
+		res.RebateRefereeAddr = tr.rebateAcc.String()
+	}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if e != nil {
+		return nil, types.ErrMarshalFailed()
+	}
+	return bz, nil
+}
@@ -0,0 +1,126 @@
+package bancorlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bancorlite"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestQueryBancorQuote(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	require.True(t, prepareBancorInit(input))
+	querier := bancorlite.NewQuerier(input.bik)
+	symbol := dex.GetSymbol(stock, money)
+
+	query := func(param keepers.QueryBancorQuoteParam) (keepers.ResBancorQuote, sdk.Error) {
+		var res keepers.ResBancorQuote
+		bz, err := querier(input.ctx, []string{keepers.QueryBancorQuote},
+			abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+		if err == nil {
+			input.cdc.MustUnmarshalJSON(bz, &res)
+		}
+		return res, err
+	}
+
+	quote, err := query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, Amount: 200000})
+	require.Nil(t, err)
+	require.Equal(t, int64(200000), quote.StockAmount)
+	require.Equal(t, "800000", quote.PoolAfterTrade.StockInPool)
+
+	// the quote must match the real trade
+	oldMoney := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(money)
+	oldCet := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(dex.CET)
+	res := input.handler(input.ctx, types.MsgBancorTrade{
+		Sender: tradeAddr,
+		Stock:  stock,
+		Money:  money,
+		Amount: 200000,
+		IsBuy:  true,
+	})
+	require.True(t, res.IsOK(), res.Log)
+	newMoney := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(money)
+	newCet := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(dex.CET)
+	require.Equal(t, quote.MoneyAmount, oldMoney.Sub(newMoney))
+	require.Equal(t, quote.Commission, oldCet.Sub(newCet))
+	require.Equal(t, quote.PoolAfterTrade.MoneyInPool, input.bik.Load(input.ctx, symbol).MoneyInPool.String())
+
+	// inverse mode
+	budget := int64(100000)
+	quote, err = query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, MoneyAmount: budget})
+	require.Nil(t, err)
+	require.True(t, quote.MoneyAmount.LTE(sdk.NewInt(budget)))
+	next, err := query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, Amount: quote.StockAmount + 1})
+	require.Nil(t, err)
+	require.True(t, next.MoneyAmount.GT(sdk.NewInt(budget)))
+
+	quote, err = query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: false, MoneyAmount: budget})
+	require.Nil(t, err)
+	require.True(t, quote.MoneyAmount.LTE(sdk.NewInt(budget)))
+
+	_, err = query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, Amount: 2000000})
+	require.Equal(t, types.CodeStockInPoolOutOfBound, err.Code())
+	_, err = query(keepers.QueryBancorQuoteParam{Symbol: "abc/" + money, IsBuy: true, Amount: 1})
+	require.Equal(t, types.CodeNoBancorExists, err.Code())
+}
+
+func TestQueryBancorQuoteAuction(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	input.ctx = input.ctx.WithBlockTime(time.Unix(1000, 0))
+	require.True(t, input.handler(input.ctx, types.MsgBancorInit{
+		Owner:             haveCetAddress,
+		Stock:             stock,
+		Money:             money,
+		InitPrice:         "1",
+		MaxSupply:         sdk.NewInt(1000000),
+		MaxMoney:          sdk.ZeroInt(),
+		MaxPrice:          "11",
+		AuctionStartPrice: "3",
+		AuctionDuration:   1000,
+	}).IsOK())
+	querier := bancorlite.NewQuerier(input.bik)
+	symbol := dex.GetSymbol(stock, money)
+	query := func(param keepers.QueryBancorQuoteParam) keepers.ResBancorQuote {
+		var res keepers.ResBancorQuote
+		bz, err := querier(input.ctx, []string{keepers.QueryBancorQuote},
+			abci.RequestQuery{Data: input.cdc.MustMarshalJSON(param)})
+		require.Nil(t, err)
+		input.cdc.MustUnmarshalJSON(bz, &res)
+		return res
+	}
+
+	// the premium decays with the block time
+	input.ctx = input.ctx.WithBlockTime(time.Unix(1250, 0))
+	quote := query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, Amount: 10000})
+	require.Equal(t, sdk.NewInt(15000), quote.AuctionPremium)
+	require.Equal(t, sdk.NewInt(10500+15000), quote.MoneyAmount)
+	require.Equal(t, "2.600000000000000000", quote.PoolAfterTrade.TimeAdjustedPrice)
+	input.ctx = input.ctx.WithBlockTime(time.Unix(1750, 0))
+	quote = query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, Amount: 10000})
+	require.Equal(t, sdk.NewInt(10500+5000), quote.MoneyAmount)
+
+	// the inverse mode counts the premium in
+	quote = query(keepers.QueryBancorQuoteParam{Symbol: symbol, IsBuy: true, MoneyAmount: 15500})
+	require.Equal(t, int64(10000), quote.StockAmount)
+
+	// the quote matches the real trade
+	oldMoney := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(money)
+	res := input.handler(input.ctx, types.MsgBancorTrade{
+		Sender:      tradeAddr,
+		Stock:       stock,
+		Money:       money,
+		IsBuy:       true,
+		MoneyAmount: 15500,
+	})
+	require.True(t, res.IsOK(), res.Log)
+	newMoney := input.akp.GetAccount(input.ctx, tradeAddr).GetCoins().AmountOf(money)
+	require.Equal(t, quote.MoneyAmount, oldMoney.Sub(newMoney))
+}
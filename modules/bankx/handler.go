@@ -39,6 +39,9 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg types.MsgMultiSend) sdk.R
 		if k.BlacklistedAddr(out.Address) {
 			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", out.Address)).Result()
 		}
+		if k.IsReceiveForbidden(ctx, out.Coins, out.Address) {
+			return types.ErrRecipientNotWhitelisted(out.Address).Result()
+		}
 	}
 
 	for _, input := range msg.Inputs {
@@ -52,7 +55,23 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg types.MsgMultiSend) sdk.R
 
 	addrs := k.PreCheckFreshAccounts(ctx, msg.Outputs)
 
-	if err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs); err != nil {
+	// the transfer fees are deducted from the outputs, and paid to their receivers as extra outputs
+	outputs := make([]bank.Output, 0, len(msg.Outputs))
+	var feeOutputs []bank.Output
+	for _, out := range msg.Outputs {
+		outs, fees := k.GetTransferFeeOutputs(ctx, out.Address, out.Coins)
+		outputs = append(outputs, bank.NewOutput(out.Address, out.Coins.Sub(fees)))
+		feeOutputs = append(feeOutputs, outs...)
+		if !fees.Empty() {
+			fillMsgQueue(ctx, k, "transfer_fee", types.NewTransferFeeMsg(nil, out.Address, out.Coins.Sub(fees), fees))
+		}
+	}
+
+	if err := k.InputOutputCoins(ctx, msg.Inputs, append(outputs, feeOutputs...)); err != nil {
+		return err.Result()
+	}
+
+	if err := k.BurnTransferFees(ctx, feeOutputs); err != nil {
 		return err.Result()
 	}
 
@@ -89,6 +108,9 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
 		}
 		return types.ErrTokenForbiddenByOwner().Result()
 	}
+	if k.IsReceiveForbidden(ctx, msg.Amount, msg.ToAddress) {
+		return types.ErrRecipientNotWhitelisted(msg.ToAddress).Result()
+	}
 
 	//TODO: add codes to check whether fromAccount & toAccount is moduleAccount
 
@@ -116,24 +138,42 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
 	return normalSend(ctx, k, msg.FromAddress, msg.ToAddress, amt)
 }
 
+// transferAttributes appends the transfer fees to the attributes of the transfer event, if any
+func transferAttributes(fees sdk.Coins, attrs ...sdk.Attribute) []sdk.Attribute {
+	if !fees.Empty() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyFee, fees.String()))
+	}
+	return attrs
+}
+
 func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, unlockTime int64) sdk.Result {
 	if unlockTime < ctx.BlockHeader().Time.Unix() {
 		return types.ErrUnlockTime("Invalid Unlock Time:" +
 			fmt.Sprintf("%d < %d", unlockTime, ctx.BlockHeader().Time.Unix())).Result()
 	}
 
+	fees, err := k.ChargeTransferFees(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err.Result()
+	}
+	amt = amt.Sub(fees)
+
 	if err := k.SendLockedCoins(ctx, fromAddr, toAddr, nil, amt, unlockTime, 0, false); err != nil {
 		return err.Result()
 	}
 
-	fillMsgQueue(ctx, k, "send_lock_coins", types.NewLockedSendMsg(fromAddr, toAddr, amt, unlockTime))
+	lockedMsg := types.NewLockedSendMsg(fromAddr, toAddr, amt, unlockTime)
+	lockedMsg.Fee = fees
+	fillMsgQueue(ctx, k, "send_lock_coins", lockedMsg)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+			transferAttributes(fees,
+				sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+			)...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -146,16 +186,27 @@ func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt
 }
 
 func normalSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Result {
-	err := k.SendCoins(ctx, fromAddr, toAddr, amt)
+	fees, err := k.ChargeTransferFees(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err.Result()
+	}
+	amt = amt.Sub(fees)
+
+	err = k.SendCoins(ctx, fromAddr, toAddr, amt)
 	if err != nil {
 		return err.Result()
 	}
 
 	//fillMsgQueue(ctx, k, "send_coins", types.NewMsgSend(fromAddr, toAddr, amt, 0))
+	if !fees.Empty() {
+		fillMsgQueue(ctx, k, "transfer_fee", types.NewTransferFeeMsg(fromAddr, toAddr, amt, fees))
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+			transferAttributes(fees,
+				sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+			)...,
 		),
 	})
 	return sdk.Result{
@@ -220,17 +271,35 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 		return types.ErrInvalidTokenSymbol(denom).Result()
 	}
 
+	fees, amount := sdk.Coins{}, msg.Amount
 	if msg.Operation == types.Create {
 		amt := sdk.NewCoins(msg.Amount)
-		if !k.HasCoins(ctx, msg.FromAddress, amt) {
-			return sdk.ErrInsufficientCoins("sender has insufficient coin for the transfer").Result()
+		if k.IsReceiveForbidden(ctx, amt, msg.ToAddress) {
+			return types.ErrRecipientNotWhitelisted(msg.ToAddress).Result()
+		}
+		// the supervisor is paid the reward in the same token
+		if msg.Reward > 0 && !msg.Supervisor.Empty() && k.IsReceiveForbidden(ctx, amt, msg.Supervisor) {
+			return types.ErrRecipientNotWhitelisted(msg.Supervisor).Result()
+		}
+		// the transfer fees are deducted from the amount as the other sends, and the amount locked must be
+		// matched when it is unlocked
+		_, fees = k.GetTransferFeeOutputs(ctx, msg.ToAddress, amt)
+		amount = amount.Sub(sdk.NewCoin(amount.Denom, fees.AmountOf(amount.Denom)))
+		if sdk.NewInt(msg.Reward).GT(amount.Amount) {
+			return types.ErrRewardExceedsAmount().Result()
+		}
+		if _, err := k.ChargeTransferFees(ctx, msg.FromAddress, msg.ToAddress, amt); err != nil {
+			return err.Result()
 		}
-		if err := k.SendLockedCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Supervisor, amt, msg.UnlockTime, msg.Reward, true); err != nil {
+		if err := k.SendLockedCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Supervisor, sdk.NewCoins(amount),
+			msg.UnlockTime, msg.Reward, true); err != nil {
 			return err.Result()
 		}
 
-		fillMsgQueue(ctx, k, "send_lock_coins", types.NewSupervisedSendMsg(msg.FromAddress, msg.ToAddress,
-			msg.Supervisor, msg.Amount, msg.UnlockTime, msg.Reward))
+		lockedMsg := types.NewSupervisedSendMsg(msg.FromAddress, msg.ToAddress,
+			msg.Supervisor, amount, msg.UnlockTime, msg.Reward)
+		lockedMsg.Fee = fees
+		fillMsgQueue(ctx, k, "send_lock_coins", lockedMsg)
 
 	} else {
 		isReturned := types.Return == msg.Operation
@@ -254,8 +323,10 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 		),
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			transferAttributes(fees,
+				sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			)...,
 		),
 	})
 	return sdk.Result{
@@ -422,3 +422,120 @@ func TestHandleMsgSupervisedSendException(t *testing.T) {
 		require.Equal(t, tc.code, ret.Code)
 	}
 }
+
+func TestHandlerTransferFee(t *testing.T) {
+	app := testapp.NewTestApp()
+	ctx := sdk.NewContext(app.Cms, abci.Header{Time: time.Now()}, false, log.NewNopLogger())
+	app.BankxKeeper.SetParams(ctx, bx.DefaultParams())
+	app.BankxKeeper.SetSendEnabled(ctx, true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("abc", 1e10))))
+	bkx, handle := &app.BankxKeeper, bankx.NewHandler(app.BankxKeeper)
+
+	abc, _ := asset.NewToken("abc token", "abc", sdk.NewInt(1e10), owner,
+		false, true, false, false,
+		"", "", asset.TestIdentityString)
+	policy := asset.NewTransferFeePolicy(100, sdk.NewInt(5e6), owner)
+	require.Nil(t, abc.SetTransferFee(&policy))
+	require.Nil(t, app.AssetKeeper.SetToken(ctx, abc))
+
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("abc", amt)) }
+	amountOf := func(addr sdk.AccAddress) int64 { return bkx.GetCoins(ctx, addr).AmountOf("abc").Int64() }
+	require.NoError(t, bkx.AddCoins(ctx, fromAddr, coins(1e9)))
+	require.NoError(t, bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(100*1e8)))
+	require.NoError(t, bkx.AddCoins(ctx, toAddr, dex.NewCetCoins(1e8)))
+	require.NoError(t, bkx.AddCoins(ctx, myaddr, dex.NewCetCoins(1e8)))
+	require.NoError(t, bkx.AddCoins(ctx, owner, dex.NewCetCoins(1e8)))
+
+	// 1% of the amount is paid to the receiver
+	res := handle(ctx, bankx.NewMsgSend(fromAddr, toAddr, coins(1e8), 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(9e8), amountOf(fromAddr))
+	require.Equal(t, int64(99e6), amountOf(toAddr))
+	require.Equal(t, int64(1e6), amountOf(owner))
+
+	// the fee is capped
+	res = handle(ctx, bankx.NewMsgSend(fromAddr, toAddr, coins(8e8), 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(1e8), amountOf(fromAddr))
+	require.Equal(t, int64(894e6), amountOf(toAddr))
+	require.Equal(t, int64(6e6), amountOf(owner))
+
+	// the fees are burnt if there is no receiver
+	policy = asset.NewTransferFeePolicy(100, sdk.ZeroInt(), nil)
+	require.Nil(t, abc.SetTransferFee(&policy))
+	require.Nil(t, app.AssetKeeper.SetToken(ctx, abc))
+	in := []bank.Input{bank.NewInput(toAddr, coins(4e8))}
+	out := []bank.Output{bank.NewOutput(fromAddr, coins(2e8)), bank.NewOutput(myaddr, coins(2e8))}
+	res = handle(ctx, bankx.NewMsgMultiSend(in, out))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(494e6), amountOf(toAddr))
+	require.Equal(t, int64(298e6), amountOf(fromAddr))
+	require.Equal(t, int64(198e6), amountOf(myaddr))
+	require.Equal(t, int64(1e10-4e6), app.AssetKeeper.GetToken(ctx, "abc").GetTotalSupply().Int64())
+	require.Equal(t, int64(4e6), app.AssetKeeper.GetToken(ctx, "abc").GetTotalBurn().Int64())
+	require.Equal(t, int64(1e10-4e6), app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf("abc").Int64())
+
+	// the fee of a supervised send is deducted from the amount as the other sends
+	lockFreeTime := ctx.BlockHeader().Time.Unix() + bkx.GetParams(ctx).LockCoinsFreeTime/int64(time.Second)
+	msg := bankx.MsgSupervisedSend{FromAddress: fromAddr, Supervisor: nil, ToAddress: toAddr,
+		Amount: sdk.NewInt64Coin("abc", 1e8), UnlockTime: lockFreeTime, Operation: bankx.Create}
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(198e6), amountOf(fromAddr))
+	require.Equal(t, int64(99e6), bkx.GetLockedCoins(ctx, toAddr)[0].Coin.Amount.Int64())
+	require.Equal(t, int64(5e6), app.AssetKeeper.GetToken(ctx, "abc").GetTotalBurn().Int64())
+
+	// the reward must not exceed the amount less the fee
+	msg.Supervisor, msg.Reward = myaddr, 1e8-5e5
+	res = handle(ctx, msg)
+	require.Equal(t, bx.CodeRewardExceedsAmount, res.Code)
+}
+
+func TestHandlerPermissionedToken(t *testing.T) {
+	app := testapp.NewTestApp()
+	ctx := sdk.NewContext(app.Cms, abci.Header{Time: time.Now()}, false, log.NewNopLogger())
+	app.BankxKeeper.SetParams(ctx, bx.DefaultParams())
+	app.BankxKeeper.SetSendEnabled(ctx, true)
+	bkx, handle := &app.BankxKeeper, bankx.NewHandler(app.BankxKeeper)
+
+	abc, _ := asset.NewToken("abc token", "abc", sdk.NewInt(1e10), owner,
+		false, true, false, false,
+		"", "", asset.TestIdentityString)
+	abc.SetPermissioned(true)
+	require.Nil(t, app.AssetKeeper.SetToken(ctx, abc))
+	require.Nil(t, app.AssetKeeper.AddTokenWhitelist(ctx, "abc", owner, []sdk.AccAddress{toAddr}))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("abc", 1e8))
+	require.NoError(t, bkx.AddCoins(ctx, fromAddr, coins.Add(coins)))
+	require.NoError(t, bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(100*1e8)))
+	require.NoError(t, bkx.AddCoins(ctx, toAddr, dex.NewCetCoins(1e8)))
+	require.NoError(t, bkx.AddCoins(ctx, myaddr, dex.NewCetCoins(1e8)))
+	require.NoError(t, bkx.AddCoins(ctx, owner, dex.NewCetCoins(1e8)))
+
+	res := handle(ctx, bankx.NewMsgSend(fromAddr, myaddr, coins, 0))
+	require.Equal(t, bx.CodeRecipientNotWhitelisted, res.Code)
+	res = handle(ctx, bankx.NewMsgSend(fromAddr, toAddr, coins, 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(1e8), bkx.GetCoins(ctx, toAddr).AmountOf("abc").Int64())
+
+	in := []bank.Input{bank.NewInput(fromAddr, coins)}
+	out := []bank.Output{bank.NewOutput(myaddr, coins)}
+	res = handle(ctx, bankx.NewMsgMultiSend(in, out))
+	require.Equal(t, bx.CodeRecipientNotWhitelisted, res.Code)
+
+	// the holders could always send the token back to the owner
+	lockFreeTime := ctx.BlockHeader().Time.Unix() + bkx.GetParams(ctx).LockCoinsFreeTime/int64(time.Second)
+	res = handle(ctx, bankx.MsgSupervisedSend{FromAddress: fromAddr, Supervisor: myaddr, ToAddress: toAddr,
+		Amount: sdk.NewInt64Coin("abc", 1e8), UnlockTime: lockFreeTime, Reward: 1e6, Operation: bankx.Create})
+	require.Equal(t, bx.CodeRecipientNotWhitelisted, res.Code)
+	res = handle(ctx, bankx.NewMsgSend(toAddr, owner, coins, 0))
+	require.True(t, res.IsOK())
+
+	// the keeper checks the recipients of the other modules, such as the bancor pools, but not the module accounts
+	err := bkx.SendCoins(ctx, fromAddr, myaddr, coins)
+	require.Equal(t, bx.CodeRecipientNotWhitelisted, err.Code())
+	err = bkx.SendLockedCoins(ctx, fromAddr, myaddr, nil, coins, lockFreeTime, 0, false)
+	require.Equal(t, bx.CodeRecipientNotWhitelisted, err.Code())
+	app.AccountKeeper.SetAccount(ctx, supply.NewEmptyModuleAccount(asset.ModuleName, supply.Burner))
+	require.NoError(t, bkx.SendCoins(ctx, fromAddr, supply.NewModuleAddress(asset.ModuleName), coins))
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
@@ -63,6 +65,11 @@ func (k Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddres
 	if k.IsSendForbidden(ctx, amt, from) {
 		return types.ErrTokenForbiddenByOwner()
 	}
+	if err := k.checkRecipient(ctx, to, amt); err != nil {
+		return err
+	}
+	k.settleDividends(ctx, from, amt)
+	k.settleDividends(ctx, to, amt)
 	ret := k.bk.SendCoins(ctx, from, to, amt)
 	return ret
 }
@@ -72,6 +79,9 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 	if k.IsSendForbidden(ctx, amt, fromAddr) {
 		return types.ErrTokenForbiddenByOwner()
 	}
+	if err := k.checkRecipient(ctx, toAddr, amt); err != nil {
+		return err
+	}
 	if k.ak.GetAccount(ctx, toAddr) == nil {
 		if err := k.AddCoins(ctx, toAddr, sdk.Coins{}); err != nil {
 			return err
@@ -94,6 +104,7 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 		return err
 	}
 
+	k.settleDividends(ctx, toAddr, amt)
 	ax := k.axk.GetOrCreateAccountX(ctx, toAddr)
 	for _, coin := range amt {
 		if isSupervised {
@@ -143,6 +154,7 @@ func (k Keeper) EarlierUnlockCoin(ctx sdk.Context, fromAddr, toAddr, supervisor
 	if err := k.tk.UpdateTokenSendLock(ctx, amt.Denom, amt.Amount, false); err != nil {
 		return nil, err
 	}
+	k.settleDividends(ctx, toAddr, sdk.NewCoins(*amt))
 
 	receiver := toAddr
 	if isReturned {
@@ -187,6 +199,44 @@ func (k Keeper) EarlierUnlockCoin(ctx sdk.Context, fromAddr, toAddr, supervisor
 	return unlockInfo, nil
 }
 
+// UnlockCoins moves at most amount of the locked coins of denom held by addr to its spendable coins,
+// beginning with the ones unlocked at last, and returns the amount unlocked. It is used to claw back
+// the coins, so the reward of a supervisor is cut down to the amount left locked.
+func (k Keeper) UnlockCoins(ctx sdk.Context, addr sdk.AccAddress, denom string, amount sdk.Int) (sdk.Int, sdk.Error) {
+	unlocked := sdk.ZeroInt()
+	ax, ok := k.axk.GetAccountX(ctx, addr)
+	if !ok {
+		return unlocked, nil
+	}
+	for i := len(ax.LockedCoins) - 1; i >= 0 && unlocked.LT(amount); i-- {
+		lockedCoin := ax.LockedCoins[i]
+		if lockedCoin.Coin.Denom != denom {
+			continue
+		}
+		n := sdk.MinInt(lockedCoin.Coin.Amount, amount.Sub(unlocked))
+		if n.Equal(lockedCoin.Coin.Amount) {
+			ax.LockedCoins = append(ax.LockedCoins[:i], ax.LockedCoins[i+1:]...)
+		} else {
+			left := lockedCoin.Coin.Amount.Sub(n)
+			ax.LockedCoins[i].Coin.Amount = left
+			if left.LT(sdk.NewInt(lockedCoin.Reward)) {
+				ax.LockedCoins[i].Reward = left.Int64()
+			}
+		}
+		unlocked = unlocked.Add(n)
+	}
+	if !unlocked.IsPositive() {
+		return unlocked, nil
+	}
+
+	if err := k.tk.UpdateTokenSendLock(ctx, denom, unlocked, false); err != nil {
+		return unlocked, err
+	}
+	k.axk.SetAccountX(ctx, ax)
+	_, err := k.bk.AddCoins(ctx, addr, sdk.NewCoins(sdk.NewCoin(denom, unlocked)))
+	return unlocked, err
+}
+
 func (k Keeper) FreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	if k.IsSendForbidden(ctx, amt, addr) {
 		return types.ErrTokenForbiddenByOwner()
@@ -221,11 +271,13 @@ func (k Keeper) UnFreezeCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin
 }
 
 func (k Keeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	k.settleDividends(ctx, addr, amt)
 	_, err := k.bk.SubtractCoins(ctx, addr, amt)
 	return err
 }
 
 func (k Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	k.settleDividends(ctx, addr, amt)
 	_, err := k.bk.AddCoins(ctx, addr, amt)
 	return err
 }
@@ -280,13 +332,34 @@ func (k Keeper) DeductActivationFeeForFreshAccounts(ctx sdk.Context, addrs []sdk
 }
 
 func (k Keeper) DeductFee(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	k.settleDividends(ctx, addr, amt)
 	return k.sk.SendCoinsFromAccountToModule(ctx, addr, auth.FeeCollectorName, amt)
 }
 
 func (k Keeper) DonateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	k.settleDividends(ctx, addr, amt)
 	return k.sk.SendCoinsFromAccountToModule(ctx, addr, distribution.ModuleName, amt)
 }
 
+// settleDividends is the hook called before amt is moved in or out of the account, which lets the asset module
+// accrue the dividends of the balances held before the change.
+// Module accounts are not paid dividends, as the asset module excludes them from the eligible supply.
+func (k Keeper) settleDividends(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	if k.isModuleAccount(ctx, addr) {
+		return
+	}
+	var total sdk.Coins
+	for _, coin := range amt {
+		if !k.tk.HasDividends(ctx, coin.Denom) {
+			continue
+		}
+		if total == nil {
+			total = k.GetTotalCoins(ctx, addr)
+		}
+		k.tk.SettleDividends(ctx, coin.Denom, addr, total.AmountOf(coin.Denom))
+	}
+}
+
 func (k Keeper) IsSendForbidden(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddress) bool {
 	for _, coin := range amt {
 		if k.tk.IsForbiddenByTokenIssuer(ctx, coin.Denom, addr) {
@@ -296,6 +369,51 @@ func (k Keeper) IsSendForbidden(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddr
 	return false
 }
 
+// IsReceiveForbidden returns whether addr is not allowed to receive any of the permissioned tokens in amt
+func (k Keeper) IsReceiveForbidden(ctx sdk.Context, amt sdk.Coins, addr sdk.AccAddress) bool {
+	for _, coin := range amt {
+		if !k.tk.IsPermittedRecipient(ctx, coin.Denom, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRecipient returns an error if addr is not allowed to receive any of the permissioned tokens in amt.
+// Module accounts, which hold coins for their modules, are not checked.
+func (k Keeper) checkRecipient(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if k.isModuleAccount(ctx, addr) {
+		return nil
+	}
+	if k.IsReceiveForbidden(ctx, amt, addr) {
+		return types.ErrRecipientNotWhitelisted(addr)
+	}
+	return nil
+}
+
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(supplyexported.ModuleAccountI)
+	return ok
+}
+
+// SetModuleAccount makes the account of name a module account, which keeps the coins already sent to it,
+// and returns its address. It lets other modules hold coins for their pools, such as the reserves of
+// liquidity pools, which are then not paid dividends or airdrops.
+func (k Keeper) SetModuleAccount(ctx sdk.Context, name string) sdk.AccAddress {
+	addr := supply.NewModuleAddress(name)
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		k.ak.SetAccount(ctx, k.ak.NewAccount(ctx, supply.NewEmptyModuleAccount(name)))
+		return addr
+	}
+	if _, ok := acc.(supplyexported.ModuleAccountI); ok {
+		return addr
+	}
+	base := auth.NewBaseAccount(addr, acc.GetCoins(), acc.GetPubKey(), acc.GetAccountNumber(), acc.GetSequence())
+	k.ak.SetAccount(ctx, supply.NewModuleAccount(base, name))
+	return addr
+}
+
 func (k Keeper) IsTokensExist(ctx sdk.Context, amt sdk.Coins) (string, bool) {
 	for _, coin := range amt {
 		if !k.tk.IsTokenExists(ctx, coin.Denom) {
@@ -337,6 +455,24 @@ func (k Keeper) GetTotalCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 
 }
 
+// IterateTokenHolders calls process with the accounts holding denom in the order of their addresses,
+// in which the amount includes the frozen and locked coins, and the module accounts are skipped
+func (k Keeper) IterateTokenHolders(ctx sdk.Context, denom string, process func(addr sdk.AccAddress, amount sdk.Int) (stop bool)) {
+	k.ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+		if _, ok := acc.(supplyexported.ModuleAccountI); ok {
+			return false
+		}
+		amount := acc.GetCoins().AmountOf(denom)
+		if accx, found := k.axk.GetAccountX(ctx, acc.GetAddress()); found {
+			amount = amount.Add(accx.GetAllCoins().AmountOf(denom))
+		}
+		if !amount.IsPositive() {
+			return false
+		}
+		return process(acc.GetAddress(), amount)
+	})
+}
+
 func (k Keeper) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return k.bk.BlacklistedAddr(addr)
 }
@@ -350,6 +486,12 @@ func (k Keeper) GetSendEnabled(ctx sdk.Context) bool {
 }
 
 func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	for _, input := range inputs {
+		k.settleDividends(ctx, input.Address, input.Coins)
+	}
+	for _, output := range outputs {
+		k.settleDividends(ctx, output.Address, output.Coins)
+	}
 	return k.bk.InputOutputCoins(ctx, inputs, outputs)
 }
 
@@ -174,6 +174,49 @@ func TestKeeper_TotalAmountOfCoin(t *testing.T) {
 	require.Equal(t, int64(100000000300), amount.Int64())
 }
 
+func TestKeeper_IterateTokenHolders(t *testing.T) {
+	bkx, ctx := defaultContext()
+	otherAddr := testutil.ToAccAddress("otheraddr")
+	require.NoError(t, givenAccountWith(ctx, bkx, myaddr, "100abc"))
+	require.NoError(t, givenAccountWith(ctx, bkx, otherAddr, "10abc,10cet"))
+	require.NoError(t, givenAccountWith(ctx, bkx, ownerAddr, "10cet"))
+	bkx.MockAddLockedCoins(ctx, myaddr, authx.LockedCoins{authx.NewLockedCoin("abc", sdk.NewInt(20), 1000)})
+	bkx.MockAddFrozenCoins(ctx, myaddr, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(30))))
+
+	holders := make(map[string]int64)
+	bkx.IterateTokenHolders(ctx, "abc", func(addr sdk.AccAddress, amount sdk.Int) bool {
+		holders[addr.String()] = amount.Int64()
+		return false
+	})
+	require.Equal(t, map[string]int64{myaddr.String(): 150, otherAddr.String(): 10}, holders)
+}
+
+func TestKeeper_SetModuleAccount(t *testing.T) {
+	bkx, ctx := defaultContext()
+	addr := supply.NewModuleAddress("pool/abc/cet")
+	require.NoError(t, givenAccountWith(ctx, bkx, addr, "100abc"))
+	require.NoError(t, givenAccountWith(ctx, bkx, myaddr, "10abc"))
+
+	require.Equal(t, addr, bkx.SetModuleAccount(ctx, "pool/abc/cet"))
+	macc, ok := bkx.GetAccount(ctx, addr).(*supply.ModuleAccount)
+	require.True(t, ok)
+	require.Equal(t, "pool/abc/cet", macc.GetName())
+	require.Equal(t, "100abc", coinsOf(ctx, bkx, addr))
+	require.Equal(t, addr, bkx.SetModuleAccount(ctx, "pool/abc/cet"))
+	require.Equal(t, "100abc", coinsOf(ctx, bkx, addr))
+
+	holders := make(map[string]int64)
+	bkx.IterateTokenHolders(ctx, "abc", func(addr sdk.AccAddress, amount sdk.Int) bool {
+		holders[addr.String()] = amount.Int64()
+		return false
+	})
+	require.Equal(t, map[string]int64{myaddr.String(): 10}, holders)
+
+	newAddr := bkx.SetModuleAccount(ctx, "pool/abc/xyz")
+	require.Equal(t, supply.NewModuleAddress("pool/abc/xyz"), newAddr)
+	require.NotNil(t, bkx.GetAccount(ctx, newAddr))
+}
+
 func TestKeeper_AddCoins(t *testing.T) {
 	bkx, ctx := defaultContext()
 	coins := sdk.NewCoins(
@@ -229,3 +272,31 @@ func TestKeeper_SendCoins(t *testing.T) {
 	cs := bkx.GetTotalCoins(ctx, addr2)
 	require.Equal(t, coins, cs)
 }
+
+func TestKeeper_UnlockCoins(t *testing.T) {
+	bkx, ctx := defaultContext()
+	params := types.DefaultParams()
+	params.LockCoinsFeePerDay = 0
+	bkx.SetParams(ctx, params)
+	supervisor := testutil.ToAccAddress("supervisor")
+	require.NoError(t, givenAccountWith(ctx, bkx, ownerAddr, "100abc,100cet"))
+	require.NoError(t, bkx.SendLockedCoins(ctx, ownerAddr, myaddr, nil, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(20))), 1000, 0, false))
+	require.NoError(t, bkx.SendLockedCoins(ctx, ownerAddr, myaddr, supervisor, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(30))), 2000, 10, true))
+	require.NoError(t, bkx.SendLockedCoins(ctx, ownerAddr, myaddr, nil, sdk.NewCoins(sdk.NewCoin("cet", sdk.NewInt(40))), 3000, 0, false))
+
+	// the coins unlocked at last go first, and the reward is cut down to the amount left
+	unlocked, err := bkx.UnlockCoins(ctx, myaddr, "abc", sdk.NewInt(25))
+	require.NoError(t, err)
+	require.Equal(t, int64(25), unlocked.Int64())
+	require.Equal(t, "25abc", coinsOf(ctx, bkx, myaddr))
+	lockedCoins := bkx.GetLockedCoins(ctx, myaddr)
+	require.Len(t, lockedCoins, 3)
+	require.Equal(t, authx.NewSupervisedLockedCoin("abc", sdk.NewInt(5), 2000, ownerAddr, supervisor, 5), lockedCoins[1])
+
+	// no more than the locked coins of the denom
+	unlocked, err = bkx.UnlockCoins(ctx, myaddr, "abc", sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, int64(25), unlocked.Int64())
+	require.Equal(t, "50abc", coinsOf(ctx, bkx, myaddr))
+	require.Equal(t, authx.LockedCoins{authx.NewLockedCoin("cet", sdk.NewInt(40), 3000)}, bkx.GetLockedCoins(ctx, myaddr))
+}
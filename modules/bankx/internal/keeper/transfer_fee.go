@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+)
+
+// the transfer fees to be burnt are sent to the asset module account, which has the permission to burn
+var assetModuleAddr = supply.NewModuleAddress(types.AssetModuleName)
+
+// GetTransferFeeOutputs returns the outputs paying the transfer fees of amt sent to the recipient, in which
+// the fees to be burnt are sent to the asset module account, and the total fees. Module accounts receive amt
+// without any fee.
+func (k Keeper) GetTransferFeeOutputs(ctx sdk.Context, to sdk.AccAddress, amt sdk.Coins) ([]bank.Output, sdk.Coins) {
+	if acc := k.ak.GetAccount(ctx, to); acc != nil {
+		if _, ok := acc.(supplyexported.ModuleAccountI); ok {
+			return nil, sdk.Coins{}
+		}
+	}
+
+	var outputs []bank.Output
+	fees := sdk.Coins{}
+	for _, coin := range amt {
+		fee, receiver := k.tk.GetTransferFee(ctx, coin)
+		if !fee.IsPositive() {
+			continue
+		}
+		if receiver.Empty() {
+			// the module account is created if it does not exist, before the fees are sent to it
+			receiver = k.sk.GetModuleAccount(ctx, types.AssetModuleName).GetAddress()
+		}
+		outputs = append(outputs, bank.NewOutput(receiver, sdk.NewCoins(fee)))
+		fees = fees.Add(sdk.NewCoins(fee))
+	}
+	return outputs, fees
+}
+
+// ChargeTransferFees sends the transfer fees of amt from the sender to their receivers, burns the ones to be burnt,
+// and returns the fees
+func (k Keeper) ChargeTransferFees(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	outputs, fees := k.GetTransferFeeOutputs(ctx, to, amt)
+	for _, output := range outputs {
+		if err := k.SendCoins(ctx, from, output.Address, output.Coins); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.BurnTransferFees(ctx, outputs); err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
+
+// BurnTransferFees burns the fees sent to the asset module account in the outputs
+func (k Keeper) BurnTransferFees(ctx sdk.Context, outputs []bank.Output) sdk.Error {
+	for _, output := range outputs {
+		if !output.Address.Equals(assetModuleAddr) {
+			continue
+		}
+		if err := k.sk.BurnCoins(ctx, types.AssetModuleName, output.Coins); err != nil {
+			return err
+		}
+		for _, coin := range output.Coins {
+			if err := k.tk.UpdateTokenBurn(ctx, coin.Denom, coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ const (
 	CodeRewardExceedsAmount             sdk.CodeType = 312
 	CodeLockedCoinNotFound              sdk.CodeType = 313
 	CodeInvalidTokenSymbol              sdk.CodeType = 314
+	CodeRecipientNotWhitelisted         sdk.CodeType = 315
 )
 
 func ErrMemoMissing() sdk.Error {
@@ -79,3 +80,7 @@ func ErrLockedCoinNotFound() sdk.Error {
 func ErrInvalidTokenSymbol(symbol string) sdk.Error {
 	return sdk.NewError(CodeSpaceBankx, CodeInvalidTokenSymbol, "%s token not exist", symbol)
 }
+
+func ErrRecipientNotWhitelisted(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeRecipientNotWhitelisted, "%s is not whitelisted to receive the permissioned token", addr)
+}
@@ -6,6 +6,7 @@ const (
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
 	AttributeKeyAmount    = "amount"
+	AttributeKeyFee       = "fee"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
 )
@@ -17,12 +18,19 @@ type ExpectedAccountXKeeper interface {
 
 type ExpectedAssetStatusKeeper interface {
 	IsForbiddenByTokenIssuer(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
+	IsPermittedRecipient(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool
 	IsTokenExists(ctx sdk.Context, symbol string) bool
 	UpdateTokenSendLock(ctx sdk.Context, symbol string, amount sdk.Int, lock bool) sdk.Error
+	HasDividends(ctx sdk.Context, symbol string) bool
+	SettleDividends(ctx sdk.Context, symbol string, addr sdk.AccAddress, balance sdk.Int)
+	GetTransferFee(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, sdk.AccAddress)
+	UpdateTokenBurn(ctx sdk.Context, symbol string, amount sdk.Int) sdk.Error
 }
 
 // SupplyKeeper defines the expected supply keeper
 type SupplyKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
 }
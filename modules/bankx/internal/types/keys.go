@@ -10,4 +10,7 @@ const (
 	AttributeKeyMemoRequired = "memo-required"
 
 	Topic = ModuleName
+
+	// AssetModuleName is the module burning the transfer fees
+	AssetModuleName = "asset"
 )
@@ -11,6 +11,7 @@ type LockedSendMsg struct {
 	UnlockTime  int64          `json:"unlock_time"`
 	Supervisor  sdk.AccAddress `json:"supervisor,omitempty"`
 	Reward      int64          `json:"reward,omitempty"`
+	Fee         sdk.Coins      `json:"fee,omitempty"`
 }
 
 func NewLockedSendMsg(fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amount sdk.Coins, unlockTime int64) LockedSendMsg {
@@ -32,3 +33,21 @@ func NewSupervisedSendMsg(fromAddr, toAddr, supervisor sdk.AccAddress, amount sd
 		Reward:      reward,
 	}
 }
+
+// TransferFeeMsg reports the transfer fees charged from a send, and Amount is received by the recipient.
+// FromAddress is empty for the outputs of a multi-send.
+type TransferFeeMsg struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	Fee         sdk.Coins      `json:"fee"`
+}
+
+func NewTransferFeeMsg(fromAddr, toAddr sdk.AccAddress, amount, fee sdk.Coins) TransferFeeMsg {
+	return TransferFeeMsg{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		Fee:         fee,
+	}
+}
@@ -21,17 +21,20 @@ const (
 	ASK                     = types.ASK
 	BUY                     = types.BUY
 	SELL                    = types.SELL
+	IOC                     = types.IOC
+	CetPriceWindow          = types.CetPriceWindow
 )
 
 var (
-	NewBaseKeeper       = keepers.NewKeeper
-	DefaultParams       = types.DefaultParams
-	DecToBigEndianBytes = types.DecToBigEndianBytes
-	ValidateOrderID     = types.ValidateOrderID
-	IsValidTradingPair  = types.IsValidTradingPair
-	ModuleCdc           = types.ModuleCdc
-	GetSymbol           = dex.GetSymbol
-	SplitSymbol         = dex.SplitSymbol
+	NewBaseKeeper         = keepers.NewKeeper
+	DefaultParams         = types.DefaultParams
+	DecToBigEndianBytes   = types.DecToBigEndianBytes
+	ValidateOrderID       = types.ValidateOrderID
+	IsValidTradingPair    = types.IsValidTradingPair
+	GetGranularityOfOrder = types.GetGranularityOfOrder
+	ModuleCdc             = types.ModuleCdc
+	GetSymbol             = dex.GetSymbol
+	SplitSymbol           = dex.SplitSymbol
 )
 
 type (
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -29,7 +30,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		QueryMarketListCmd(cdc),
 		QueryOrderbookCmd(cdc),
 		QueryOrderCmd(cdc),
-		QueryUserOrderList(cdc))...)
+		QueryUserOrderList(cdc),
+		QueryOrderSimulationCmd(cdc))...)
 	return mktQueryCmd
 }
 
@@ -145,3 +147,35 @@ Example:
 
 	return cmd
 }
+
+func QueryOrderSimulationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-simulation [userAddress]",
+		Short: "Simulate creating an order without sending a tx",
+		Long: `Simulate creating an order without sending a tx, and show the frozen amount, 
+frozen commission, feature fee, referral rebate and the estimated fill against the order book.
+
+Example:
+	cetcli query market order-simulation [userAddress] --trading-pair=btc/cet \
+	--order-type=2 --price=520 --quantity=10000000 --side=1 --price-precision=10 \
+	--gte=true --blocks=100000 --identify=1 --trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg, err := parseCreateOrderFlags(viper.GetBool(FlagGTE))
+			if err != nil {
+				return err
+			}
+			msg.Sender = sender
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryOrderSimulation)
+			return cliutil.CliQuery(cdc, route, keepers.QueryOrderSimulationParam{Order: *msg})
+		},
+	}
+	markCreateOrderFlags(cmd)
+	cmd.Flags().Bool(FlagGTE, false, "simulate a GTE order instead of an IOC order")
+	cmd.Flags().Int(FlagBlocks, 10000, "the gte order will exist at least blocks in blockChain")
+	return cmd
+}
@@ -103,4 +103,25 @@ func TestQuery(t *testing.T) {
 	assert.Equal(t, "decoding bech32 failed: checksum failed. Expected 026624, got lwzdpy.", err.Error())
 	assert.Equal(t, "custom/market/user-order-list", ResultPath)
 
+	args = []string{
+		"order-simulation",
+		user,
+		"--trading-pair=btc/cet",
+		"--order-type=2",
+		"--price=520",
+		"--quantity=10000000",
+		"--side=1",
+		"--price-precision=10",
+		"--identify=1",
+	}
+	cmd.SetArgs(args)
+	cliutil.SetViperWithArgs(args)
+	err = cmd.Execute()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "custom/market/order-simulation", ResultPath)
+	param := ResultParam.(keepers.QueryOrderSimulationParam)
+	assert.Equal(t, user, param.Order.Sender.String())
+	assert.Equal(t, "btc/cet", param.Order.TradingPair)
+	assert.Equal(t, int64(520), param.Order.Price)
+
 }
@@ -23,6 +23,7 @@ const (
 	FlagBlocks    = "blocks"
 	FlagTime      = "time"
 	FlagIdentify  = "identify"
+	FlagGTE       = "gte"
 )
 
 var createOrderFlags = []string{
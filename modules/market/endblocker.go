@@ -225,12 +225,14 @@ func calRebateAmount(ctx sdk.Context, fee int64, keeper types.ExpectedAuthXKeepe
 	return rebateAmount
 }
 
-// Iterate the candidate orders for matching, and remove the orders whose sender is forbidden by the money owner or the stock owner.
+// Iterate the candidate orders for matching, and remove the orders whose sender is forbidden by the money owner or the stock owner,
+// or is no longer whitelisted to receive the token bought.
 func filterCandidates(ctx sdk.Context, asKeeper types.ExpectedAssetStatusKeeper, ordersIn []*types.Order, stock, money string) []*types.Order {
 	ordersOut := make([]*types.Order, 0, len(ordersIn))
 	for _, order := range ordersIn {
 		if !(asKeeper.IsForbiddenByTokenIssuer(ctx, stock, order.Sender) ||
-			asKeeper.IsForbiddenByTokenIssuer(ctx, money, order.Sender)) {
+			asKeeper.IsForbiddenByTokenIssuer(ctx, money, order.Sender) ||
+			!asKeeper.IsPermittedRecipient(ctx, receivedDenom(order.Side, stock, money), order.Sender)) {
 			ordersOut = append(ordersOut, order)
 		}
 	}
@@ -289,6 +291,70 @@ func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, kee
 	return ordersForUpdate, infoForDeal.lastPrice
 }
 
+// settleIOCOrder deals an IOC order with the resting orders it crosses at once, at their prices with
+// the best price first, and removes it. As in runMatch, no deal is made at a price out of the range
+// around the last executed price.
+func settleIOCOrder(ctx sdk.Context, keeper keepers.Keeper, order *types.Order) {
+	marketParams := keeper.GetParams(ctx)
+	orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
+	infoForDeal := &InfoForDeal{
+		bxKeeper:      keeper.GetBankxKeeper(),
+		dataHash:      ctx.BlockHeader().DataHash,
+		changedOrders: make(map[string]*types.Order),
+		context:       ctx,
+		lastPrice:     sdk.NewDec(0),
+		msgSender:     keeper.GetMsgProducer(),
+	}
+	stock, money := SplitSymbol(order.TradingPair)
+	mi, err := keeper.GetMarketInfo(ctx, order.TradingPair)
+	if err == nil && !keeper.IsTokenForbidden(ctx, stock) && !keeper.IsTokenForbidden(ctx, money) {
+		ratio := marketParams.MaxExecutedPriceChangeRatio
+		lowPrice := mi.LastExecutedPrice.Mul(sdk.NewDec(100 - ratio)).Quo(sdk.NewDec(100))
+		highPrice := mi.LastExecutedPrice.Mul(sdk.NewDec(100 + ratio)).Quo(sdk.NewDec(100))
+		taker := &WrappedOrder{order: order, infoForDeal: infoForDeal}
+		makers := orderKeeper.GetOrdersCrossingPrice(ctx, order.Side, order.Price)
+		for _, makerOrder := range filterCandidates(ctx, keeper.GetAssetKeeper(), makers, stock, money) {
+			if taker.GetAmount() == 0 {
+				break
+			}
+			if !mi.LastExecutedPrice.IsZero() && (makerOrder.Price.LT(lowPrice) || makerOrder.Price.GT(highPrice)) {
+				continue
+			}
+			maker := &WrappedOrder{order: makerOrder, infoForDeal: infoForDeal}
+			amount := taker.GetAmount()
+			if maker.GetAmount() < amount {
+				amount = maker.GetAmount()
+			}
+			if amount > 0 {
+				taker.Deal(maker, amount, makerOrder.Price)
+			}
+		}
+	}
+	infoForDeal.changedOrders[order.OrderID()] = order
+	updateOrders(ctx, keeper, orderKeeper, infoForDeal.changedOrders, &marketParams)
+	if !infoForDeal.lastPrice.IsZero() {
+		mi.LastExecutedPrice = infoForDeal.lastPrice
+		keeper.SetMarket(ctx, mi)
+		keeper.UpdateCetPrice(ctx, mi)
+	}
+}
+
+// updateOrders saves the orders changed by deals, and removes the IOC orders and the orders which can not be dealt any more
+func updateOrders(ctx sdk.Context, keeper keepers.Keeper, orderKeeper keepers.OrderKeeper,
+	orders map[string]*types.Order, marketParams *types.Params) {
+	bankxKeeper := keeper.GetBankxKeeper()
+	for _, order := range orders {
+		orderKeeper.Update(ctx, order)
+		if order.TimeInForce == types.IOC || order.LeftStock == 0 || notEnoughMoney(order) {
+			removeOrder(ctx, orderKeeper, bankxKeeper, keeper, order, marketParams)
+			if keeper.IsSubScribed(types.Topic) {
+				cancelOrderInfo := packageCancelOrderMsg(ctx, order, marketParams, keeper)
+				msgqueue.FillMsgs(ctx, types.CancelOrderInfoKey, cancelOrderInfo)
+			}
+		}
+	}
+}
+
 func removeExpiredOrder(ctx sdk.Context, keeper keepers.Keeper, marketInfoList []types.MarketInfo, marketParams *types.Params) {
 	currHeight := ctx.BlockHeight()
 	bankxKeeper := keeper.GetBankxKeeper()
@@ -394,23 +460,13 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 		if len(ordersForUpdateList[idx]) == 0 {
 			continue
 		}
-		bankxKeeper := keeper.GetBankxKeeper()
 		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), mi.GetSymbol(), types.ModuleCdc)
-		// update the order book
-		for _, order := range ordersForUpdateList[idx] {
-			orderKeeper.Update(ctx, order)
-			if order.TimeInForce == types.IOC || order.LeftStock == 0 || notEnoughMoney(order) {
-				removeOrder(ctx, orderKeeper, bankxKeeper, keeper, order, &marketParams)
-				if keeper.IsSubScribed(types.Topic) {
-					cancelOrderInfo := packageCancelOrderMsg(ctx, order, &marketParams, keeper)
-					msgqueue.FillMsgs(ctx, types.CancelOrderInfoKey, cancelOrderInfo)
-				}
-			}
-		}
+		updateOrders(ctx, keeper, orderKeeper, ordersForUpdateList[idx], &marketParams)
 		// if some orders dealt, update last executed price of this market
 		if !newPrices[idx].IsZero() {
 			mi.LastExecutedPrice = newPrices[idx]
 			keeper.SetMarket(ctx, mi)
+			keeper.UpdateCetPrice(ctx, mi)
 		}
 	}
 }
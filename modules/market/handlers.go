@@ -57,8 +57,7 @@ func handleMsgCreateTradingPair(ctx sdk.Context, msg types.MsgCreateTradingPair,
 		return err.Result()
 	}
 
-	param := keeper.GetParams(ctx)
-	if err := keeper.SubtractFeeAndCollectFee(ctx, msg.Creator, param.CreateMarketFee); err != nil {
+	if err := keeper.SubtractFeeAndCollectFee(ctx, msg.Creator, keeper.GetCreateMarketFee(ctx)); err != nil {
 		// CreateMarketFee has been checked with HasCoins in checkMsgCreateTradingPair
 		// this clause will not execute in production
 		return err.Result()
@@ -99,8 +98,7 @@ func checkMsgCreateTradingPair(ctx sdk.Context, msg types.MsgCreateTradingPair,
 		return types.ErrInvalidTokenIssuer()
 	}
 
-	marketParams := keeper.GetParams(ctx)
-	if !keeper.HasCoins(ctx, msg.Creator, dex.NewCetCoins(marketParams.CreateMarketFee)) {
+	if !keeper.HasCoins(ctx, msg.Creator, dex.NewCetCoins(keeper.GetCreateMarketFee(ctx))) {
 		return types.ErrInsufficientCoins()
 	}
 
@@ -224,27 +222,50 @@ func getDenomAndOrderAmount(msg types.MsgCreateOrder) (string, int64, sdk.Error)
 }
 
 func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keepers.Keeper) sdk.Result {
+	order, err := createOrder(ctx, msg, keeper)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyCreateOrder,
+			sdk.NewAttribute(AttributeKeyOrder, order.OrderID()),
+			sdk.NewAttribute(AttributeKeyTradingPair, order.TradingPair),
+			sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(order.Height, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
 
+// createOrder checks the msg, freezes the coins and fees of the new order, and adds it to the order book
+func createOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keepers.Keeper) (types.Order, sdk.Error) {
 	denom, amount, err := getDenomAndOrderAmount(msg)
 	if err != nil {
-		return err.Result()
+		return types.Order{}, err
 	}
 	seq, err := keeper.QuerySeqWithAddr(ctx, msg.Sender)
 	if err != nil {
-		return err.Result()
+		return types.Order{}, err
 	}
 	marketParams := keeper.GetParams(ctx)
 	frozenFee, err := calOrderCommission(ctx, keeper, msg)
 	if err != nil {
-		return err.Result()
+		return types.Order{}, err
 	}
 	featureFee := calFeatureFeeForExistBlocks(msg, marketParams)
 	totalFee := frozenFee + featureFee
 	if featureFee > types.MaxOrderAmount || frozenFee > types.MaxOrderAmount || totalFee > types.MaxOrderAmount {
-		return types.ErrInvalidOrderAmount("The frozen fee is too large").Result()
+		return types.Order{}, types.ErrInvalidOrderAmount("The frozen fee is too large")
 	}
 	if err := checkMsgCreateOrder(ctx, keeper, msg, totalFee, amount, denom, seq); err != nil {
-		return err.Result()
+		return types.Order{}, err
 	}
 	existBlocks := msg.ExistBlocks
 	if existBlocks == 0 && msg.TimeInForce == GTE {
@@ -273,29 +294,98 @@ func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keep
 
 	ork := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
 	if err := ork.Add(ctx, &order); err != nil {
-		return err.Result()
+		return types.Order{}, err
 	}
 	if err := handleFeeForCreateOrder(ctx, keeper, amount, denom, order.Sender, frozenFee, featureFee); err != nil {
-		return err.Result()
+		return types.Order{}, err
 	}
 	sendCreateOrderMsg(ctx, keeper, order)
+	return order, nil
+}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			EventTypeKeyCreateOrder,
+// OrderCreator lets other modules add orders to the order books, e.g. to route trades to them.
+// It takes a pointer because the market keeper may be built after the modules using it.
+type OrderCreator struct {
+	keeper *keepers.Keeper
+}
+
+func NewOrderCreator(keeper *keepers.Keeper) OrderCreator {
+	return OrderCreator{keeper: keeper}
+}
+
+// CreateIOCOrder adds an IOC order just like MsgCreateOrder, but deals it with the resting orders at once
+// instead of at the end of the block, and leaves the events to the caller. The order returned has been
+// removed, and tells the stock and money dealt.
+func (oc OrderCreator) CreateIOCOrder(ctx sdk.Context, msg types.MsgCreateOrder) (types.Order, sdk.Error) {
+	if msg.TimeInForce != IOC {
+		return types.Order{}, types.ErrInvalidTimeInForce(msg.TimeInForce)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return types.Order{}, err
+	}
+	order, err := createOrder(ctx, msg, *oc.keeper)
+	if err != nil {
+		return types.Order{}, err
+	}
+	settleIOCOrder(ctx, *oc.keeper, &order)
+	return order, nil
+}
+
+// OrderCanceller lets other modules cancel the orders of an address, e.g. to claw back the coins frozen in them.
+// It takes a pointer because the market keeper may be built after the modules using it.
+type OrderCanceller struct {
+	keeper *keepers.Keeper
+}
+
+func NewOrderCanceller(keeper *keepers.Keeper) OrderCanceller {
+	return OrderCanceller{keeper: keeper}
+}
+
+// CancelOrdersOfHolder cancels the orders of the holder whose stock or money is the denom,
+// unfreezing their coins, and returns the ids of the cancelled orders
+func (oc OrderCanceller) CancelOrdersOfHolder(ctx sdk.Context, holder sdk.AccAddress, denom string) []string {
+	keeper := *oc.keeper
+	glk := keepers.NewGlobalOrderKeeper(keeper.GetMarketKey(), types.ModuleCdc)
+	orderIDs := glk.GetOrdersFromUser(ctx, holder.String())
+	if len(orderIDs) == 0 {
+		return nil
+	}
+	marketParams := keeper.GetParams(ctx)
+	bankxKeeper := keeper.GetBankxKeeper()
+	var cancelled []string
+	for _, orderID := range orderIDs {
+		order := glk.QueryOrder(ctx, orderID)
+		if order == nil {
+			continue
+		}
+		stock, money := SplitSymbol(order.TradingPair)
+		if stock != denom && money != denom {
+			continue
+		}
+		ork := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
+		removeOrder(ctx, ork, bankxKeeper, keeper, order, &marketParams)
+		if keeper.IsSubScribed(types.Topic) {
+			cancelOrderInfo := packageCancelOrderMsgWithDelReason(ctx, order, types.CancelOrderByClawback, &marketParams, keeper)
+			msgqueue.FillMsgs(ctx, types.CancelOrderInfoKey, cancelOrderInfo)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeKeyCancelOrder,
 			sdk.NewAttribute(AttributeKeyOrder, order.OrderID()),
+			sdk.NewAttribute(AttributeKeyDelOrderReason, types.CancelOrderByClawback),
+			sdk.NewAttribute(AttributeKeyDelOrderHeight, strconv.Itoa(int(ctx.BlockHeight()))),
 			sdk.NewAttribute(AttributeKeyTradingPair, order.TradingPair),
-			sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(order.Height, 10)),
-		),
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
-		),
-	})
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
+		))
+		cancelled = append(cancelled, order.OrderID())
 	}
+	return cancelled
+}
+
+// receivedDenom returns the token that the sender of an order receives when it is filled
+func receivedDenom(side byte, stock, money string) string {
+	if side == types.BUY {
+		return stock
+	}
+	return money
 }
 
 func checkMsgCreateOrder(ctx sdk.Context, keeper keepers.Keeper, msg types.MsgCreateOrder, cetFee int64, amount int64, denom string, seq uint64) sdk.Error {
@@ -331,6 +421,9 @@ func checkMsgCreateOrder(ctx sdk.Context, keeper keepers.Keeper, msg types.MsgCr
 	if keeper.IsForbiddenByTokenIssuer(ctx, stock, msg.Sender) || keeper.IsForbiddenByTokenIssuer(ctx, money, msg.Sender) {
 		return types.ErrAddressForbidByIssuer()
 	}
+	if received := receivedDenom(msg.Side, stock, money); !keeper.IsPermittedRecipient(ctx, received, msg.Sender) {
+		return types.ErrNotWhitelistedByIssuer(received)
+	}
 	baseValue := types.GetGranularityOfOrder(marketInfo.OrderPrecision)
 	if msg.Quantity%baseValue != 0 {
 		return types.ErrInvalidOrderAmount("The amount of tokens to trade should be a multiple of the order precision")
@@ -168,6 +168,7 @@ func prepareAssetKeeper(t *testing.T, keys storeKeys, cdc *codec.Codec, ctx sdk.
 		params.NewKeeper(cdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(asset.DefaultParamspace),
 		bkx,
 		sk,
+		msgqueue.NewProducer(nil),
 	)
 	tk.SetParams(ctx, asset.DefaultParams())
 
@@ -385,6 +386,52 @@ func TestMarketInfoSetSuccess(t *testing.T) {
 	}
 }
 
+func TestCreateMarketFeePricedInToken(t *testing.T) {
+	input := prepareMockInput(t, true, true)
+	params := input.mk.GetParams(input.ctx)
+	require.True(t, createCetMarket(input, stock, 0).IsOK())
+
+	// no price of CET yet
+	params.FeePricing = dex.NewFeePricing(stock, sdk.OneDec())
+	input.mk.SetParams(input.ctx, params)
+	_, ok := input.mk.GetCetPrice(input.ctx, stock)
+	require.False(t, ok)
+	require.Equal(t, params.CreateMarketFee, input.mk.GetCreateMarketFee(input.ctx))
+
+	// 1 tusdt is 0.5 CET, and the price is not used before it is averaged over a whole window
+	info, err := input.mk.GetMarketInfo(input.ctx, GetSymbol(stock, dex.CET))
+	require.Nil(t, err)
+	info.LastExecutedPrice = sdk.NewDecWithPrec(5, 1)
+	require.Nil(t, input.mk.SetMarket(input.ctx, info))
+	input.mk.UpdateCetPrice(input.ctx, info)
+	_, ok = input.mk.GetCetPrice(input.ctx, stock)
+	require.False(t, ok)
+	startTime := input.ctx.BlockHeader().Time
+	input.ctx = input.ctx.WithBlockTime(startTime.Add(time.Duration(types.CetPriceWindow) * time.Second))
+
+	// the fee set at 1 tusdt per CET is halved
+	price, ok := input.mk.GetCetPrice(input.ctx, stock)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(2), price)
+	require.Equal(t, params.CreateMarketFee/2, input.mk.GetCreateMarketFee(input.ctx))
+
+	// a wash trade at a hundredfold price barely moves the average
+	info.LastExecutedPrice = sdk.NewDecWithPrec(5, 3)
+	input.mk.UpdateCetPrice(input.ctx, info)
+	input.ctx = input.ctx.WithBlockTime(input.ctx.BlockHeader().Time.Add(time.Second))
+	price, ok = input.mk.GetCetPrice(input.ctx, stock)
+	require.True(t, ok)
+	require.True(t, price.LT(sdk.NewDecWithPrec(21, 1)), price.String())
+	info.LastExecutedPrice = sdk.NewDecWithPrec(5, 1)
+	input.mk.UpdateCetPrice(input.ctx, info)
+	input.ctx = input.ctx.WithBlockTime(input.ctx.BlockHeader().Time.Add(time.Duration(types.CetPriceWindow) * time.Second))
+
+	oldCetCoin := input.getCoinFromAddr(haveCetAddress, dex.CET)
+	require.True(t, createImpMarket(input, stock, money, 0).IsOK())
+	newCetCoin := input.getCoinFromAddr(haveCetAddress, dex.CET)
+	require.True(t, IsEqual(oldCetCoin, newCetCoin, dex.NewCetCoin(params.CreateMarketFee/2)))
+}
+
 func TestCreateOrderFailed(t *testing.T) {
 	input := prepareMockInput(t, false, true)
 	msgOrder := types.MsgCreateOrder{
@@ -641,6 +688,81 @@ func TestCancelOrderSuccess(t *testing.T) {
 	require.Equal(t, true, input.hasCoins(notHaveCetAddress, sdk.Coins{remainCoin}), "The amount is error ")
 }
 
+func TestCancelOrdersOfHolder(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	ret := createCetMarket(input, stock, 10)
+	require.Equal(t, true, ret.IsOK(), "create market should succeed")
+
+	msgGteOrder := types.MsgCreateOrder{
+		Sender:         haveCetAddress,
+		Identify:       1,
+		TradingPair:    GetSymbol(stock, "cet"),
+		OrderType:      types.LimitOrder,
+		PricePrecision: 8,
+		Price:          100,
+		Quantity:       10000000,
+		Side:           types.SELL,
+		TimeInForce:    types.GTE,
+	}
+	seq, err := input.mk.QuerySeqWithAddr(input.ctx, msgGteOrder.Sender)
+	require.Equal(t, nil, err)
+	oldCoin := input.getCoinFromAddr(haveCetAddress, stock)
+	ret = input.handler(input.ctx, msgGteOrder)
+	require.Equal(t, true, ret.IsOK(), "create GTE order should succeed")
+	orderID := types.AssemblyOrderID(msgGteOrder.Sender.String(), seq, msgGteOrder.Identify)
+
+	// only the orders trading the denom are cancelled
+	oc := NewOrderCanceller(&input.mk)
+	require.Empty(t, oc.CancelOrdersOfHolder(input.ctx, haveCetAddress, "xyz"))
+	require.Empty(t, oc.CancelOrdersOfHolder(input.ctx, notHaveCetAddress, stock))
+	require.Equal(t, []string{orderID}, oc.CancelOrdersOfHolder(input.ctx, haveCetAddress, stock))
+
+	glk := keepers.NewGlobalOrderKeeper(input.keys.marketKey, input.cdc)
+	require.Nil(t, glk.QueryOrder(input.ctx, orderID))
+	require.Equal(t, oldCoin, input.getCoinFromAddr(haveCetAddress, stock))
+}
+
+func TestCreateIOCOrder(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	ret := createCetMarket(input, stock, 0)
+	require.Equal(t, true, ret.IsOK(), "create market should succeed")
+	msgGteOrder := types.MsgCreateOrder{
+		Sender:         haveCetAddress,
+		Identify:       1,
+		TradingPair:    GetSymbol(stock, "cet"),
+		OrderType:      types.LimitOrder,
+		PricePrecision: 8,
+		Price:          100,
+		Quantity:       10000000,
+		Side:           types.SELL,
+		TimeInForce:    types.GTE,
+	}
+	oldCoin := input.getCoinFromAddr(haveCetAddress, stock)
+	ret = input.handler(input.ctx, msgGteOrder)
+	require.Equal(t, true, ret.IsOK(), "create GTE order should succeed")
+
+	oc := NewOrderCreator(&input.mk)
+	_, err := oc.CreateIOCOrder(input.ctx, msgGteOrder)
+	require.Equal(t, types.CodeInvalidTimeInForce, err.Code())
+
+	// the IOC order deals with the ask at its price at once, and both are removed
+	msgIOCOrder := msgGteOrder
+	msgIOCOrder.Identify = 2
+	msgIOCOrder.Price = 300
+	msgIOCOrder.Quantity = 30000000
+	msgIOCOrder.Side = types.BUY
+	msgIOCOrder.TimeInForce = types.IOC
+	order, err := oc.CreateIOCOrder(input.ctx, msgIOCOrder)
+	require.Nil(t, err)
+	require.Equal(t, int64(10000000), order.DealStock)
+	require.Equal(t, int64(10), order.DealMoney)
+	require.Empty(t, input.mk.GetAllOrders(input.ctx))
+	info, e := input.mk.GetMarketInfo(input.ctx, GetSymbol(stock, "cet"))
+	require.Nil(t, e)
+	require.Equal(t, sdk.NewDecWithPrec(1, 6), info.LastExecutedPrice)
+	require.Equal(t, oldCoin, input.getCoinFromAddr(haveCetAddress, stock))
+}
+
 func TestCancelMarketFailed(t *testing.T) {
 	input := prepareMockInput(t, false, false)
 	createCetMarket(input, stock, 0)
@@ -116,6 +116,10 @@ func (k Keeper) IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, addr sdk
 	return k.axk.IsForbiddenByTokenIssuer(ctx, denom, addr)
 }
 
+func (k Keeper) IsPermittedRecipient(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.axk.IsPermittedRecipient(ctx, denom, addr)
+}
+
 func (k Keeper) IsTokenForbidden(ctx sdk.Context, symbol string) bool {
 	return k.axk.IsTokenForbidden(ctx, symbol)
 }
@@ -178,6 +182,12 @@ func (k Keeper) GetMarketFeeMin(ctx sdk.Context) int64 {
 	return k.GetParams(ctx).MarketFeeMin
 }
 
+// GetCreateMarketFee returns the fee of creating a market, which may be priced in a reference token
+func (k Keeper) GetCreateMarketFee(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.FeePricing.GetFee(ctx, k, params.CreateMarketFee)
+}
+
 // -----------------------------------------------------------------------------
 // Order
 
@@ -190,6 +200,12 @@ func (k Keeper) GetAllOrders(ctx sdk.Context) []*types.Order {
 	return NewGlobalOrderKeeper(k.marketKey, k.cdc).GetAllOrders(ctx)
 }
 
+// GetOrdersCrossingPrice returns the orders of the trading pair on the opposite side of 'side',
+// whose prices can deal with 'price', the best price first
+func (k Keeper) GetOrdersCrossingPrice(ctx sdk.Context, symbol string, side byte, price sdk.Dec) []*types.Order {
+	return NewOrderKeeper(k.marketKey, symbol, k.cdc).GetOrdersCrossingPrice(ctx, side, price)
+}
+
 // -----------------------------------------------
 // market info
 
@@ -229,6 +245,53 @@ func (k *Keeper) GetMarketLastExePrice(ctx sdk.Context, symbol string) (sdk.Dec,
 	return mi.LastExecutedPrice, err
 }
 
+// GetCetPrice returns the time-weighted average price of CET in the token, by the executed prices
+// of the cet/token or token/cet market. It returns false before the market has traded for a whole window.
+func (k Keeper) GetCetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	p, ok := k.getCetPriceRecord(ctx, denom)
+	now := ctx.BlockHeader().Time.Unix()
+	if !ok || !p.IsReady(now) {
+		return sdk.ZeroDec(), false
+	}
+	price := p.AverageAt(now)
+	return price, price.IsPositive()
+}
+
+// UpdateCetPrice takes the last executed price of a market into the average price of CET,
+// if it is a market of CET
+func (k Keeper) UpdateCetPrice(ctx sdk.Context, info types.MarketInfo) {
+	if !info.LastExecutedPrice.IsPositive() {
+		return
+	}
+	var denom string
+	var price sdk.Dec
+	switch {
+	case info.Stock == dex.CET:
+		denom, price = info.Money, info.LastExecutedPrice
+	case info.Money == dex.CET:
+		denom, price = info.Stock, sdk.OneDec().Quo(info.LastExecutedPrice)
+	default:
+		return
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	p, ok := k.getCetPriceRecord(ctx, denom)
+	if ok {
+		p.Update(price, now)
+	} else {
+		p = types.NewCetPrice(price, now)
+	}
+	ctx.KVStore(k.marketKey).Set(marketStoreKey(CetPriceKeyPrefix, denom), k.cdc.MustMarshalBinaryBare(p))
+}
+
+func (k Keeper) getCetPriceRecord(ctx sdk.Context, denom string) (p types.CetPrice, ok bool) {
+	bz := ctx.KVStore(k.marketKey).Get(marketStoreKey(CetPriceKeyPrefix, denom))
+	if bz == nil {
+		return p, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &p)
+	return p, true
+}
+
 func (k Keeper) GetMarketVolume(ctx sdk.Context, stock, money string, stockVolume, moneyVolume sdk.Dec) sdk.Dec {
 	volume := sdk.ZeroDec()
 	if stock == dex.CET {
@@ -2,6 +2,7 @@ package keepers
 
 var (
 	MarketIdentifierPrefix = []byte{0x15}
+	CetPriceKeyPrefix      = []byte{0x16}
 	DelistKey              = []byte{0x40}
 	DelistRevKey           = []byte{0x42}
 )
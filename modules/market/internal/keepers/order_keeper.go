@@ -59,6 +59,7 @@ type OrderKeeper interface {
 	GetOlderThan(ctx sdk.Context, height int64) []*types.Order
 	GetOrdersAtHeight(ctx sdk.Context, height int64) []*types.Order
 	GetMatchingCandidates(ctx sdk.Context) []*types.Order
+	GetOrdersCrossingPrice(ctx sdk.Context, side byte, price sdk.Dec) []*types.Order
 	GetSymbol() string
 }
 
@@ -308,6 +309,37 @@ func (keeper *PersistentOrderKeeper) GetMatchingCandidates(ctx sdk.Context) []*t
 	return result
 }
 
+// Return the orders on the opposite side of 'side' whose prices can deal with 'price', the best price first
+func (keeper *PersistentOrderKeeper) GetOrdersCrossingPrice(ctx sdk.Context, side byte, price sdk.Dec) []*types.Order {
+	store := ctx.KVStore(keeper.marketKey)
+	priceStartPos := len(keeper.symbol) + 2
+	priceEndPos := priceStartPos + types.DecByteCount
+	limit := types.DecToBigEndianBytes(price)
+
+	var iter sdk.Iterator
+	if side == types.BID {
+		iter = store.Iterator(dex.ConcatKeys(AskListKeyPrefix, []byte(keeper.symbol), []byte{0x0}),
+			dex.ConcatKeys(AskListKeyPrefix, []byte(keeper.symbol), []byte{0x1}))
+	} else {
+		iter = store.ReverseIterator(dex.ConcatKeys(BidListKeyPrefix, []byte(keeper.symbol), []byte{0x0}),
+			dex.ConcatKeys(BidListKeyPrefix, []byte(keeper.symbol), []byte{0x1}))
+	}
+	defer iter.Close()
+
+	var result []*types.Order
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		cmp := bytes.Compare(key[priceStartPos:priceEndPos], limit)
+		if (side == types.BID && cmp > 0) || (side == types.ASK && cmp < 0) {
+			break
+		}
+		if order := keeper.getOrder(ctx, string(key[priceEndPos:])); order != nil {
+			result = append(result, order)
+		}
+	}
+	return result
+}
+
 ////////////////////////////////////////////////
 
 // Global order keep can lookup a order, given its ID or the prefix of its ID, i.e. the sender's address
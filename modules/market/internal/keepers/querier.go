@@ -21,6 +21,7 @@ const (
 	QueryUserOrders        = "user-order-list"
 	QueryWaitCancelMarkets = "wait-cancel-markets"
 	QueryParameters        = "parameters"
+	QueryOrderSimulation   = "order-simulation"
 )
 
 // creates a querier for asset REST endpoints
@@ -263,3 +264,24 @@ func queryWaitCancelMarkets(ctx sdk.Context, req abci.RequestQuery, mk Keeper) (
 	}
 	return bz, nil
 }
+
+type QueryOrderSimulationParam struct {
+	Order types.MsgCreateOrder `json:"order"`
+}
+
+// The result of a simulated MsgCreateOrder, nothing is written to the store
+type ResOrderSimulation struct {
+	Valid              bool    `json:"valid"`
+	Codespace          string  `json:"codespace,omitempty"`
+	Code               uint32  `json:"code,omitempty"`
+	Log                string  `json:"log,omitempty"`
+	FreezeDenom        string  `json:"freeze_denom"`
+	Freeze             int64   `json:"freeze"`
+	FrozenCommission   int64   `json:"frozen_commission"`
+	FrozenFeatureFee   int64   `json:"frozen_feature_fee"`
+	RebateRefereeAddr  string  `json:"rebate_referee_addr"`
+	RebateAmount       int64   `json:"rebate_amount"`
+	EstimatedDealStock int64   `json:"estimated_deal_stock"`
+	EstimatedDealMoney int64   `json:"estimated_deal_money"`
+	EstimatedAvgPrice  sdk.Dec `json:"estimated_avg_price"`
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CetPriceWindow is the time in seconds over which the prices of CET are averaged, so that a
+// short-lived price, e.g. of a wash trade, moves the average little. An average is not used
+// before its market has traded for a whole window.
+const CetPriceWindow int64 = 60 * 60
+
+// CetPrice is the time-weighted average price of CET in a token, updated with the executed
+// prices of their market. Last is the latest executed price, which has been held since UpdateTime.
+type CetPrice struct {
+	Average    sdk.Dec `json:"average"`
+	Last       sdk.Dec `json:"last"`
+	StartTime  int64   `json:"start_time"`
+	UpdateTime int64   `json:"update_time"`
+}
+
+func NewCetPrice(price sdk.Dec, now int64) CetPrice {
+	return CetPrice{
+		Average:    price,
+		Last:       price,
+		StartTime:  now,
+		UpdateTime: now,
+	}
+}
+
+// AverageAt returns the average at the time now, moving the average towards the last price
+// by the part of the window the last price has been held
+func (p CetPrice) AverageAt(now int64) sdk.Dec {
+	elapsed := now - p.UpdateTime
+	if elapsed <= 0 {
+		return p.Average
+	}
+	if elapsed >= CetPriceWindow {
+		return p.Last
+	}
+	return p.Average.Add(p.Last.Sub(p.Average).MulInt64(elapsed).QuoInt64(CetPriceWindow))
+}
+
+// Update takes a new executed price at the time now
+func (p *CetPrice) Update(price sdk.Dec, now int64) {
+	p.Average = p.AverageAt(now)
+	p.Last = price
+	if now > p.UpdateTime {
+		p.UpdateTime = now
+	}
+}
+
+// IsReady returns whether the average has covered a whole window at the time now
+func (p CetPrice) IsReady(now int64) bool {
+	return now-p.StartTime >= CetPriceWindow
+}
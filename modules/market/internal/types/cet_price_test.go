@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCetPrice(t *testing.T) {
+	p := NewCetPrice(sdk.NewDec(2), 1000)
+	require.False(t, p.IsReady(1000+CetPriceWindow-1))
+	require.True(t, p.IsReady(1000+CetPriceWindow))
+	require.Equal(t, sdk.NewDec(2), p.AverageAt(2000))
+
+	// a price held for a quarter of the window moves the average by a quarter
+	p.Update(sdk.NewDec(10), 1000)
+	require.Equal(t, sdk.NewDec(2), p.AverageAt(1000))
+	require.Equal(t, sdk.NewDec(4), p.AverageAt(1000+CetPriceWindow/4))
+
+	// a wash trade just before the fee is charged barely moves it
+	p.Update(sdk.NewDec(2), 1000+CetPriceWindow/4)
+	p.Update(sdk.NewDec(1000), 1001+CetPriceWindow/4)
+	require.True(t, p.AverageAt(1001+CetPriceWindow/4).LT(sdk.NewDec(5)))
+
+	// and the average is the last price once it has been held for a whole window
+	require.Equal(t, sdk.NewDec(1000), p.AverageAt(1001+CetPriceWindow/4+CetPriceWindow))
+}
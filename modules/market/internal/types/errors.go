@@ -37,6 +37,7 @@ const (
 	CodeOrderAlreadyExist      sdk.CodeType = 630
 	CodeDelistRequestExist     sdk.CodeType = 632
 	CodeInvalidMarket          sdk.CodeType = 633
+	CodeNotWhitelistedByIssuer sdk.CodeType = 634
 )
 
 func ErrFailedParseParam() sdk.Error {
@@ -79,6 +80,10 @@ func ErrAddressForbidByIssuer() sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeAddressForbidByIssuer, "The sender is forbidden by token issuer")
 }
 
+func ErrNotWhitelistedByIssuer(denom string) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeNotWhitelistedByIssuer, "The sender is not whitelisted to receive the permissioned token %s", denom)
+}
+
 func ErrOrderAlreadyExist(id string) sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeOrderAlreadyExist, "the order [%s] already exist", id)
 }
@@ -28,6 +28,7 @@ type ExpectedAssetStatusKeeper interface {
 	IsTokenExists(ctx sdk.Context, denom string) bool    // check whether there is a coin named "denom"
 	IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
 	IsForbiddenByTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	IsPermittedRecipient(ctx sdk.Context, denom string, addr sdk.AccAddress) bool // only the whitelisted addresses could receive a permissioned token
 	GetToken(ctx sdk.Context, symbol string) asset.Token
 }
 
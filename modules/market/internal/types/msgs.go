@@ -29,6 +29,7 @@ const (
 	CancelOrderByIocType       = "IOC order cancel "
 	CancelOrderByNoEnoughMoney = "Insufficient freeze money"
 	CancelOrderByNotKnow       = "Don't know"
+	CancelOrderByClawback      = "The token was clawed back by its owner"
 )
 
 // /////////////////////////////////////////////////////////
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 const (
@@ -29,6 +31,7 @@ var (
 	KeyMarketFeeRate               = []byte("MarketFeeRate")
 	KeyMarketFeeMin                = []byte("MarketFeeMin")
 	KeyFeeForZeroDeal              = []byte("FeeForZeroDeal")
+	KeyFeePricing                  = []byte("FeePricing")
 )
 
 type Params struct {
@@ -40,6 +43,8 @@ type Params struct {
 	MarketFeeRate               int64 `json:"market_fee_rate"`
 	MarketFeeMin                int64 `json:"market_fee_min"`
 	FeeForZeroDeal              int64 `json:"fee_for_zero_deal"`
+	// the optional mode in which CreateMarketFee is priced in a reference token
+	FeePricing dex.FeePricing `json:"fee_pricing"`
 }
 
 // ParamKeyTable for market module
@@ -58,6 +63,7 @@ func DefaultParams() Params {
 		DefaultMarketFeeRate,
 		DefaultMarketFeeMin,
 		DefaultFeeForZeroDeal,
+		dex.DefaultFeePricing(),
 	}
 }
 
@@ -74,6 +80,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMarketFeeRate, Value: &p.MarketFeeRate},
 		{Key: KeyMarketFeeMin, Value: &p.MarketFeeMin},
 		{Key: KeyFeeForZeroDeal, Value: &p.FeeForZeroDeal},
+		{Key: KeyFeePricing, Value: &p.FeePricing},
 	}
 }
 
@@ -94,6 +101,9 @@ func (p *Params) ValidateGenesis() error {
 			p.MarketFeeRate, p.MarketFeeMin, p.FeeForZeroDeal, p.GTEOrderLifetime,
 			p.GTEOrderFeatureFeeByBlocks)
 	}
+	if err := p.FeePricing.Validate(); err != nil {
+		return fmt.Errorf("%s is invalid: %s", KeyFeePricing, err)
+	}
 	return nil
 }
 
@@ -113,7 +123,8 @@ func (p Params) String() string {
   MaxExecutedPriceChangeRatio: %d
   MarketFeeRate:               %d
   MarketFeeMin:                %d
-  FeeForZeroDeal:              %d`,
+  FeeForZeroDeal:              %d
+  FeePricing:                  %s`,
 		p.CreateMarketFee,
 		p.MarketMinExpiredTime,
 		p.GTEOrderLifetime,
@@ -121,5 +132,6 @@ func (p Params) String() string {
 		p.MaxExecutedPriceChangeRatio,
 		p.MarketFeeRate,
 		p.MarketFeeMin,
-		p.FeeForZeroDeal)
+		p.FeeForZeroDeal,
+		p.FeePricing)
 }
@@ -83,6 +83,9 @@ func (k *mocAssertStatusKeeper) IsForbiddenByTokenIssuer(ctx sdk.Context, denom
 	}
 	return false
 }
+func (k *mocAssertStatusKeeper) IsPermittedRecipient(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return true
+}
 func (k *mocAssertStatusKeeper) GetToken(ctx sdk.Context, symbol string) asset.Token {
 	return nil
 }
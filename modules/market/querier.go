@@ -0,0 +1,123 @@
+package market
+
+import (
+	"math"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+)
+
+// NewQuerier serves the queries which need the order-creating logic of this package,
+// and leaves the others to keepers.NewQuerier
+func NewQuerier(k keepers.Keeper) sdk.Querier {
+	querier := keepers.NewQuerier(k)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case keepers.QueryOrderSimulation:
+			return queryOrderSimulation(ctx, req, k)
+		default:
+			return querier(ctx, path, req)
+		}
+	}
+}
+
+func queryOrderSimulation(ctx sdk.Context, req abci.RequestQuery, k keepers.Keeper) ([]byte, sdk.Error) {
+	var param keepers.QueryOrderSimulationParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, types.ErrFailedParseParam()
+	}
+
+	res := simulateCreateOrder(ctx, k, param.Order)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, types.ErrFailedMarshal()
+	}
+	return bz, nil
+}
+
+// simulateCreateOrder runs the same calculations and checks as handleMsgCreateOrder
+// without freezing any coins or adding the order to the order book
+func simulateCreateOrder(ctx sdk.Context, k keepers.Keeper, msg types.MsgCreateOrder) keepers.ResOrderSimulation {
+	res := keepers.ResOrderSimulation{EstimatedAvgPrice: sdk.ZeroDec()}
+	fail := func(err sdk.Error) keepers.ResOrderSimulation {
+		res.Valid = false
+		res.Codespace = string(err.Codespace())
+		res.Code = uint32(err.Code())
+		res.Log = err.Result().Log
+		return res
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return fail(err)
+	}
+	denom, amount, err := getDenomAndOrderAmount(msg)
+	if err != nil {
+		return fail(err)
+	}
+	res.FreezeDenom = denom
+	res.Freeze = amount
+
+	marketParams := k.GetParams(ctx)
+	frozenFee, err := calOrderCommission(ctx, k, msg)
+	if err != nil {
+		return fail(err)
+	}
+	featureFee := calFeatureFeeForExistBlocks(msg, marketParams)
+	res.FrozenCommission = frozenFee
+	res.FrozenFeatureFee = featureFee
+	totalFee := frozenFee + featureFee
+	if featureFee > types.MaxOrderAmount || frozenFee > types.MaxOrderAmount || totalFee > types.MaxOrderAmount {
+		return fail(types.ErrInvalidOrderAmount("The frozen fee is too large"))
+	}
+
+	if refereeAddr := k.GetRefereeAddr(ctx, msg.Sender); refereeAddr != nil {
+		res.RebateRefereeAddr = refereeAddr.String()
+		res.RebateAmount = getRebateAmountInOrder(ctx, k, frozenFee, featureFee)
+	}
+
+	if _, err := k.GetMarketInfo(ctx, msg.TradingPair); err == nil {
+		estimateOrderFill(ctx, k, msg, &res)
+	}
+
+	seq, err := k.QuerySeqWithAddr(ctx, msg.Sender)
+	if err != nil {
+		return fail(err)
+	}
+	if err := checkMsgCreateOrder(ctx, k, msg, totalFee, amount, denom, seq); err != nil {
+		return fail(err)
+	}
+	res.Valid = true
+	return res
+}
+
+// estimateOrderFill walks the opposite side of the order book from the best price and
+// fills the order at the resting orders' prices. The real deal price is decided by the
+// matching in EndBlocker, so this is only an estimation.
+func estimateOrderFill(ctx sdk.Context, k keepers.Keeper, msg types.MsgCreateOrder, res *keepers.ResOrderSimulation) {
+	price := sdk.NewDec(msg.Price).Quo(sdk.NewDec(int64(math.Pow10(int(msg.PricePrecision)))))
+	ork := keepers.NewOrderKeeper(k.GetMarketKey(), msg.TradingPair, types.ModuleCdc)
+	leftStock := msg.Quantity
+	dealMoney := sdk.ZeroDec()
+	for _, order := range ork.GetOrdersCrossingPrice(ctx, msg.Side, price) {
+		if leftStock == 0 {
+			break
+		}
+		dealStock := order.LeftStock
+		if dealStock > leftStock {
+			dealStock = leftStock
+		}
+		leftStock -= dealStock
+		dealMoney = dealMoney.Add(order.Price.MulInt64(dealStock))
+	}
+
+	res.EstimatedDealStock = msg.Quantity - leftStock
+	res.EstimatedDealMoney = dealMoney.RoundInt64()
+	if res.EstimatedDealStock != 0 {
+		res.EstimatedAvgPrice = dealMoney.QuoInt64(res.EstimatedDealStock)
+	}
+}
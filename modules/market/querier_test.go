@@ -0,0 +1,87 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestQueryOrderSimulation(t *testing.T) {
+	input := prepareMockInput(t, false, false)
+	ret := createCetMarket(input, stock, 0)
+	require.True(t, ret.IsOK())
+
+	sellOrder := types.MsgCreateOrder{
+		Sender:         haveCetAddress,
+		Identify:       1,
+		TradingPair:    GetSymbol(stock, dex.CET),
+		OrderType:      types.LimitOrder,
+		PricePrecision: 0,
+		Price:          2,
+		Quantity:       30000000,
+		Side:           types.SELL,
+		TimeInForce:    types.GTE,
+	}
+	ret = input.handler(input.ctx, sellOrder)
+	require.True(t, ret.IsOK(), ret.Log)
+
+	buyOrder := sellOrder
+	buyOrder.Identify = 2
+	buyOrder.Price = 3
+	buyOrder.Quantity = 50000000
+	buyOrder.Side = types.BUY
+	buyOrder.TimeInForce = types.IOC
+
+	querier := NewQuerier(input.mk)
+	query := func(msg types.MsgCreateOrder) keepers.ResOrderSimulation {
+		bz := input.cdc.MustMarshalJSON(keepers.QueryOrderSimulationParam{Order: msg})
+		res, err := querier(input.ctx, []string{keepers.QueryOrderSimulation}, abci.RequestQuery{Data: bz})
+		require.Nil(t, err)
+		var sim keepers.ResOrderSimulation
+		input.cdc.MustUnmarshalJSON(res, &sim)
+		return sim
+	}
+
+	sim := query(buyOrder)
+	require.True(t, sim.Valid, sim.Log)
+	denom, amount, _ := getDenomAndOrderAmount(buyOrder)
+	frozenFee, _ := calOrderCommission(input.ctx, input.mk, buyOrder)
+	require.Equal(t, denom, sim.FreezeDenom)
+	require.Equal(t, amount, sim.Freeze)
+	require.Equal(t, frozenFee, sim.FrozenCommission)
+	require.Equal(t, int64(0), sim.FrozenFeatureFee)
+	require.Equal(t, frozenFee/100, sim.RebateAmount)
+	require.Equal(t, sellOrder.Quantity, sim.EstimatedDealStock)
+	require.Equal(t, sellOrder.Quantity*2, sim.EstimatedDealMoney)
+	require.True(t, sim.EstimatedAvgPrice.Equal(sdk.NewDec(2)))
+
+	// the order book must be left untouched
+	ork := keepers.NewOrderKeeper(input.keys.marketKey, buyOrder.TradingPair, types.ModuleCdc)
+	require.Equal(t, 1, len(ork.GetOlderThan(input.ctx, 1000)))
+
+	// a buy price lower than all asks gets no estimated fill
+	buyOrder.Price = 1
+	sim = query(buyOrder)
+	require.True(t, sim.Valid, sim.Log)
+	require.Equal(t, int64(0), sim.EstimatedDealStock)
+	require.True(t, sim.EstimatedAvgPrice.IsZero())
+
+	// not enough coins
+	buyOrder.Sender = forbidAddr
+	buyOrder.Quantity = 1e12
+	sim = query(buyOrder)
+	require.False(t, sim.Valid)
+	require.EqualValues(t, types.CodeInsufficientCoin, sim.Code)
+
+	// invalid message
+	buyOrder.Quantity = 0
+	sim = query(buyOrder)
+	require.False(t, sim.Valid)
+}
@@ -283,13 +283,21 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.BankxKeeper,
 		app.DistrKeeper,
 	)
-	app.AssetKeeper = asset.NewBaseKeeper(
+	assetKeeper := asset.NewBaseKeeper(
 		app.Cdc,
 		app.keyAsset,
 		app.ParamsKeeper.Subspace(asset.DefaultParamspace),
 		app.BankxKeeper,
 		app.SupplyKeeper,
+		app.MsgQueProducer,
 	)
+	// the market keeper is passed by reference, so that clawbacks can cancel the orders of the holders
+	assetKeeper.SetOrderCanceller(market.NewOrderCanceller(&app.MarketKeeper))
+	assetKeeper.SetCetPriceKeeper(&app.MarketKeeper)
+	assetKeeper.SetModuleAccountAddrs(app.ModuleAccountAddrs())
+	// the bancorlite keeper is passed by reference, so that the reserves of liquidity pools are not paid dividends
+	assetKeeper.SetReserveKeeper(&app.BancorKeeper)
+	app.AssetKeeper = assetKeeper
 	app.StakingXKeeper = stakingx.NewKeeper(
 		app.keyStakingX,
 		app.Cdc,
@@ -321,6 +329,8 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.AccountKeeper,
 		app.AccountXKeeper,
 	)
+	// the market keeper is passed by reference, so that bancorlite can route trades to it
+	app.BancorKeeper.SetOrderCreator(market.NewOrderCreator(&app.MarketKeeper))
 	// register the staking hooks
 	// NOTE: The StakingKeeper above is passed by reference, so that it can be
 	// modified like below:
@@ -345,6 +355,8 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.AssetKeeper,
 		app.ParamsKeeper.Subspace(alias.StoreKey),
 	)
+	// the market keeper prices the fees in the reference token, if the params enable it
+	app.AliasKeeper.SetCetPriceKeeper(&app.MarketKeeper)
 }
 
 func (app *TestApp) ModuleAccountAddrs() map[string]bool {
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CetPriceKeeper gives the current price of CET in a token, e.g. the time-weighted average price of their market.
+// It returns false if there is no such price.
+type CetPriceKeeper interface {
+	GetCetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
+
+// FeePricing is the optional mode in which the fixed CET fees of a module are priced in a reference token,
+// usually a stable coin. The fixed fees are set at the reference Price of CET in Denom, and are charged at the
+// current price of CET, so that they are always worth the same amount of Denom. It is disabled if Denom is empty.
+type FeePricing struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	Price sdk.Dec `json:"price" yaml:"price"`
+}
+
+func NewFeePricing(denom string, price sdk.Dec) FeePricing {
+	return FeePricing{
+		Denom: denom,
+		Price: price,
+	}
+}
+
+// DefaultFeePricing charges the fixed CET fees
+func DefaultFeePricing() FeePricing {
+	return NewFeePricing("", sdk.ZeroDec())
+}
+
+func (p FeePricing) IsEnabled() bool {
+	return p.Denom != ""
+}
+
+func (p FeePricing) Validate() error {
+	if !p.IsEnabled() {
+		return nil
+	}
+	if p.Denom == CET {
+		return fmt.Errorf("the reference token of fees must not be %s", CET)
+	}
+	if !(sdk.Coin{Denom: p.Denom, Amount: sdk.OneInt()}).IsValid() {
+		return fmt.Errorf("invalid reference token of fees: %s", p.Denom)
+	}
+	if p.Price == (sdk.Dec{}) || !p.Price.IsPositive() {
+		return fmt.Errorf("the reference price of fees must be positive")
+	}
+	return nil
+}
+
+// MaxFeePricingRatio bounds the converted fees to [fee/MaxFeePricingRatio, fee*MaxFeePricingRatio],
+// so that no price of CET, however it is pushed, changes the fixed fees by more than that
+const MaxFeePricingRatio = 10
+
+// GetFee returns the CET to charge for the fixed fee, which is converted at the current price of CET
+// and bounded by MaxFeePricingRatio. It falls back to the fixed fee if the mode is disabled or there
+// is no current price.
+func (p FeePricing) GetFee(ctx sdk.Context, pk CetPriceKeeper, fee int64) int64 {
+	if !p.IsEnabled() || pk == nil {
+		return fee
+	}
+	price, ok := pk.GetCetPrice(ctx, p.Denom)
+	if !ok || !price.IsPositive() {
+		return fee
+	}
+	amount := sdk.NewDec(fee).Mul(p.Price).Quo(price).TruncateInt()
+	if lower := sdk.NewInt(fee / MaxFeePricingRatio); amount.LT(lower) {
+		amount = lower
+	}
+	if upper := sdk.NewInt(fee).MulRaw(MaxFeePricingRatio); amount.GT(upper) {
+		amount = upper
+	}
+	if !amount.IsPositive() || !amount.IsInt64() {
+		return fee
+	}
+	return amount.Int64()
+}
+
+func (p FeePricing) String() string {
+	if !p.IsEnabled() {
+		return "fixed"
+	}
+	return fmt.Sprintf("%s at %s", p.Denom, p.Price)
+}
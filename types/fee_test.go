@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockCetPriceKeeper map[string]sdk.Dec
+
+func (k mockCetPriceKeeper) GetCetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	price, ok := k[denom]
+	return price, ok
+}
+
+func TestFeePricing_Validate(t *testing.T) {
+	require.NoError(t, DefaultFeePricing().Validate())
+	require.NoError(t, FeePricing{}.Validate())
+	require.NoError(t, NewFeePricing("usdt", sdk.NewDecWithPrec(2, 2)).Validate())
+	require.Error(t, NewFeePricing("usdt", sdk.ZeroDec()).Validate())
+	require.Error(t, FeePricing{Denom: "usdt"}.Validate())
+	require.Error(t, NewFeePricing(CET, sdk.OneDec()).Validate())
+	require.Error(t, NewFeePricing("USDT", sdk.OneDec()).Validate())
+}
+
+func TestFeePricing_GetFee(t *testing.T) {
+	pk := mockCetPriceKeeper{
+		"usdt": sdk.NewDecWithPrec(4, 2),
+		"usdc": sdk.ZeroDec(),
+	}
+	ctx := sdk.Context{}
+
+	// 100 CET at 0.02 usdt is 2 usdt, which is 50 CET at 0.04 usdt
+	require.Equal(t, int64(50e8), NewFeePricing("usdt", sdk.NewDecWithPrec(2, 2)).GetFee(ctx, pk, 100e8))
+	require.Equal(t, int64(200e8), NewFeePricing("usdt", sdk.NewDecWithPrec(8, 2)).GetFee(ctx, pk, 100e8))
+
+	// fall back to the fixed fee
+	require.Equal(t, int64(100e8), DefaultFeePricing().GetFee(ctx, pk, 100e8))
+	require.Equal(t, int64(100e8), NewFeePricing("usdt", sdk.OneDec()).GetFee(ctx, nil, 100e8))
+	require.Equal(t, int64(100e8), NewFeePricing("usdc", sdk.OneDec()).GetFee(ctx, pk, 100e8))
+	require.Equal(t, int64(100e8), NewFeePricing("dai", sdk.OneDec()).GetFee(ctx, pk, 100e8))
+	require.Equal(t, int64(1), NewFeePricing("usdt", sdk.NewDecWithPrec(1, 18)).GetFee(ctx, pk, 1))
+
+	// bounded to [fee/MaxFeePricingRatio, fee*MaxFeePricingRatio]
+	require.Equal(t, int64(10e8), NewFeePricing("usdt", sdk.NewDecWithPrec(1, 18)).GetFee(ctx, pk, 100e8))
+	require.Equal(t, int64(1000e8), NewFeePricing("usdt", sdk.NewDec(1)).GetFee(ctx, pk, 100e8))
+	require.Equal(t, int64(990e8), NewFeePricing("usdt", sdk.NewDecWithPrec(396, 3)).GetFee(ctx, pk, 100e8))
+}